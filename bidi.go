@@ -0,0 +1,114 @@
+package icu
+
+import "unicode"
+
+// Direction is the writing direction of a script or a text.
+type Direction int
+
+const (
+	// DirectionLTR is the direction of scripts like Latin, Cyrillic or Han.
+	DirectionLTR Direction = iota
+	// DirectionRTL is the direction of scripts like Arabic or Hebrew.
+	DirectionRTL
+)
+
+func (d Direction) String() string {
+	if d == DirectionRTL {
+		return "rtl"
+	}
+	return "ltr"
+}
+
+// Unicode characters isolating text of a direction from its surroundings.
+const (
+	leftToRightIsolate    = '\u2066'
+	rightToLeftIsolate    = '\u2067'
+	firstStrongIsolate    = '\u2068'
+	popDirectionalIsolate = '\u2069'
+)
+
+// Right-to-left scripts of Unicode. gen_localedata.go has a copy.
+var rightToLeftScripts = map[string]bool{
+	"Adlm": true, "Arab": true, "Armi": true, "Avst": true, "Chrs": true,
+	"Cprt": true, "Elym": true, "Hatr": true, "Hebr": true, "Hung": true,
+	"Khar": true, "Lydi": true, "Mand": true, "Mani": true, "Mend": true,
+	"Merc": true, "Mero": true, "Narb": true, "Nbat": true, "Nkoo": true,
+	"Orkh": true, "Ougr": true, "Palm": true, "Phli": true, "Phlp": true,
+	"Phnx": true, "Prti": true, "Rohg": true, "Samr": true, "Sarb": true,
+	"Sogd": true, "Sogo": true, "Syrc": true, "Thaa": true, "Yezi": true,
+}
+
+var rightToLeftRanges = []*unicode.RangeTable{
+	unicode.Hebrew, unicode.Arabic, unicode.Syriac, unicode.Thaana, unicode.Nko,
+	unicode.Samaritan, unicode.Mandaic, unicode.Adlam, unicode.Hanifi_Rohingya,
+	unicode.Yezidi, unicode.Imperial_Aramaic, unicode.Phoenician, unicode.Kharoshthi,
+}
+
+// Direction returns the writing direction of the script of a tag, which is
+// either given like in "pa-Arab" or is the likely script of the language and
+// region, e.g. DirectionRTL for "ar", "he" and "pa-PK" and DirectionLTR for
+// "en" and "pa".
+func (t Tag) Direction() Direction {
+	if rightToLeftScripts[t.Locale().Canonicalize().Maximize().Script] {
+		return DirectionRTL
+	}
+	return DirectionLTR
+}
+
+// firstStrongDirection returns the direction of the first character of s
+// with a strong direction, i.e. a letter or a directional mark. Texts like
+// "42" have none.
+func firstStrongDirection(s string) (Direction, bool) {
+	for _, r := range s {
+		switch {
+		case r == '\u200f' || r == '\u061c':
+			return DirectionRTL, true
+		case r == '\u200e':
+			return DirectionLTR, true
+		case unicode.IsLetter(r):
+			if unicode.In(r, rightToLeftRanges...) {
+				return DirectionRTL, true
+			}
+			return DirectionLTR, true
+		}
+	}
+	return DirectionLTR, false
+}
+
+const bidiIsolationParameter = "$bidi-isolation"
+
+// BidiIsolation returns a parameter that isolates each argument of a message
+// from the surrounding text, so that e.g. an English product name does not
+// reorder an Arabic sentence.
+func BidiIsolation() Parameter {
+	return P(bidiIsolationParameter, true)
+}
+
+// isolate wraps s in LRI or RLI and PDI according to its direction, or in FSI
+// and PDI if it has none. Left-to-right text in a left-to-right message
+// needs no isolation.
+func isolate(s string, dir Direction) string {
+	if s == "" {
+		return s
+	}
+	d, ok := firstStrongDirection(s)
+	switch {
+	case !ok:
+		return string(firstStrongIsolate) + s + string(popDirectionalIsolate)
+	case d == DirectionRTL:
+		return string(rightToLeftIsolate) + s + string(popDirectionalIsolate)
+	case dir == DirectionLTR:
+		return s
+	}
+	return string(leftToRightIsolate) + s + string(popDirectionalIsolate)
+}
+
+// isArgument tells whether a node substitutes an argument rather than being
+// text or choosing between messages.
+func isArgument(n node) bool {
+	switch n.(type) {
+	case nodeText, nodeQuotedText, nodeMessage, nodeFormatPlural, nodeFormatSelectOrdinal, nodeFormatSelect:
+		return false
+	}
+	return true
+}
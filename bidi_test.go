@@ -0,0 +1,70 @@
+package icu
+
+import "testing"
+
+func TestTagDirection(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		tag  Tag
+		want Direction
+	}{
+		{"en", DirectionLTR},
+		{"de-CH", DirectionLTR},
+		{"ar", DirectionRTL},
+		{"ar-EG", DirectionRTL},
+		{"he_IL", DirectionRTL},
+		{"fa-IR-u-nu-latn", DirectionRTL},
+		{"pa", DirectionLTR},
+		{"pa-Arab", DirectionRTL},
+		{"ks-Deva", DirectionLTR},
+		{"az-arab-IR", DirectionRTL},
+		{"az-IR", DirectionRTL},
+		{"az", DirectionLTR},
+		{"pa-PK", DirectionRTL},
+		{"uz-AF", DirectionRTL},
+		{"uz", DirectionLTR},
+		{"ku-IQ", DirectionRTL},
+		{"ku", DirectionLTR},
+		{"ckb", DirectionRTL},
+		{"iw", DirectionRTL},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag), func(t *testing.T) {
+			if got := tc.tag.Direction(); tc.want != got {
+				t.Errorf("want: %s, got: %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTranslateBidiIsolation(t *testing.T) {
+	testCases := []struct {
+		name       string
+		tag        Tag
+		message    MessageFormat
+		parameters []Parameter
+		translated string
+	}{
+		{"ltr in rtl", "ar", "تم شراء {product}.", []Parameter{P("product", "iPhone 15"), BidiIsolation()}, "تم شراء \u2066iPhone 15\u2069."},
+		{"rtl in rtl", "he", "שלום {name}!", []Parameter{P("name", "דנה"), BidiIsolation()}, "שלום \u2067דנה\u2069!"},
+		{"neutral", "he", "{n} פריטים", []Parameter{P("n", "42"), BidiIsolation()}, "\u206842\u2069 פריטים"},
+		{"rtl in ltr", "en", "Hello {name}!", []Parameter{P("name", "دانة"), BidiIsolation()}, "Hello \u2067دانة\u2069!"},
+		{"ltr in ltr", "en", "Hello {name}!", []Parameter{P("name", "Dana"), BidiIsolation()}, "Hello Dana!"},
+		{"number", "ar-EG", "{n, number} عناصر", []Parameter{P("n", -42), BidiIsolation()}, "\u2067\u061c-٤٢\u2069 عناصر"},
+		{"plural", "he", "{n, plural, one {פריט אחד} other {# פריטים}}", []Parameter{P("n", 3), BidiIsolation()}, "\u20683\u2069 פריטים"},
+		{"select", "he", "{kind, select, brand {מוצרים של {brand}} other {מוצרים}}", []Parameter{P("kind", "brand"), P("brand", "ACME"), BidiIsolation()}, "מוצרים של \u2066ACME\u2069"},
+		{"empty", "ar", "[{name}]", []Parameter{P("name", ""), BidiIsolation()}, "[]"},
+		{"off", "ar", "تم شراء {product}.", []Parameter{P("product", "iPhone 15")}, "تم شراء iPhone 15."},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Translate(tc.tag, tc.message, tc.parameters...)
+			if err != nil {
+				t.Errorf("parse: %s", err)
+			}
+			if tc.translated != got {
+				t.Errorf("expected: %q, got: %q", tc.translated, got)
+			}
+		})
+	}
+}
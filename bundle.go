@@ -1,90 +1,596 @@
 package icu
 
 import (
+	gocontext "context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"net/http"
-	"os"
-	"path/filepath"
+	"sort"
 	"sync"
 	"time"
-
-	"github.com/BurntSushi/toml"
 )
 
+// NewBundle returns a bundle of the translation files in a directory, named
+// like their tags, e.g. "de-CH.toml". Changed files are reloaded, see Watch to
+// reload them in the background instead.
 func NewBundle(directory string, defaultTag Tag) *Bundle {
+	return NewBundleLoader(DirLoader{Dir: directory}, defaultTag)
+}
+
+// NewBundleFS returns a bundle of the translation files in the root of a file
+// system, e.g. an embed.FS narrowed by fs.Sub. Changed files are reloaded if
+// the file system tells their modification time.
+func NewBundleFS(fsys fs.FS, defaultTag Tag) *Bundle {
+	return NewBundleLoader(FSLoader{FS: fsys}, defaultTag)
+}
+
+// NewBundleLoader returns a bundle of the catalogs of a loader.
+func NewBundleLoader(loader Loader, defaultTag Tag) *Bundle {
 	return &Bundle{
-		directory:  directory,
-		defaultTag: defaultTag,
+		loader:     loader,
+		defaultTag: defaultTag.literal(),
 		cache:      map[Tag]cacheEntry{},
+		fallbacks:  map[Tag][]Tag{},
+		resolvers:  []LocaleResolver{AcceptLanguageResolver{}},
+		formatters: newFormatterRegistry(),
 	}
 }
 
 type Bundle struct {
-	directory  string
-	defaultTag Tag
-	mu         sync.RWMutex
-	cache      map[Tag]cacheEntry
+	loader      Loader
+	defaultTag  Tag
+	mu          sync.RWMutex
+	cache       map[Tag]cacheEntry
+	tagCache    []Tag
+	tagsCached  bool
+	tagsVersion Version
+	tagMatcher  *Matcher
+	fallbacks   map[Tag][]Tag
+	resolvers   []LocaleResolver
+	formatters  *formatterRegistry
+	strict      bool
+	// checked is the version of the global formatters the cached catalogs
+	// of a strict bundle were checked with.
+	checked int
+
+	keepLastGood bool
+	onError      func(err error)
+
+	reloadMu    sync.Mutex
+	watchers    int
+	subscribers map[int]func(ReloadEvent)
+	subscriber  int
 }
 
-func (b *Bundle) TranslatorForRequest(r *http.Request) Translator {
-	if b == nil {
-		return nilTranslator
+// RegisterFormatter registers a formatter for arguments of the named type in
+// the messages of the bundle. It takes precedence over global formatters.
+// Strict bundles check their catalogs again.
+func (b *Bundle) RegisterFormatter(name string, f Formatter) {
+	b.formatters.register(name, f)
+	b.mu.Lock()
+	if b.strict {
+		b.cache = map[Tag]cacheEntry{}
 	}
-	spec := ExtractSpecification(r)
-	for _, r := range spec.Ranges() {
-		t, err := b.load(r.Tag)
-		if err != nil {
+	b.mu.Unlock()
+}
+
+// SetStrict sets whether messages with arguments of types without a
+// registered formatter fail to translate, which makes translators fall back
+// to the key. The types are checked and reported when catalogs are loaded,
+// so formatters passed with WithFormatters do not count.
+func (b *Bundle) SetStrict(strict bool) {
+	b.mu.Lock()
+	b.strict = strict
+	b.cache = map[Tag]cacheEntry{}
+	b.mu.Unlock()
+}
+
+// SetErrorHandler sets a function called with a *LoadError whenever a
+// catalog fails to load for another reason than missing, e.g. for a syntax
+// error, instead of silently skipping it. Failures to list the tags of the
+// catalogs are reported too.
+func (b *Bundle) SetErrorHandler(f func(err error)) {
+	b.mu.Lock()
+	b.onError = f
+	b.mu.Unlock()
+}
+
+// SetKeepLastGood sets whether catalogs failing to reload keep being served
+// in their last good version instead of being skipped.
+func (b *Bundle) SetKeepLastGood(keep bool) {
+	b.mu.Lock()
+	b.keepLastGood = keep
+	b.mu.Unlock()
+}
+
+// Errors returns the errors of the catalogs that failed when last loaded,
+// sorted by tag, including those still served in their last good version,
+// and of the messages failing the checks of a strict bundle. It is nil if all
+// catalogs loaded so far are fine.
+func (b *Bundle) Errors() LoadErrors {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	var errs LoadErrors
+	for key, e := range b.cache {
+		if e.err != nil && !errors.Is(e.err, fs.ErrNotExist) {
+			errs = append(errs, &LoadError{Tag: key, Err: e.err})
+		}
+		if e.translator != nil {
+			errs = append(errs, sortedMessageErrors(key, e.translator.invalid)...)
+		}
+	}
+	sort.SliceStable(errs, func(i, j int) bool { return errs[i].Tag < errs[j].Tag })
+	return errs
+}
+
+// Validate loads the catalogs of all tags of the bundle and of its default
+// tag and parses their messages, checking the argument types of strict
+// bundles, e.g. to refuse to start with broken catalogs. It returns
+// LoadErrors if any fail.
+func (b *Bundle) Validate() error {
+	ctx := gocontext.Background()
+	tags, err := b.loader.Tags(ctx)
+	if err != nil {
+		return err
+	}
+	var errs LoadErrors
+	seen := map[Tag]bool{}
+	for _, tag := range append(tags, b.defaultTag) {
+		key := tag.literal()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		e := b.loadEntry(ctx, tag)
+		if e.err != nil {
+			errs = append(errs, &LoadError{Tag: key, Err: e.err})
 			continue
 		}
-		return t
+		b.mu.RLock()
+		strict := b.strict
+		b.mu.RUnlock()
+		t := e.translator
+		errs = append(errs, sortedMessageErrors(key, messageErrors(t.Tag, t.Translations, b.formatters, strict))...)
 	}
-	return b.TranslatorForTag(b.defaultTag)
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
 }
 
-func (b *Bundle) TranslatorForTag(tag Tag) Translator {
+// sortedMessageErrors returns the errors of the messages of a catalog sorted
+// by key.
+func sortedMessageErrors(tag Tag, errs map[string]error) LoadErrors {
+	keys := make([]string, 0, len(errs))
+	for k := range errs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var les LoadErrors
+	for _, k := range keys {
+		les = append(les, &LoadError{Tag: tag, Err: fmt.Errorf("message %q: %w", k, errs[k])})
+	}
+	return les
+}
+
+// SetFallback sets the tags translations missing for a tag are looked up in
+// instead of its CLDR parent locales, e.g. "pt-PT" and "en" for "pt-BR".
+func (b *Bundle) SetFallback(tag Tag, fallbacks ...Tag) {
+	var fs []Tag
+	for _, f := range fallbacks {
+		fs = append(fs, f.literal())
+	}
+	b.mu.Lock()
+	b.fallbacks[tag.literal()] = fs
+	b.mu.Unlock()
+}
+
+// fallbackChain returns the tags the translations of a tag are looked up in:
+// the tag, its parent locales or configured fallbacks, those of the default
+// tag and finally root, e.g. "de-AT", "de", "en" and "root". Regions whose
+// likely script is not that of their language go through the script first,
+// e.g. "zh-TW", "zh-Hant-TW" and "zh-Hant". Aliases are kept apart, so "nb"
+// falls back to "no".
+func (b *Bundle) fallbackChain(tag Tag) []Tag {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	var chain []Tag
+	seen := map[Tag]bool{}
+	add := func(t Tag) {
+		if k := t.literal(); !seen[k] {
+			seen[k] = true
+			chain = append(chain, t)
+		}
+	}
+	walk := func(t Tag) {
+		l := t.Locale()
+		t = Locale{Language: l.Language, Script: l.Script, Region: l.Region}.Tag()
+		for t != "" && t != localeRoot {
+			add(t)
+			if fs, ok := b.fallbacks[t]; ok {
+				for _, f := range fs {
+					add(f)
+				}
+				return
+			}
+			if s := scriptedLocale(t); s != t {
+				t = s
+			} else {
+				t = parentLocale(t)
+			}
+		}
+	}
+	add(tag)
+	walk(tag)
+	if b.defaultTag != "" {
+		walk(b.defaultTag)
+	}
+	add(localeRoot)
+	return chain
+}
+
+// SetResolvers sets the resolvers asked for the locale of a request in order
+// of priority, e.g. a query parameter before the Accept-Language header,
+// which is the only one by default.
+func (b *Bundle) SetResolvers(resolvers ...LocaleResolver) {
+	b.mu.Lock()
+	b.resolvers = resolvers
+	b.mu.Unlock()
+}
+
+// Resolve returns the tag of the bundle for r, decided by the first resolver
+// whose locales match a tag of the bundle, or else the default tag.
+func (b *Bundle) Resolve(r *http.Request) Resolution {
+	b.mu.RLock()
+	resolvers := b.resolvers
+	b.mu.RUnlock()
+	m := b.matcher(detachedContext{r.Context()})
+	for _, res := range resolvers {
+		spec, ok := res.Resolve(r)
+		if !ok {
+			continue
+		}
+		if tag, c := match(spec, m); c != No {
+			return Resolution{Tag: tag, Confidence: c, Resolver: res}
+		}
+	}
+	return Resolution{Tag: b.defaultTag}
+}
+
+// match returns the tag best matching the ranges of a specification, e.g.
+// "de" for "de-CH", leaving out tags matched by ranges with a quality of 0.
+func match(spec Specification, m *Matcher) (Tag, Confidence) {
+	excluded := spec.excluded()
+	var desired []Tag
+	for _, r := range spec.Ranges() {
+		if r.Tag != Wildcard {
+			desired = append(desired, r.Tag)
+		}
+	}
+	tag, _, c := m.match(func(j int) bool { return excluded.matchAny(m.supported[j]) }, desired)
+	return tag, c
+}
+
+// matcher returns the matcher of the tags of the catalogs, which is only
+// built again when they change.
+func (b *Bundle) matcher(ctx gocontext.Context) *Matcher {
+	tags := b.tags(ctx)
+	b.mu.RLock()
+	m := b.tagMatcher
+	b.mu.RUnlock()
+	if m != nil && sameTags(m.supported, tags) {
+		return m
+	}
+	m = NewMatcher(tags...)
+	b.mu.Lock()
+	b.tagMatcher = m
+	b.mu.Unlock()
+	return m
+}
+
+func sameTags(a []Tag, b []Tag) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// TranslatorForRequest returns the translator for the tag resolved for r,
+// e.g. "de" for "de-CH" and "zh-Hant" for "zh-TW" in the Accept-Language
+// header, or else for the default tag.
+func (b *Bundle) TranslatorForRequest(r *http.Request) Translator {
 	if b == nil {
 		return nilTranslator
 	}
-	t, err := b.load(tag)
+	t, err := b.translatorForRequest(r)
 	if err != nil {
 		return nilTranslator
 	}
 	return t
 }
 
-func (b *Bundle) load(tag Tag) (*HierachicalTranslator, error) {
-	f := filepath.Join(b.directory, fmt.Sprintf("%s.toml", tag))
-	var err error
+func (b *Bundle) translatorForRequest(r *http.Request) (*HierachicalTranslator, error) {
+	ctx := detachedContext{r.Context()}
+	if t, err := b.translator(ctx, b.Resolve(r).Tag); err == nil {
+		return t, nil
+	}
+	return b.translator(ctx, b.defaultTag)
+}
+
+// detachedContext keeps the values of a request context but not its
+// cancellation, so a client going away does not fail the catalogs loaded for
+// it, which are cached for other requests too.
+type detachedContext struct {
+	gocontext.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
+// interrupted tells whether a load failed because its context was done,
+// which says nothing about the catalog.
+func interrupted(err error) bool {
+	return errors.Is(err, gocontext.Canceled) || errors.Is(err, gocontext.DeadlineExceeded)
+}
+
+// tags returns the tags of the catalogs of the bundle. Those of loaders
+// without versions and while watching or polling are only asked for once,
+// those of a TagsVersionLoader again when their version changes.
+func (b *Bundle) tags(ctx gocontext.Context) []Tag {
+	_, versioned := b.loader.(VersionLoader)
+	b.mu.RLock()
+	tags, ok, cached := b.tagCache, b.tagsCached, b.tagsVersion
+	memory := !versioned || b.watchers > 0
+	b.mu.RUnlock()
+	if memory && ok {
+		return tags
+	}
+	var v Version
+	if !memory {
+		tl, tagsVersioned := b.loader.(TagsVersionLoader)
+		if !tagsVersioned {
+			return b.listTags(ctx)
+		}
+		var err error
+		if v, err = tl.TagsVersion(ctx); err != nil {
+			return b.listTags(ctx)
+		}
+		if ok && v == cached {
+			return tags
+		}
+	}
+	tags, err := b.loader.Tags(ctx)
+	if err != nil {
+		b.reportTags(err)
+		return nil
+	}
+	b.mu.Lock()
+	b.tagCache, b.tagsCached, b.tagsVersion = tags, true, v
+	b.mu.Unlock()
+	return tags
+}
 
-	fi, err := os.Stat(f)
+// listTags returns the tags of the loader without caching them.
+func (b *Bundle) listTags(ctx gocontext.Context) []Tag {
+	tags, err := b.loader.Tags(ctx)
 	if err != nil {
-		return nil, err
+		b.reportTags(err)
 	}
+	return tags
+}
 
-	// Do we have a cached version?
+// reportTags reports a failure to list the tags of the catalogs, unless its
+// context was done.
+func (b *Bundle) reportTags(err error) {
+	if interrupted(err) {
+		return
+	}
 	b.mu.RLock()
-	e, ok := b.cache[tag]
+	onError := b.onError
 	b.mu.RUnlock()
-	if ok && fi.ModTime().Equal(e.modTime) {
-		return e.translator, nil
+	if onError != nil {
+		onError(fmt.Errorf("tags: %w", err))
 	}
+}
 
-	// No cached version or old version. Load language from file.
-	t := NewHierachicalTranslator()
-	_, err = toml.DecodeFile(f, t)
+// TranslatorForTag returns the translator for a tag, which falls back to the
+// translations of the other tags in its fallback chain.
+func (b *Bundle) TranslatorForTag(tag Tag) Translator {
+	if b == nil {
+		return nilTranslator
+	}
+	t, err := b.translator(gocontext.Background(), tag)
 	if err != nil {
-		return nil, err
+		return nilTranslator
+	}
+	return t
+}
+
+// translator chains copies of the translators loaded for the fallback chain
+// of a tag, as a translator may be a base in several chains.
+func (b *Bundle) translator(ctx gocontext.Context, tag Tag) (*HierachicalTranslator, error) {
+	var ts []*HierachicalTranslator
+	var first error
+	for i, t := range b.fallbackChain(tag) {
+		lt, err := b.load(ctx, t)
+		if err != nil {
+			if i == 0 {
+				first = err
+			}
+			continue
+		}
+		ts = append(ts, lt)
+	}
+	if len(ts) == 0 {
+		return nil, first
+	}
+	var base *HierachicalTranslator
+	for i := len(ts) - 1; i >= 0; i-- {
+		t := *ts[i]
+		if base != nil {
+			t.Base = base
+		}
+		base = &t
 	}
+	return base, nil
+}
+
+// load returns the translator of the catalog of a tag.
+func (b *Bundle) load(ctx gocontext.Context, tag Tag) (*HierachicalTranslator, error) {
+	return b.loadEntry(ctx, tag).result()
+}
 
-	// Cache loaded version.
+// loadEntry returns the cache entry of the catalog of a tag. Catalogs are
+// cached until the loader tells another version, or for good with loaders
+// without versions and while watching or polling, which also caches missing
+// catalogs. Failures without a version, e.g. of an unavailable database, are
+// retried with a backoff. Loads interrupted by their context are neither
+// cached nor reported.
+func (b *Bundle) loadEntry(ctx gocontext.Context, tag Tag) cacheEntry {
+	b.checkFormatters()
+	key := tag.literal()
+	vl, versioned := b.loader.(VersionLoader)
+	b.mu.RLock()
+	e, ok := b.cache[key]
+	memory := !versioned || b.watchers > 0
+	b.mu.RUnlock()
+	if ok && !e.retry.IsZero() && time.Now().Before(e.retry) {
+		return e
+	}
+	if ok && memory && e.retry.IsZero() {
+		return e
+	}
+	if ok && versioned {
+		if v, err := vl.Version(ctx, tag); err == nil && v == e.seen {
+			return e
+		}
+	}
+
+	c, v, err := b.loader.Load(ctx, tag)
+	if interrupted(err) {
+		if ok {
+			return e
+		}
+		return cacheEntry{tag: tag, err: err}
+	}
+	e = b.entry(e, tag, c, v, err)
+	if err == nil || memory || e.seen != "" || e.translator != nil || !e.retry.IsZero() {
+		b.mu.Lock()
+		b.cache[key] = e
+		b.mu.Unlock()
+	}
+	return e
+}
+
+// checkFormatters empties the cache of a strict bundle if formatters were
+// registered globally since its catalogs were checked.
+func (b *Bundle) checkFormatters() {
+	v := formatters.currentVersion()
+	b.mu.RLock()
+	checked := b.checked
+	b.mu.RUnlock()
+	if checked == v {
+		return
+	}
 	b.mu.Lock()
-	b.cache[tag] = cacheEntry{translator: t, modTime: fi.ModTime()}
+	if b.strict && b.checked != v {
+		b.cache = map[Tag]cacheEntry{}
+	}
+	b.checked = v
 	b.mu.Unlock()
+}
 
-	return t, nil
+// entry returns the cache entry of a catalog loaded for a tag, and reports
+// its failure. Failed entries keep the last good translator of the old entry
+// if the bundle is set to.
+func (b *Bundle) entry(old cacheEntry, tag Tag, c Catalog, v Version, err error) cacheEntry {
+	if err != nil {
+		e := cacheEntry{tag: tag, seen: v, err: err}
+		if errors.Is(err, fs.ErrNotExist) {
+			return e
+		}
+		b.mu.RLock()
+		keep, onError := b.keepLastGood, b.onError
+		b.mu.RUnlock()
+		if keep {
+			e.translator, e.version = old.translator, old.version
+		}
+		if v == "" {
+			e.backoff = old.backoff * 2
+			if e.backoff < minRetry {
+				e.backoff = minRetry
+			} else if e.backoff > maxRetry {
+				e.backoff = maxRetry
+			}
+			e.retry = time.Now().Add(e.backoff)
+		}
+		if onError != nil {
+			onError(&LoadError{Tag: tag.literal(), Err: err})
+		}
+		return e
+	}
+	t := NewHierachicalTranslator()
+	t.Tag = c.Tag
+	if t.Tag == "" {
+		t.Tag = tag.Canonical()
+	}
+	if c.Translations != nil {
+		t.Translations = c.Translations
+	}
+	t.formatters = b.formatters
+	b.mu.RLock()
+	strict, onError := b.strict, b.onError
+	b.mu.RUnlock()
+	if strict {
+		t.invalid = messageErrors(t.Tag, t.Translations, b.formatters, true)
+		if onError != nil {
+			for _, err := range sortedMessageErrors(tag.literal(), t.invalid) {
+				onError(err)
+			}
+		}
+	}
+	return cacheEntry{tag: tag, translator: t, version: v, seen: v}
 }
 
+// cacheEntry holds the translator of a catalog and the outcome of the last
+// attempt to load it, seen in its version.
 type cacheEntry struct {
+	tag        Tag
 	translator *HierachicalTranslator
-	modTime    time.Time
+	version    Version
+	seen       Version
+	err        error
+	retry      time.Time
+	backoff    time.Duration
+}
+
+// Failures without a version are retried after a backoff doubling from
+// minRetry up to maxRetry.
+const (
+	minRetry = time.Second
+	maxRetry = time.Minute
+)
+
+// result returns the translator served for the entry, which may be the last
+// good one of a failed catalog.
+func (e cacheEntry) result() (*HierachicalTranslator, error) {
+	if e.translator != nil {
+		return e.translator, nil
+	}
+	return nil, e.err
+}
+
+// served returns the version of the translator served for the entry.
+func (e cacheEntry) served() Version {
+	if e.translator == nil {
+		return ""
+	}
+	return e.version
 }
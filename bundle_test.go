@@ -0,0 +1,413 @@
+package icu
+
+import (
+	gocontext "context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func writeBundle(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestBundleFallbackChain(t *testing.T) {
+	requireFullData(t)
+	b := NewBundle(t.TempDir(), "en")
+	b.SetFallback("pt_br", "pt-PT", "en")
+	testCases := []struct {
+		in   Tag
+		want []Tag
+	}{
+		{"de-AT", []Tag{"de-AT", "de", "en", "root"}},
+		{"es-AR", []Tag{"es-AR", "es-419", "es", "en", "root"}},
+		{"en-AU", []Tag{"en-AU", "en-001", "en", "root"}},
+		{"de_at", []Tag{"de_at", "de", "en", "root"}},
+		{"pt-BR", []Tag{"pt-BR", "pt-PT", "en", "root"}},
+		{"en", []Tag{"en", "root"}},
+		{"zh-TW", []Tag{"zh-TW", "zh-Hant-TW", "zh-Hant", "en", "root"}},
+		{"zh-HK", []Tag{"zh-HK", "zh-Hant-HK", "zh-Hant", "en", "root"}},
+		{"zh-CN", []Tag{"zh-CN", "zh", "en", "root"}},
+		{"sr-ME", []Tag{"sr-ME", "sr-Latn-ME", "sr-Latn", "en", "root"}},
+		{"nb", []Tag{"nb", "no", "en", "root"}},
+		{"no", []Tag{"no", "en", "root"}},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.in), func(t *testing.T) {
+			if got := b.fallbackChain(tc.in); !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want: %v, got: %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestBundleFallback(t *testing.T) {
+	requireFullData(t)
+	dir := writeBundle(t, map[string]string{
+		"root.toml":   "[Translations]\nbrand = \"ICU\"\n",
+		"en.toml":     "[Translations]\nhello = \"Hello\"\nbye = \"Bye\"\ncart = \"Cart\"\n",
+		"de.toml":     "[Translations]\nhello = \"Hallo\"\nbye = \"Tschüss\"\n",
+		"de-AT.toml":  "[Translations]\nhello = \"Servus\"\n",
+		"es.toml":     "[Translations]\nhello = \"Hola\"\ncart = \"Carrito\"\n",
+		"es-419.toml": "[Translations]\ncart = \"Carro\"\n",
+		"pt-PT.toml":  "[Translations]\nhello = \"Olá\"\n",
+	})
+	b := NewBundle(dir, "en")
+	b.SetFallback("pt-BR", "pt-PT", "en")
+	testCases := []struct {
+		tag  Tag
+		key  string
+		want string
+	}{
+		{"de-AT", "hello", "Servus"},
+		{"de-AT", "bye", "Tschüss"},
+		{"de-AT", "cart", "Cart"},
+		{"de-AT", "brand", "ICU"},
+		{"de-AT", "missing", "missing"},
+		{"de-CH", "hello", "Hallo"},
+		{"es-AR", "cart", "Carro"},
+		{"es-AR", "hello", "Hola"},
+		{"es-ES", "cart", "Carrito"},
+		{"pt-BR", "hello", "Olá"},
+		{"pt-BR", "bye", "Bye"},
+		{"en", "hello", "Hello"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag)+" "+tc.key, func(t *testing.T) {
+			if got := b.TranslatorForTag(tc.tag).Translate(tc.key); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestBundleAliases(t *testing.T) {
+	requireFullData(t)
+	dir := writeBundle(t, map[string]string{
+		"en.toml":      "[Translations]\nhello = \"Hello\"\n",
+		"no.toml":      "[Translations]\nhello = \"Hei\"\nbye = \"Ha det\"\n",
+		"nb.toml":      "[Translations]\nhello = \"Hei (bokmål)\"\n",
+		"zh.toml":      "[Translations]\nhello = \"你好\"\n",
+		"zh-Hant.toml": "[Translations]\nhello = \"妳好\"\n",
+	})
+	b := NewBundle(dir, "en")
+	testCases := []struct {
+		tag  Tag
+		key  string
+		want string
+	}{
+		{"nb", "hello", "Hei (bokmål)"},
+		{"nb", "bye", "Ha det"},
+		{"no", "hello", "Hei"},
+		{"zh-TW", "hello", "妳好"},
+		{"zh-HK", "hello", "妳好"},
+		{"zh-CN", "hello", "你好"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag)+" "+tc.key, func(t *testing.T) {
+			if got := b.TranslatorForTag(tc.tag).Translate(tc.key); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestBundleFS(t *testing.T) {
+	modTime := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	fsys := fstest.MapFS{
+		"en.toml":     {Data: []byte("[Translations]\nhello = \"Hello\"\n"), ModTime: modTime},
+		"de.toml":     {Data: []byte("[Translations]\nhello = \"Hallo\"\n"), ModTime: modTime},
+		"sub/fr.toml": {Data: []byte("[Translations]\nhello = \"Bonjour\"\n"), ModTime: modTime},
+	}
+	b := NewBundleFS(fsys, "en")
+	if got, want := b.TranslatorForTag("de-AT").Translate("hello"), "Hallo"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+	if got, want := b.TranslatorForTag("fr").Translate("hello"), "Hello"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+	if got, want := b.tags(gocontext.Background()), []Tag{"de", "en"}; !reflect.DeepEqual(want, got) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+
+	// Unchanged modification times keep the cached translations.
+	fsys["de.toml"] = &fstest.MapFile{Data: []byte("[Translations]\nhello = \"Servus\"\n"), ModTime: modTime}
+	if got, want := b.TranslatorForTag("de").Translate("hello"), "Hallo"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+	fsys["de.toml"].ModTime = modTime.Add(time.Second)
+	if got, want := b.TranslatorForTag("de").Translate("hello"), "Servus"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+}
+
+func TestBundleErrors(t *testing.T) {
+	modTime := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	fsys := fstest.MapFS{
+		"en.toml": {Data: []byte("[Translations]\nhello = \"Hello\"\n"), ModTime: modTime},
+		"de.toml": {Data: []byte("[Translations]\nhello = \"Hallo\",\n"), ModTime: modTime},
+	}
+	b := NewBundleFS(fsys, "en")
+	var reported []error
+	b.SetErrorHandler(func(err error) { reported = append(reported, err) })
+	for i := 0; i < 2; i++ {
+		if got, want := b.TranslatorForTag("de").Translate("hello"), "Hello"; want != got {
+			t.Errorf("want: %q, got: %q", want, got)
+		}
+	}
+	// Unchanged broken catalogs are reported once, missing ones never.
+	if len(reported) != 1 {
+		t.Fatalf("want 1 reported error, got: %v", reported)
+	}
+	var le *LoadError
+	if !errors.As(reported[0], &le) || le.Tag != "de" {
+		t.Errorf("want a load error of de, got: %v", reported[0])
+	}
+	errs := b.Errors()
+	if len(errs) != 1 || errs[0].Tag != "de" {
+		t.Errorf("want an error of de, got: %v", errs)
+	}
+
+	fsys["de.toml"] = &fstest.MapFile{Data: []byte("[Translations]\nhello = \"Hallo\"\n"), ModTime: modTime.Add(time.Second)}
+	if got, want := b.TranslatorForTag("de").Translate("hello"), "Hallo"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+	if errs := b.Errors(); errs != nil {
+		t.Errorf("want no errors, got: %v", errs)
+	}
+}
+
+// unreadableFS fails to read files it can stat.
+type unreadableFS struct {
+	fstest.MapFS
+}
+
+func (fsys unreadableFS) Open(name string) (fs.File, error) {
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrPermission}
+}
+
+func (fsys unreadableFS) ReadFile(name string) ([]byte, error) {
+	return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrPermission}
+}
+
+func TestBundleReadError(t *testing.T) {
+	modTime := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	b := NewBundleFS(unreadableFS{fstest.MapFS{
+		"de.toml": {Data: []byte("[Translations]\nhello = \"Hallo\"\n"), ModTime: modTime},
+	}}, "en")
+	reported := 0
+	b.SetErrorHandler(func(err error) { reported++ })
+	for i := 0; i < 3; i++ {
+		b.TranslatorForTag("de")
+	}
+	if reported != 1 {
+		t.Errorf("want 1 reported error, got: %d", reported)
+	}
+	if errs := b.Errors(); len(errs) != 1 || !errors.Is(errs[0], fs.ErrPermission) {
+		t.Errorf("want a permission error, got: %v", errs)
+	}
+}
+
+func TestBundleKeepLastGood(t *testing.T) {
+	modTime := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	fsys := fstest.MapFS{
+		"en.toml": {Data: []byte("[Translations]\nhello = \"Hello\"\n"), ModTime: modTime},
+		"de.toml": {Data: []byte("[Translations]\nhello = \"Hallo\"\n"), ModTime: modTime},
+	}
+	testCases := []struct {
+		keep bool
+		want string
+	}{
+		{false, "Hello"},
+		{true, "Hallo"},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.keep), func(t *testing.T) {
+			fsys["de.toml"] = &fstest.MapFile{Data: []byte("[Translations]\nhello = \"Hallo\"\n"), ModTime: modTime}
+			b := NewBundleFS(fsys, "en")
+			b.SetKeepLastGood(tc.keep)
+			b.TranslatorForTag("de")
+			fsys["de.toml"] = &fstest.MapFile{Data: []byte("[Translations\n"), ModTime: modTime.Add(time.Second)}
+			if got := b.TranslatorForTag("de").Translate("hello"); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+			if errs := b.Errors(); len(errs) != 1 {
+				t.Errorf("want an error, got: %v", errs)
+			}
+		})
+	}
+}
+
+func TestBundleValidate(t *testing.T) {
+	testCases := []struct {
+		name  string
+		files map[string]string
+		want  []Tag
+	}{
+		{"valid", map[string]string{
+			"en.toml": "[Translations]\nhello = \"Hello\"\n",
+			"de.toml": "[Translations]\nhello = \"Hallo\"\n",
+		}, nil},
+		{"syntax", map[string]string{
+			"en.toml": "[Translations]\nhello = \"Hello\"\n",
+			"de.toml": "[Translations]\nhello = \"Hallo\",\n",
+		}, []Tag{"de"}},
+		{"message", map[string]string{
+			"en.toml": "[Translations]\nhello = \"Hello\"\n",
+			"fr.toml": "[Translations]\nhello = \"{count, plural, one {Salut}\"\n",
+		}, []Tag{"fr"}},
+		{"cut", map[string]string{
+			"en.toml": "[Translations]\nplural = \"{a, plural, one\"\nselect = \"{a, select, other\"\n",
+		}, []Tag{"en", "en"}},
+		{"brace", map[string]string{
+			"en.toml": "[Translations]\nhello = \"Hello\"\n",
+			"de.toml": "[Translations]\nstray = \"a}b\"\nnested = \"{{a}}\"\nselector = \"{a, select, {x}}\"\n",
+		}, []Tag{"de", "de", "de"}},
+		{"default", map[string]string{
+			"de.toml": "[Translations]\nhello = \"Hallo\"\n",
+		}, []Tag{"en"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewBundle(writeBundle(t, tc.files), "en").Validate()
+			var got []Tag
+			var errs LoadErrors
+			if errors.As(err, &errs) {
+				for _, e := range errs {
+					got = append(got, e.Tag)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want: %v, got: %v (%v)", tc.want, got, err)
+			}
+		})
+	}
+}
+
+// countingLoader counts the listings of the tags of an FSLoader.
+type countingLoader struct {
+	FSLoader
+	listings int
+}
+
+func (l *countingLoader) Tags(ctx gocontext.Context) ([]Tag, error) {
+	l.listings++
+	return l.FSLoader.Tags(ctx)
+}
+
+func TestBundleTagsVersion(t *testing.T) {
+	modTime := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	fsys := fstest.MapFS{
+		".":       {Mode: fs.ModeDir, ModTime: modTime},
+		"en.toml": {Data: []byte("[Translations]\nhello = \"Hello\"\n"), ModTime: modTime},
+	}
+	l := &countingLoader{FSLoader: FSLoader{FS: fsys}}
+	b := NewBundleLoader(l, "en")
+	ctx := gocontext.Background()
+	for i := 0; i < 3; i++ {
+		if got, want := b.tags(ctx), []Tag{"en"}; !reflect.DeepEqual(want, got) {
+			t.Errorf("want: %v, got: %v", want, got)
+		}
+	}
+	if l.listings != 1 {
+		t.Errorf("want: 1 listing, got: %d", l.listings)
+	}
+
+	fsys["de.toml"] = &fstest.MapFile{Data: []byte("[Translations]\nhello = \"Hallo\"\n"), ModTime: modTime}
+	fsys["."].ModTime = modTime.Add(time.Second)
+	if got, want := b.tags(ctx), []Tag{"de", "en"}; !reflect.DeepEqual(want, got) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+	if l.listings != 2 {
+		t.Errorf("want: 2 listings, got: %d", l.listings)
+	}
+}
+
+func TestBundleMatcher(t *testing.T) {
+	modTime := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	fsys := fstest.MapFS{
+		".":       {Mode: fs.ModeDir, ModTime: modTime},
+		"en.toml": {Data: []byte("[Translations]\nhello = \"Hello\"\n"), ModTime: modTime},
+	}
+	b := NewBundleLoader(FSLoader{FS: fsys}, "en")
+	ctx := gocontext.Background()
+	m := b.matcher(ctx)
+	if b.matcher(ctx) != m {
+		t.Errorf("want the matcher to be reused while the tags are unchanged")
+	}
+
+	fsys["de.toml"] = &fstest.MapFile{Data: []byte("[Translations]\nhello = \"Hallo\"\n"), ModTime: modTime}
+	fsys["."].ModTime = modTime.Add(time.Second)
+	if b.matcher(ctx) == m {
+		t.Errorf("want a new matcher for the changed tags")
+	}
+	if tag, c := match("de-CH", b.matcher(ctx)); tag != "de" || c != High {
+		t.Errorf("want: de (High), got: %s (%s)", tag, c)
+	}
+}
+
+// interruptingLoader fails the loads of an FSLoader with err if set, or else
+// with the error of a done context.
+type interruptingLoader struct {
+	FSLoader
+	err error
+}
+
+func (l *interruptingLoader) Load(ctx gocontext.Context, tag Tag) (Catalog, Version, error) {
+	if l.err != nil {
+		return Catalog{}, "", l.err
+	}
+	if err := ctx.Err(); err != nil {
+		return Catalog{}, "", err
+	}
+	return l.FSLoader.Load(ctx, tag)
+}
+
+func TestBundleLoadInterrupted(t *testing.T) {
+	fsys := fstest.MapFS{
+		"en.toml": {Data: []byte("[Translations]\nhello = \"Hello\"\n")},
+		"de.toml": {Data: []byte("[Translations]\nhello = \"Hallo\"\n")},
+		"it.toml": {Data: []byte("[Translations]\nhello = \"Ciao\"\n")},
+	}
+	l := &interruptingLoader{FSLoader: FSLoader{FS: fsys}}
+	b := NewBundleLoader(l, "en")
+	var reported []error
+	b.SetErrorHandler(func(err error) { reported = append(reported, err) })
+
+	// Requests going away do not fail the catalogs loaded for them.
+	ctx, cancel := gocontext.WithCancel(gocontext.Background())
+	cancel()
+	r := httptest.NewRequest("GET", "/", nil).WithContext(ctx)
+	r.Header.Set(HeaderAcceptLanguage, "de")
+	if got, want := b.TranslatorForRequest(r).Translate("hello"), "Hallo"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+
+	l.err = fmt.Errorf("query: %w", gocontext.DeadlineExceeded)
+	if got, want := b.TranslatorForTag("it").Translate("hello"), "Hello"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+	if reported != nil {
+		t.Errorf("want no reported errors, got: %v", reported)
+	}
+	l.err = nil
+	if got, want := b.TranslatorForTag("it").Translate("hello"), "Ciao"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+}
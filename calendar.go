@@ -0,0 +1,300 @@
+package icu
+
+import (
+	"math"
+	"time"
+)
+
+// Calendars supported by date formatting, named like the types of the "-u-ca-"
+// extension.
+const (
+	calendarGregorian       = "gregorian"
+	calendarBuddhist        = "buddhist"
+	calendarJapanese        = "japanese"
+	calendarIslamicCivil    = "islamic-civil"
+	calendarIslamicUmalqura = "islamic-umalqura"
+	calendarPersian         = "persian"
+	calendarHebrew          = "hebrew"
+)
+
+// Julian day numbers of the Unix epoch and of the epochs of the calendars.
+const (
+	julianDayUnix    = 2440588
+	julianDayIslamic = 1948440
+	julianDayPersian = 1948320
+	julianDayHebrew  = 347997
+)
+
+// Calendar returns the calendar of a tag, which is either given by a "-u-ca-"
+// extension like in "th-u-ca-gregory" or is the default of its region, e.g.
+// "buddhist" for "th" and "persian" for "fa-IR". The observation-based
+// "islamic" and "islamic-rgsa" calendars are approximated by
+// "islamic-umalqura". Other calendars which are not supported fall back to
+// the default of the region.
+func Calendar(tag Tag) string {
+	switch ca := unicodeExtension(tag, "ca"); ca {
+	case "gregory":
+		return calendarGregorian
+	case "islamic", "islamic-rgsa":
+		return calendarIslamicUmalqura
+	case calendarBuddhist, calendarJapanese, calendarIslamicCivil, calendarIslamicUmalqura, calendarPersian, calendarHebrew:
+		return ca
+	}
+	if ca, ok := defaultCalendars[regionOf(tag)]; ok {
+		return ca
+	}
+	return calendarGregorian
+}
+
+// calendarSymbolsIn returns the symbols of a calendar. Days, quarters and day
+// periods are the same in all calendars.
+func calendarSymbolsIn(tag Tag, calendar string) *calendarSymbols {
+	g := calendarSymbolsFor(tag)
+	ls, ok := calendarSymbolData[calendar]
+	if !ok {
+		return g
+	}
+	t, ok := lookupLocale(tag, func(key string) bool { _, ok := ls[key]; return ok })
+	if !ok {
+		t = TagEn
+	}
+	c := ls[string(t)]
+	s := *c
+	s.days, s.daysStandAlone, s.quarters, s.dayPeriods = g.days, g.daysStandAlone, g.quarters, g.dayPeriods
+	return &s
+}
+
+// calendarDate is a date in a calendar. The month is the number to format,
+// monthIndex the index of its name, which differ for the Hebrew calendar.
+type calendarDate struct {
+	era          int
+	year         int
+	extendedYear int
+	month        int
+	monthIndex   int
+	day          int
+	dayOfYear    int
+}
+
+// dateIn converts the civil date of t into a calendar.
+func dateIn(calendar string, t time.Time) calendarDate {
+	y, m, d := t.Date()
+	jd := int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix()/86400) + julianDayUnix
+	switch calendar {
+	case calendarBuddhist:
+		return calendarDate{year: y + 543, extendedYear: y, month: int(m), monthIndex: int(m) - 1, day: d, dayOfYear: t.YearDay()}
+	case calendarJapanese:
+		return japaneseDate(y, int(m), d, t.YearDay())
+	case calendarIslamicCivil:
+		return islamicCivilDate(jd)
+	case calendarIslamicUmalqura:
+		return umalquraDate(jd)
+	case calendarPersian:
+		return persianDate(jd)
+	case calendarHebrew:
+		return hebrewDate(jd)
+	}
+	cd := calendarDate{era: 1, year: y, extendedYear: y, month: int(m), monthIndex: int(m) - 1, day: d, dayOfYear: t.YearDay()}
+	if y <= 0 {
+		cd.era, cd.year = 0, 1-y
+	}
+	return cd
+}
+
+func japaneseDate(y int, m int, d int, dayOfYear int) calendarDate {
+	era := 0
+	for i, s := range japaneseEraStarts {
+		if s[0] < y || (s[0] == y && (s[1] < m || (s[1] == m && s[2] <= d))) {
+			era = i
+		}
+	}
+	return calendarDate{era: era, year: y - japaneseEraStarts[era][0] + 1, extendedYear: y, month: m, monthIndex: m - 1, day: d, dayOfYear: dayOfYear}
+}
+
+func islamicCivilYearStart(y int) int {
+	return 354*(y-1) + floorDiv(3+11*y, 30)
+}
+
+func islamicCivilMonthStart(y int, m int) int {
+	return int(math.Ceil(29.5*float64(m))) + islamicCivilYearStart(y)
+}
+
+// islamicCivilDate uses the arithmetic Islamic calendar with leap years in
+// years 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29 of each 30 year cycle.
+func islamicCivilDate(jd int) calendarDate {
+	days := jd - julianDayIslamic
+	y := floorDiv(30*days+10646, 10631)
+	m := int(math.Ceil(float64(days-29-islamicCivilYearStart(y)) / 29.5))
+	if m > 11 {
+		m = 11
+	}
+	return calendarDate{
+		year:         y,
+		extendedYear: y,
+		month:        m + 1,
+		monthIndex:   m,
+		day:          days - islamicCivilMonthStart(y, m) + 1,
+		dayOfYear:    days - islamicCivilYearStart(y) + 1,
+	}
+}
+
+const (
+	umalquraFirstYear = 1300
+	umalquraLastYear  = 1600
+)
+
+func umalquraYearStart(y int) int {
+	if y < umalquraFirstYear || y > umalquraLastYear {
+		return islamicCivilYearStart(y)
+	}
+	i := y - umalquraFirstYear
+	return int(354.36720*float64(i)+460322.05+0.5) + int(umalquraYearStartFixes[i])
+}
+
+func umalquraMonthLength(y int, m int) int {
+	if y < umalquraFirstYear || y > umalquraLastYear {
+		if m == 11 && islamicCivilYearStart(y+1)-islamicCivilYearStart(y) == 355 {
+			return 30
+		}
+		return 29 + (m+1)%2
+	}
+	if umalquraMonthLengths[y-umalquraFirstYear]&(1<<uint(11-m)) != 0 {
+		return 30
+	}
+	return 29
+}
+
+// umalquraDate uses the Umm al-Qura calendar of Saudi Arabia for the years
+// 1300 to 1600 and the civil Islamic calendar otherwise.
+func umalquraDate(jd int) calendarDate {
+	days := jd - julianDayIslamic
+	if days < umalquraYearStart(umalquraFirstYear) {
+		return islamicCivilDate(jd)
+	}
+	y := int((float64(days)-(460322.05+0.5))/354.36720) + umalquraFirstYear - 1
+	for umalquraYearStart(y+1) <= days {
+		y++
+	}
+	d := days - umalquraYearStart(y) + 1
+	dayOfYear := d
+	m := 0
+	for m < 11 && d > umalquraMonthLength(y, m) {
+		d -= umalquraMonthLength(y, m)
+		m++
+	}
+	return calendarDate{year: y, extendedYear: y, month: m + 1, monthIndex: m, day: d, dayOfYear: dayOfYear}
+}
+
+var persianMonthStarts = [12]int{0, 31, 62, 93, 124, 155, 186, 216, 246, 276, 306, 336}
+
+// persianDate uses the arithmetic Persian calendar, which matches the
+// astronomical one until the year 1502.
+func persianDate(jd int) calendarDate {
+	days := jd - julianDayPersian
+	y := floorDiv(33*days+3, 12053) + 1
+	dayOfYear := days - (365*(y-1) + floorDiv(8*y+21, 33))
+	m := dayOfYear / 31
+	if dayOfYear >= 216 {
+		m = (dayOfYear - 6) / 30
+	}
+	return calendarDate{year: y, extendedYear: y, month: m + 1, monthIndex: m, day: dayOfYear + 1 - persianMonthStarts[m], dayOfYear: dayOfYear + 1}
+}
+
+// Hebrew time is counted in parts of 1/1080 hour.
+const (
+	hebrewHourParts  = 1080
+	hebrewDayParts   = 24 * hebrewHourParts
+	hebrewMonthFract = 12*hebrewHourParts + 793
+	hebrewMonthParts = 29*hebrewDayParts + hebrewMonthFract
+	hebrewBaharad    = 11*hebrewHourParts + 204
+)
+
+// Days before each month of deficient, regular and complete Hebrew years,
+// with a placeholder for Adar I in common years.
+var (
+	hebrewMonthStarts = [14][3]int{
+		{0, 0, 0}, {30, 30, 30}, {59, 59, 60}, {88, 89, 90}, {117, 118, 119},
+		{147, 148, 149}, {147, 148, 149}, {176, 177, 178}, {206, 207, 208}, {235, 236, 237},
+		{265, 266, 267}, {294, 295, 296}, {324, 325, 326}, {353, 354, 355},
+	}
+	hebrewLeapMonthStarts = [14][3]int{
+		{0, 0, 0}, {30, 30, 30}, {59, 59, 60}, {88, 89, 90}, {117, 118, 119},
+		{147, 148, 149}, {177, 178, 179}, {206, 207, 208}, {236, 237, 238}, {265, 266, 267},
+		{295, 296, 297}, {324, 325, 326}, {354, 355, 356}, {383, 384, 385},
+	}
+)
+
+func isHebrewLeapYear(y int) bool {
+	return floorMod(12*y+17, 19) >= 12
+}
+
+// hebrewYearStart returns the day of Tishri 1 of a year, which is the day of
+// its new moon postponed by the rules of dehiyyot.
+func hebrewYearStart(y int) int {
+	months := floorDiv(235*y-234, 19)
+	frac := months*hebrewMonthFract + hebrewBaharad
+	day := months*29 + frac/hebrewDayParts
+	frac %= hebrewDayParts
+	switch wd := day % 7; {
+	case wd == 2 || wd == 4 || wd == 6:
+		day++
+	case wd == 1 && frac > 15*hebrewHourParts+204 && !isHebrewLeapYear(y):
+		day += 2
+	case wd == 0 && frac > 21*hebrewHourParts+589 && isHebrewLeapYear(y-1):
+		day++
+	}
+	return day
+}
+
+func hebrewDate(jd int) calendarDate {
+	d := jd - julianDayHebrew
+	m := floorDiv(d*hebrewDayParts, hebrewMonthParts)
+	y := floorDiv(19*m+234, 235) + 1
+	dayOfYear := d - hebrewYearStart(y)
+	for dayOfYear < 1 {
+		y--
+		dayOfYear = d - hebrewYearStart(y)
+	}
+	length := hebrewYearStart(y+1) - hebrewYearStart(y)
+	if length > 380 {
+		length -= 30
+	}
+	kind := 1
+	switch length {
+	case 353:
+		kind = 0
+	case 355:
+		kind = 2
+	}
+	leap := isHebrewLeapYear(y)
+	starts := &hebrewMonthStarts
+	if leap {
+		starts = &hebrewLeapMonthStarts
+	}
+	month := 0
+	for month < len(starts) && dayOfYear > starts[month][kind] {
+		month++
+	}
+	month--
+	cd := calendarDate{year: y, extendedYear: y, month: month + 1, monthIndex: month, day: dayOfYear - starts[month][kind], dayOfYear: dayOfYear}
+	switch {
+	case !leap && month > 5:
+		cd.month = month
+	case leap && month == 6:
+		// Adar is called Adar II in leap years.
+		cd.monthIndex = 13
+	}
+	return cd
+}
+
+func floorDiv(a int, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+func floorMod(a int, b int) int {
+	return a - floorDiv(a, b)*b
+}
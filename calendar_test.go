@@ -0,0 +1,102 @@
+package icu
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalendar(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		tag  Tag
+		want string
+	}{
+		{"en", "gregorian"},
+		{"th", "buddhist"},
+		{"th-u-ca-gregory", "gregorian"},
+		{"fa", "persian"},
+		{"fa-AF", "persian"},
+		{"ja", "gregorian"},
+		{"ja-u-ca-japanese", "japanese"},
+		{"ar-SA-u-ca-islamic-umalqura", "islamic-umalqura"},
+		{"ar-SA", "islamic-umalqura"},
+		{"ar-SA-u-ca-gregory", "gregorian"},
+		{"ar-EG", "gregorian"},
+		{"ar-u-ca-islamic", "islamic-umalqura"},
+		{"ar-SA-u-ca-islamic-rgsa", "islamic-umalqura"},
+		{"th-u-ca-chinese", "buddhist"},
+		{"he-u-ca-hebrew-nu-latn", "hebrew"},
+		{"en-u-ca-unknown", "gregorian"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag), func(t *testing.T) {
+			if got := Calendar(tc.tag); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatDateTimeCalendar(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		tag     Tag
+		date    time.Time
+		pattern string
+		want    string
+	}{
+		{"th", time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC), "d MMMM G y", "5 มีนาคม พ.ศ. 2567"},
+		{"en-u-ca-buddhist", time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC), "MMMM d, y G", "March 5, 2567 BE"},
+		{"ja-u-ca-japanese", time.Date(2019, time.April, 30, 0, 0, 0, 0, time.UTC), "GGGGy年M月d日", "平成31年4月30日"},
+		{"ja-u-ca-japanese", time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC), "GGGGy年M月d日", "令和1年5月1日"},
+		{"en-u-ca-japanese", time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC), "MMM d, y G", "Mar 5, 6 Reiwa"},
+		{"en-u-ca-islamic-civil", time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC), "d MMMM y G", "25 Shaʻban 1440 AH"},
+		{"en-u-ca-islamic-umalqura", time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC), "d MMMM y G", "26 Shaʻban 1440 AH"},
+		{"en-u-ca-islamic-umalqura", time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC), "d MMMM y", "1 Ramadan 1446"},
+		{"en-u-ca-islamic", time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC), "d MMMM y G", "26 Shaʻban 1440 AH"},
+		{"fa", time.Date(2025, time.March, 21, 0, 0, 0, 0, time.UTC), "d MMMM y", "۱ فروردین ۱۴۰۴"},
+		{"en-u-ca-persian", time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC), "d MMMM y G", "15 Esfand 1402 AP"},
+		{"en-u-ca-hebrew", time.Date(2023, time.September, 16, 0, 0, 0, 0, time.UTC), "d MMMM y", "1 Tishri 5784"},
+		{"en-u-ca-hebrew", time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC), "d MMMM y", "25 Adar I 5784"},
+		{"en-u-ca-hebrew", time.Date(2024, time.March, 25, 0, 0, 0, 0, time.UTC), "d MMMM y M", "15 Adar II 5784 7"},
+		{"en-u-ca-hebrew", time.Date(2025, time.March, 21, 0, 0, 0, 0, time.UTC), "d MMMM y M", "21 Adar 5785 6"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag)+":"+tc.want, func(t *testing.T) {
+			if got := FormatDateTime(tc.tag, tc.date, tc.pattern); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTranslateCalendar(t *testing.T) {
+	requireFullData(t)
+	date := time.Date(2024, time.March, 5, 14, 7, 0, 0, time.UTC)
+	testCases := []struct {
+		name       string
+		tag        Tag
+		message    MessageFormat
+		parameters []Parameter
+		translated string
+	}{
+		{"buddhist", "th", "{d, date, long}", []Parameter{P("d", date)}, "5 มีนาคม 2567"},
+		{"gregory", "th-u-ca-gregory", "{d, date, long}", []Parameter{P("d", date)}, "5 มีนาคม ค.ศ. 2024"},
+		{"japanese", "ja-u-ca-japanese", "{d, date, long}", []Parameter{P("d", date)}, "令和6年3月5日"},
+		{"persian", "en-u-ca-persian", "{d, date, long}", []Parameter{P("d", date)}, "Esfand 15, 1402 AP"},
+		{"hebrew", "en-u-ca-hebrew", "{d, date, medium}", []Parameter{P("d", date)}, "25 Adar I 5784"},
+		{"umalqura", "en-u-ca-islamic-umalqura", "{d, date, short}", []Parameter{P("d", date)}, "8/24/1445 AH"},
+		{"gregorian", "en", "{d, date, long}", []Parameter{P("d", date)}, "March 5, 2024"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Translate(tc.tag, tc.message, tc.parameters...)
+			if err != nil {
+				t.Errorf("parse: %s", err)
+			}
+			if tc.translated != got {
+				t.Errorf("expected: '%s', got: '%s'", tc.translated, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package icu
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Predefined date and time styles, e.g. {d, date, long}.
+const (
+	styleFull   = "full"
+	styleLong   = "long"
+	styleMedium = "medium"
+	styleShort  = "short"
+)
+
+type symbolWidths struct {
+	abbreviated []string
+	wide        []string
+	narrow      []string
+	short       []string
+}
+
+// get returns the i-th symbol in the width selected by the number of pattern
+// letters: 1-3 abbreviated, 4 wide, 5 narrow and 6 short.
+func (w symbolWidths) get(i int, count int) string {
+	names := w.abbreviated
+	switch {
+	case count == 4 && w.wide != nil:
+		names = w.wide
+	case count == 5 && w.narrow != nil:
+		names = w.narrow
+	case count == 6 && w.short != nil:
+		names = w.short
+	}
+	if i < 0 || i >= len(names) {
+		return ""
+	}
+	return names[i]
+}
+
+type calendarSymbols struct {
+	months           symbolWidths
+	monthsStandAlone symbolWidths
+	days             symbolWidths
+	daysStandAlone   symbolWidths
+	quarters         symbolWidths
+	dayPeriods       symbolWidths
+	eras             symbolWidths
+	timeFormats      [4]string
+	dateFormats      [4]string
+}
+
+func calendarSymbolsFor(tag Tag) *calendarSymbols {
+	_, d := localeDataFor(tag, hasGregorian)
+	return d.gregorian
+}
+
+func styleIndex(style string) (int, bool) {
+	switch style {
+	case styleFull:
+		return 0, true
+	case styleLong:
+		return 1, true
+	case styleMedium:
+		return 2, true
+	case styleShort:
+		return 3, true
+	}
+	return 0, false
+}
+
+// datePattern returns the pattern of a predefined date style in a calendar.
+// Any other style is taken to be a pattern already.
+func datePattern(tag Tag, calendar string, style string) string {
+	if i, ok := styleIndex(style); ok {
+		return calendarSymbolsIn(tag, calendar).dateFormats[i]
+	}
+	return style
+}
+
+// timePattern returns the pattern of a predefined time style in a calendar.
+// Any other style is taken to be a pattern already.
+func timePattern(tag Tag, calendar string, style string) string {
+	if i, ok := styleIndex(style); ok {
+		return calendarSymbolsIn(tag, calendar).timeFormats[i]
+	}
+	return style
+}
+
+// FormatDateTime formats t according to an LDML date format pattern such as
+// "EEEE, d. MMMM y HH:mm zzzz" using the symbols, the calendar and the
+// numbering system of the given tag.
+func FormatDateTime(tag Tag, t time.Time, pattern string) string {
+	return formatDateTime(baseLocale(tag), Calendar(tag), NumberingSystem(tag), t, pattern)
+}
+
+func formatDateTime(tag Tag, calendar string, numbering string, t time.Time, pattern string) string {
+	sym := calendarSymbolsIn(tag, calendar)
+	d := dateIn(calendar, t)
+	buf := bytes.Buffer{}
+	rs := []rune(pattern)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case r == quote:
+			i++
+			if i < len(rs) && rs[i] == quote {
+				buf.WriteRune(quote)
+				i++
+				continue
+			}
+			for i < len(rs) {
+				if rs[i] == quote {
+					if i+1 < len(rs) && rs[i+1] == quote {
+						buf.WriteRune(quote)
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				buf.WriteRune(rs[i])
+				i++
+			}
+		case isPatternLetter(r):
+			n := 1
+			for i+n < len(rs) && rs[i+n] == r {
+				n++
+			}
+			buf.WriteString(FormatDigits(formatDateField(tag, sym, t, d, r, n), numbering))
+			i += n
+		default:
+			buf.WriteRune(r)
+			i++
+		}
+	}
+	return buf.String()
+}
+
+func isPatternLetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func formatDateField(tag Tag, sym *calendarSymbols, t time.Time, d calendarDate, letter rune, count int) string {
+	switch letter {
+	case 'G':
+		return sym.eras.get(d.era, count)
+	case 'y':
+		if count == 2 {
+			return pad(d.year%100, 2)
+		}
+		return pad(d.year, count)
+	case 'Y':
+		y, _ := t.ISOWeek()
+		if count == 2 {
+			return pad(y%100, 2)
+		}
+		return pad(y, count)
+	case 'u':
+		return pad(d.extendedYear, count)
+	case 'Q', 'q':
+		q := (d.month - 1) / 3
+		if count <= 2 {
+			return pad(q+1, count)
+		}
+		return sym.quarters.get(q, count)
+	case 'M', 'L':
+		if count <= 2 {
+			return pad(d.month, count)
+		}
+		if letter == 'L' {
+			return sym.monthsStandAlone.get(d.monthIndex, count)
+		}
+		return sym.months.get(d.monthIndex, count)
+	case 'w':
+		_, w := t.ISOWeek()
+		return pad(w, count)
+	case 'd':
+		return pad(d.day, count)
+	case 'D':
+		return pad(d.dayOfYear, count)
+	case 'F':
+		return pad((d.day-1)/7+1, count)
+	case 'E':
+		return sym.days.get(int(t.Weekday()), count)
+	case 'e', 'c':
+		if count <= 2 {
+			return pad(int(t.Weekday())+1, count)
+		}
+		if letter == 'c' {
+			return sym.daysStandAlone.get(int(t.Weekday()), count)
+		}
+		return sym.days.get(int(t.Weekday()), count)
+	case 'a', 'b', 'B':
+		p := 0
+		if t.Hour() >= 12 {
+			p = 1
+		}
+		return sym.dayPeriods.get(p, count)
+	case 'h':
+		h := t.Hour() % 12
+		if h == 0 {
+			h = 12
+		}
+		return pad(h, count)
+	case 'H':
+		return pad(t.Hour(), count)
+	case 'K':
+		return pad(t.Hour()%12, count)
+	case 'k':
+		h := t.Hour()
+		if h == 0 {
+			h = 24
+		}
+		return pad(h, count)
+	case 'm':
+		return pad(t.Minute(), count)
+	case 's':
+		return pad(t.Second(), count)
+	case 'S':
+		frac := pad(t.Nanosecond(), 9)
+		for len(frac) < count {
+			frac += "0"
+		}
+		return frac[:count]
+	case 'A':
+		ms := ((t.Hour()*60+t.Minute())*60+t.Second())*1000 + t.Nanosecond()/1e6
+		return pad(ms, count)
+	case 'z', 'Z', 'O', 'v', 'V', 'X', 'x':
+		return formatZoneField(tag, t, letter, count)
+	}
+	return strings.Repeat(string(letter), count)
+}
+
+// pad formats v with at least n digits.
+func pad(v int, n int) string {
+	if v < 0 {
+		return "-" + pad(-v, n)
+	}
+	s := strconv.Itoa(v)
+	if len(s) < n {
+		s = strings.Repeat("0", n-len(s)) + s
+	}
+	return s
+}
@@ -0,0 +1,301 @@
+package icu
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationUnit is a unit in which FormatDuration expresses a duration.
+type DurationUnit string
+
+const (
+	DurationDay         DurationUnit = "day"
+	DurationHour        DurationUnit = "hour"
+	DurationMinute      DurationUnit = "minute"
+	DurationSecond      DurationUnit = "second"
+	DurationMillisecond DurationUnit = "millisecond"
+)
+
+var durationUnits = []struct {
+	unit DurationUnit
+	size time.Duration
+}{
+	{DurationDay, 24 * time.Hour},
+	{DurationHour, time.Hour},
+	{DurationMinute, time.Minute},
+	{DurationSecond, time.Second},
+	{DurationMillisecond, time.Millisecond},
+}
+
+func durationUnitIndex(u DurationUnit, def int) int {
+	for i, du := range durationUnits {
+		if du.unit == u {
+			return i
+		}
+	}
+	return def
+}
+
+// Rounding selects how a value is rounded to the precision shown.
+type Rounding int
+
+const (
+	RoundHalfUp Rounding = iota
+	RoundDown
+	RoundUp
+)
+
+// DurationStyle configures FormatDuration.
+type DurationStyle struct {
+	// Clock formats durations as "1:02:03" instead of "1 hour, 2 minutes, 3 seconds".
+	Clock bool
+	// Width selects the length of unit names.
+	Width Width
+	// Largest is the largest unit shown, defaulting to days. Larger amounts
+	// are expressed in this unit. Clock formats never show days.
+	Largest DurationUnit
+	// Smallest is the smallest unit shown, defaulting to seconds. The rest
+	// of the duration is rounded to this unit.
+	Smallest DurationUnit
+	Rounding Rounding
+}
+
+// FormatDuration formats d either in clock style or as a list of units with
+// non-zero amounts, e.g. "1 hour, 2 minutes" or "1 Std., 2 Min.".
+func FormatDuration(tag Tag, d time.Duration, style DurationStyle) string {
+	tag = baseLocale(tag)
+	largest := durationUnitIndex(style.Largest, 0)
+	smallest := durationUnitIndex(style.Smallest, 3)
+	if style.Clock && largest == 0 {
+		largest = 1
+	}
+	if smallest < largest {
+		smallest = largest
+	}
+	neg := d < 0
+	if neg {
+		d = -d
+	}
+	d = roundDuration(d, durationUnits[smallest].size, style.Rounding)
+
+	amounts := make([]int, len(durationUnits))
+	for i := largest; i <= smallest; i++ {
+		size := durationUnits[i].size
+		amounts[i] = int(d / size)
+		d -= time.Duration(amounts[i]) * size
+	}
+
+	var res string
+	if style.Clock {
+		res = formatClock(tag, amounts, largest, smallest)
+	} else {
+		var parts []string
+		for i := largest; i <= smallest; i++ {
+			if amounts[i] != 0 {
+				parts = append(parts, formatUnitInteger(tag, amounts[i], "duration-"+string(durationUnits[i].unit), style.Width))
+			}
+		}
+		if len(parts) == 0 {
+			parts = append(parts, formatUnitInteger(tag, 0, "duration-"+string(durationUnits[smallest].unit), style.Width))
+		}
+		res = joinList(tag, parts, "unit"+style.Width.suffix())
+	}
+	if neg {
+		return numberSymbolsFor(tag).minus + res
+	}
+	return res
+}
+
+func roundDuration(d time.Duration, m time.Duration, r Rounding) time.Duration {
+	rest := d % m
+	if rest == 0 {
+		return d
+	}
+	switch r {
+	case RoundDown:
+		return d - rest
+	case RoundUp:
+		return d - rest + m
+	}
+	if rest*2 >= m {
+		return d - rest + m
+	}
+	return d - rest
+}
+
+// formatClock formats hours, minutes and seconds like "1:02:03" and appends
+// milliseconds as decimals of the seconds. A single unit below hours is shown
+// without separators, e.g. "125" seconds.
+func formatClock(tag Tag, amounts []int, largest int, smallest int) string {
+	h, m, s, ms := amounts[1], amounts[2], amounts[3], amounts[4]
+	var res string
+	switch {
+	case largest >= 3:
+		res = formatInteger(tag, s)
+	case largest == 2 && smallest == 2:
+		res = formatInteger(tag, m)
+	case smallest <= 2:
+		res = formatInteger(tag, h) + ":" + pad(m, 2)
+	case largest >= 2:
+		res = formatInteger(tag, m) + ":" + pad(s, 2)
+	default:
+		res = formatInteger(tag, h) + ":" + pad(m, 2) + ":" + pad(s, 2)
+	}
+	if smallest == 4 {
+		res += numberSymbolsFor(tag).decimal + pad(ms, 3)
+	}
+	return res
+}
+
+// ParseISODuration parses an ISO 8601 duration such as "PT1H30M" or
+// "P2DT12H". Years and months are rejected as their length varies.
+func ParseISODuration(s string) (time.Duration, error) {
+	in := s
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+	if !strings.HasPrefix(s, "P") || len(s) < 2 {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %q", in)
+	}
+	s = s[1:]
+	var d float64
+	inTime := false
+	// designators holds the designators in the order they must appear,
+	// those of the time after the T.
+	designators := "YMWD"
+	for s != "" {
+		if s[0] == 'T' {
+			if inTime || len(s) == 1 {
+				return 0, fmt.Errorf("invalid ISO 8601 duration %q", in)
+			}
+			inTime = true
+			designators = "HMS"
+			s = s[1:]
+			continue
+		}
+		i := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if i <= 0 || !isDecimal(s[:i]) {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q", in)
+		}
+		v, err := strconv.ParseFloat(strings.Replace(s[:i], ",", ".", 1), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q", in)
+		}
+		c := s[i]
+		j := strings.IndexByte(designators, c)
+		if j < 0 {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q", in)
+		}
+		designators = designators[j+1:]
+		var unit time.Duration
+		switch {
+		case c == 'W':
+			unit = 7 * 24 * time.Hour
+		case c == 'D':
+			unit = 24 * time.Hour
+		case c == 'H':
+			unit = time.Hour
+		case c == 'M' && inTime:
+			unit = time.Minute
+		case c == 'S':
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("ISO 8601 duration %q has years or months, which have no fixed length", in)
+		}
+		d += v * float64(unit)
+		s = s[i+1:]
+	}
+	if d > math.MaxInt64 {
+		return 0, fmt.Errorf("ISO 8601 duration %q is out of range", in)
+	}
+	if neg {
+		d = -d
+	}
+	return time.Duration(math.Round(d)), nil
+}
+
+// isDecimal tells if s consists of digits with an optional fraction separated
+// by a point or comma, e.g. "1", "0.5" or "1,5".
+func isDecimal(s string) bool {
+	digits := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= '0' && c <= '9':
+			digits++
+		case (c == '.' || c == ',') && i > 0 && i < len(s)-1 && digits == i:
+		default:
+			return false
+		}
+	}
+	return digits > 0
+}
+
+// nodeFormatDuration formats time.Duration values, numbers of seconds and
+// ISO 8601 durations. The style consists of the keywords clock, long, short
+// and narrow, as well as largest=<unit>, smallest=<unit> and
+// round=half-up|down|up. Without a style durations are formatted as clock.
+type nodeFormatDuration struct {
+	key   string
+	style string
+}
+
+func (n nodeFormatDuration) translate(ctx *context) string {
+	v, ok := ctx.values[n.key]
+	if !ok {
+		return ""
+	}
+	var d time.Duration
+	switch v := v.(type) {
+	case time.Duration:
+		d = v
+	case string:
+		var err error
+		d, err = ParseISODuration(v)
+		if err != nil {
+			return v
+		}
+	case float32:
+		d = time.Duration(math.Round(float64(v) * float64(time.Second)))
+	case float64:
+		d = time.Duration(math.Round(v * float64(time.Second)))
+	default:
+		i, ok := toInt(v)
+		if !ok {
+			return fmt.Sprintf("%v", v)
+		}
+		d = time.Duration(i) * time.Second
+	}
+	style := DurationStyle{Clock: true}
+	for _, s := range strings.Fields(n.style) {
+		key, val := s, ""
+		if i := strings.Index(s, "="); i >= 0 {
+			key, val = s[:i], s[i+1:]
+		}
+		switch key {
+		case "clock":
+			style.Clock = true
+		case styleLong:
+			style.Clock, style.Width = false, WidthLong
+		case styleShort:
+			style.Clock, style.Width = false, WidthShort
+		case "narrow":
+			style.Clock, style.Width = false, WidthNarrow
+		case "largest":
+			style.Largest = DurationUnit(val)
+		case "smallest":
+			style.Smallest = DurationUnit(val)
+		case "round":
+			switch val {
+			case "down":
+				style.Rounding = RoundDown
+			case "up":
+				style.Rounding = RoundUp
+			default:
+				style.Rounding = RoundHalfUp
+			}
+		}
+	}
+	return FormatDuration(ctx.tag, d, style)
+}
@@ -0,0 +1,120 @@
+package icu
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatDuration(t *testing.T) {
+	requireFullData(t)
+	d := time.Hour + 2*time.Minute + 3*time.Second
+	testCases := []struct {
+		tag   Tag
+		d     time.Duration
+		style DurationStyle
+		want  string
+	}{
+		{"en", d, DurationStyle{Clock: true}, "1:02:03"},
+		{"en", 26*time.Hour + 5*time.Second, DurationStyle{Clock: true}, "26:00:05"},
+		{"en", 2*time.Minute + 3*time.Second, DurationStyle{Clock: true, Largest: DurationMinute}, "2:03"},
+		{"en", 125 * time.Second, DurationStyle{Clock: true, Largest: DurationSecond}, "125"},
+		{"en", 125*time.Second + 500*time.Millisecond, DurationStyle{Clock: true, Largest: DurationSecond, Smallest: DurationMillisecond}, "125.500"},
+		{"en", 125 * time.Minute, DurationStyle{Clock: true, Largest: DurationMinute, Smallest: DurationMinute}, "125"},
+		{"en", d + 40*time.Second, DurationStyle{Clock: true, Smallest: DurationMinute}, "1:03"},
+		{"de", d + 450*time.Millisecond, DurationStyle{Clock: true, Smallest: DurationMillisecond}, "1:02:03,450"},
+		{"en", d, DurationStyle{}, "1 hour, 2 minutes, 3 seconds"},
+		{"en", d, DurationStyle{Smallest: DurationMinute}, "1 hour, 2 minutes"},
+		{"en", d + 30*time.Second, DurationStyle{Smallest: DurationMinute}, "1 hour, 3 minutes"},
+		{"en", d + 30*time.Second, DurationStyle{Smallest: DurationMinute, Rounding: RoundDown}, "1 hour, 2 minutes"},
+		{"en", d, DurationStyle{Smallest: DurationHour, Rounding: RoundUp}, "2 hours"},
+		{"en", 49 * time.Hour, DurationStyle{}, "2 days, 1 hour"},
+		{"en", 49 * time.Hour, DurationStyle{Largest: DurationHour}, "49 hours"},
+		{"en", 0, DurationStyle{}, "0 seconds"},
+		{"en", -d, DurationStyle{Width: WidthNarrow}, "-1h 2m 3s"},
+		{"de", d, DurationStyle{Width: WidthShort, Smallest: DurationMinute}, "1 Std., 2 Min."},
+		{"de", d, DurationStyle{}, "1 Stunde, 2 Minuten und 3 Sekunden"},
+		{"de", 1500 * time.Hour, DurationStyle{Largest: DurationHour, Smallest: DurationHour}, "1.500 Stunden"},
+		{"fr", 2 * time.Hour, DurationStyle{}, "2\u00a0heures"},
+		{"es", time.Hour + time.Minute, DurationStyle{}, "1 hora y 1 minuto"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.want, func(t *testing.T) {
+			got := FormatDuration(tc.tag, tc.d, tc.style)
+			if tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParseISODuration(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want time.Duration
+		err  bool
+	}{
+		{"PT1H2M3S", time.Hour + 2*time.Minute + 3*time.Second, false},
+		{"P2DT12H", 60 * time.Hour, false},
+		{"P1W", 7 * 24 * time.Hour, false},
+		{"PT0.5S", 500 * time.Millisecond, false},
+		{"PT1,5H", 90 * time.Minute, false},
+		{"-PT30M", -30 * time.Minute, false},
+		{"P1Y", 0, true},
+		{"P1M", 0, true},
+		{"PT1D", 0, true},
+		{"PT", 0, true},
+		{"P", 0, true},
+		{"1H", 0, true},
+		{"PT1e3S", 0, true},
+		{"PTNaNS", 0, true},
+		{"PT+1S", 0, true},
+		{"PT.5S", 0, true},
+		{"PT1.S", 0, true},
+		{"PT1.2.3S", 0, true},
+		{"PT1S1H", 0, true},
+		{"PT1H1H", 0, true},
+		{"P1DT1H1D", 0, true},
+		{"P1D1W", 0, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := ParseISODuration(tc.in)
+			if tc.err != (err != nil) {
+				t.Fatalf("want error: %t, got: %v", tc.err, err)
+			}
+			if tc.want != got {
+				t.Errorf("want: %s, got: %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTranslateDuration(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		name       string
+		tag        Tag
+		message    MessageFormat
+		parameters []Parameter
+		translated string
+	}{
+		{"duration", "en", "{d, duration}", []Parameter{P("d", 62*time.Minute+3*time.Second)}, "1:02:03"},
+		{"seconds", "en", "{d, duration}", []Parameter{P("d", 3723)}, "1:02:03"},
+		{"iso", "en", "{d, duration, long}", []Parameter{P("d", "PT1H2M3S")}, "1 hour, 2 minutes, 3 seconds"},
+		{"iso:invalid", "en", "{d, duration, long}", []Parameter{P("d", "P1M")}, "P1M"},
+		{"short", "de", "{d, duration, short smallest=minute}", []Parameter{P("d", 3723)}, "1 Std., 2 Min."},
+		{"rounding", "en", "{d, duration, long, smallest=minute, round=up}", []Parameter{P("d", 3721)}, "1 hour, 3 minutes"},
+		{"largest", "en", "{d, duration, narrow largest=minute}", []Parameter{P("d", 3723.4)}, "62m 3s"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Translate(tc.tag, tc.message, tc.parameters...)
+			if err != nil {
+				t.Errorf("parse: %s", err)
+			}
+			if tc.translated != got {
+				t.Errorf("expected: '%s', got: '%s'", tc.translated, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package icu
+
+import (
+	gocontext "context"
+	"os"
+	"strings"
+)
+
+// Environment variables of the POSIX locale, in order of precedence.
+const (
+	EnvLanguage   = "LANGUAGE"
+	EnvLCAll      = "LC_ALL"
+	EnvLCMessages = "LC_MESSAGES"
+	EnvLang       = "LANG"
+)
+
+// posixModifiers maps the modifiers of POSIX locales to scripts and variants,
+// e.g. "@latin" in "sr_RS@latin". Others like "@euro" are dropped.
+var posixModifiers = map[string]string{
+	"latin":      "Latn",
+	"cyrillic":   "Cyrl",
+	"devanagari": "Deva",
+	"valencia":   "valencia",
+}
+
+// EnvironmentSpecification returns the languages of the environment for
+// messages: those listed in LANGUAGE followed by the locale of LC_ALL,
+// LC_MESSAGES or LANG, e.g. "de-CH, fr, de-DE" for "de_CH:fr" and
+// "de_DE.UTF-8". The C and POSIX locales have none.
+func EnvironmentSpecification() Specification {
+	return environmentSpecification(os.Getenv)
+}
+
+func environmentSpecification(getenv func(string) string) Specification {
+	locale := ""
+	for _, v := range []string{EnvLCAll, EnvLCMessages, EnvLang} {
+		if locale = getenv(v); locale != "" {
+			break
+		}
+	}
+	if locale == "C" || locale == "POSIX" || strings.HasPrefix(locale, "C.") {
+		return ""
+	}
+	var tags []string
+	for _, s := range append(strings.Split(getenv(EnvLanguage), ":"), locale) {
+		if t, ok := posixTag(s); ok {
+			tags = append(tags, string(t))
+		}
+	}
+	return Specification(strings.Join(tags, ", "))
+}
+
+// posixTag converts a POSIX locale like "de_DE.UTF-8@euro" to a tag like
+// "de-DE".
+func posixTag(s string) (Tag, bool) {
+	modifier := ""
+	if i := strings.Index(s, "@"); i >= 0 {
+		s, modifier = s[:i], s[i+1:]
+	}
+	if i := strings.Index(s, "."); i >= 0 {
+		s = s[:i]
+	}
+	if s == "" || s == "C" || s == "POSIX" {
+		return "", false
+	}
+	l, err := parseLocale(s)
+	if err != nil {
+		return "", false
+	}
+	if m, ok := posixModifiers[modifier]; ok {
+		if len(m) == 4 {
+			l.Script = m
+		} else {
+			l.Variants = append(l.Variants, m)
+		}
+	}
+	return l.Canonicalize().Tag(), true
+}
+
+// TranslatorForEnvironment returns the translator for the tag of the bundle
+// best matching the languages of the environment, e.g. in command line
+// programs, or else for the default tag.
+func (b *Bundle) TranslatorForEnvironment() Translator {
+	if b == nil {
+		return nilTranslator
+	}
+	if tag, c := match(EnvironmentSpecification(), b.matcher(gocontext.Background())); c != No {
+		return b.TranslatorForTag(tag)
+	}
+	return b.TranslatorForTag(b.defaultTag)
+}
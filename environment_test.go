@@ -0,0 +1,82 @@
+package icu
+
+import "testing"
+
+func TestPosixTag(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want Tag
+		ok   bool
+	}{
+		{"de_DE.UTF-8@euro", "de-DE", true},
+		{"en_US.UTF-8", "en-US", true},
+		{"fr", "fr", true},
+		{"sr_RS@latin", "sr-Latn-RS", true},
+		{"ca_ES.UTF-8@valencia", "ca-ES-valencia", true},
+		{"pt_BR", "pt-BR", true},
+		{"C.UTF-8", "", false},
+		{"POSIX", "", false},
+		{"", "", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.in, func(t *testing.T) {
+			got, ok := posixTag(tc.in)
+			if tc.want != got || tc.ok != ok {
+				t.Errorf("want: %q %t, got: %q %t", tc.want, tc.ok, got, ok)
+			}
+		})
+	}
+}
+
+func TestEnvironmentSpecification(t *testing.T) {
+	testCases := []struct {
+		name string
+		env  map[string]string
+		want Specification
+	}{
+		{"lang", map[string]string{"LANG": "de_DE.UTF-8"}, "de-DE"},
+		{"lc_messages", map[string]string{"LANG": "de_DE.UTF-8", "LC_MESSAGES": "fr_FR.UTF-8"}, "fr-FR"},
+		{"lc_all", map[string]string{"LANG": "de_DE.UTF-8", "LC_MESSAGES": "fr_FR.UTF-8", "LC_ALL": "it_IT"}, "it-IT"},
+		{"language", map[string]string{"LANG": "de_DE.UTF-8", "LANGUAGE": "de_CH:fr::en"}, "de-CH, fr, en, de-DE"},
+		{"c", map[string]string{"LANG": "C", "LANGUAGE": "de"}, ""},
+		{"empty", map[string]string{}, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			getenv := func(key string) string { return tc.env[key] }
+			if got := environmentSpecification(getenv); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTranslatorForEnvironment(t *testing.T) {
+	dir := writeBundle(t, map[string]string{
+		"en.toml": "[Translations]\nhello = \"Hello\"\n",
+		"de.toml": "[Translations]\nhello = \"Hallo\"\n",
+		"fr.toml": "[Translations]\nhello = \"Bonjour\"\n",
+	})
+	b := NewBundle(dir, "en")
+	testCases := []struct {
+		language string
+		lang     string
+		want     string
+	}{
+		{"", "de_AT.UTF-8@euro", "Hallo"},
+		{"ja:fr_CA", "de_DE.UTF-8", "Bonjour"},
+		{"", "ja_JP.UTF-8", "Hello"},
+		{"", "C", "Hello"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.language+" "+tc.lang, func(t *testing.T) {
+			t.Setenv(EnvLCAll, "")
+			t.Setenv(EnvLCMessages, "")
+			t.Setenv(EnvLanguage, tc.language)
+			t.Setenv(EnvLang, tc.lang)
+			if got := b.TranslatorForEnvironment().Translate("hello"); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package icu
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Formatter formats the value of an argument of a custom type. For
+// {amount, money, EUR} it is called with the value of amount and the
+// arguments ["EUR"].
+type Formatter func(tag Tag, value interface{}, args []string) (string, error)
+
+// Formatters maps the names of argument types to their formatters.
+type Formatters map[string]Formatter
+
+type formatterRegistry struct {
+	mu sync.RWMutex
+	m  Formatters
+	// version counts the registrations, so strict bundles can tell when to
+	// check their catalogs again.
+	version int
+}
+
+func newFormatterRegistry() *formatterRegistry {
+	return &formatterRegistry{m: Formatters{}}
+}
+
+func (r *formatterRegistry) register(name string, f Formatter) {
+	r.mu.Lock()
+	r.m[name] = f
+	r.version++
+	r.mu.Unlock()
+}
+
+func (r *formatterRegistry) currentVersion() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.version
+}
+
+func (r *formatterRegistry) lookup(name string) (Formatter, bool) {
+	if r == nil {
+		return nil, false
+	}
+	r.mu.RLock()
+	f, ok := r.m[name]
+	r.mu.RUnlock()
+	return f, ok
+}
+
+var formatters = newFormatterRegistry()
+
+// RegisterFormatter registers a formatter for arguments of the named type in
+// all messages. Formatters registered with a Bundle or passed with
+// WithFormatters take precedence. Strict bundles check their catalogs again.
+func RegisterFormatter(name string, f Formatter) {
+	formatters.register(name, f)
+}
+
+const (
+	formattersParameter = "$formatters"
+	strictParameter     = "$strict"
+)
+
+// WithFormatters returns a parameter that makes formatters available to a
+// single translation.
+func WithFormatters(fs Formatters) Parameter {
+	return P(formattersParameter, fs)
+}
+
+// Strict returns a parameter that makes a translation fail if the message
+// has arguments of types without a registered formatter.
+func Strict() Parameter {
+	return P(strictParameter, true)
+}
+
+// formatter looks up the formatter of a type in the parameters, the
+// formatters of the translator and the global registry, in this order.
+func (ctx *context) formatter(name string) (Formatter, bool) {
+	if fs, ok := ctx.values[formattersParameter].(Formatters); ok {
+		if f, ok := fs[name]; ok {
+			return f, true
+		}
+	}
+	if f, ok := ctx.formatters.lookup(name); ok {
+		return f, true
+	}
+	return formatters.lookup(name)
+}
+
+// checkTypes returns an error for the first argument of a custom type that
+// has no formatter.
+func checkTypes(n node, ctx *context) error {
+	switch n := n.(type) {
+	case nodeMessage:
+		for _, c := range n {
+			if err := checkTypes(c, ctx); err != nil {
+				return err
+			}
+		}
+	case nodeFormatCustom:
+		if _, ok := ctx.formatter(n.custom); !ok {
+			return fmt.Errorf("unknown argument type %q of %q", n.custom, n.key)
+		}
+	case nodeFormatPlural:
+		return checkCases(n.cases, ctx)
+	case nodeFormatSelectOrdinal:
+		return checkCases(n.cases, ctx)
+	case nodeFormatSelect:
+		return checkCases(n.cases, ctx)
+	}
+	return nil
+}
+
+// messageErrors parses messages and, in strict mode, checks that their
+// arguments of custom types have formatters. It returns the errors by key.
+func messageErrors(tag Tag, messages map[string]MessageFormat, fs *formatterRegistry, strict bool) map[string]error {
+	errs := map[string]error{}
+	for k, m := range messages {
+		node, err := parse(string(m))
+		if err == nil && strict {
+			ctx := newContext(tag)
+			ctx.formatters = fs
+			err = checkTypes(node, ctx)
+		}
+		if err != nil {
+			errs[k] = err
+		}
+	}
+	return errs
+}
+
+func checkCases(cases map[string]nodeMessage, ctx *context) error {
+	for _, c := range cases {
+		if err := checkTypes(c, ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
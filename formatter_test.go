@@ -0,0 +1,184 @@
+package icu
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func money(tag Tag, v interface{}, args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("money: expected a currency")
+	}
+	switch tag {
+	case "de":
+		return fmt.Sprintf("%v %s", v, args[0]), nil
+	}
+	return fmt.Sprintf("%s %v", args[0], v), nil
+}
+
+func ticket(tag Tag, v interface{}, args []string) (string, error) {
+	return fmt.Sprintf("#%v", v), nil
+}
+
+// registerFormatter registers a formatter globally until the test ends.
+func registerFormatter(t *testing.T, name string, f Formatter) {
+	t.Helper()
+	RegisterFormatter(name, f)
+	t.Cleanup(func() {
+		formatters.mu.Lock()
+		delete(formatters.m, name)
+		formatters.version++
+		formatters.mu.Unlock()
+	})
+}
+
+func TestTranslateCustomType(t *testing.T) {
+	fs := WithFormatters(Formatters{"ticket": ticket, "money": money})
+	testCases := []struct {
+		name       string
+		tag        Tag
+		message    MessageFormat
+		parameters []Parameter
+		translated string
+	}{
+		{"ticket", "en", "Ticket {id, ticket}", []Parameter{P("id", 42), fs}, "Ticket #42"},
+		{"args", "de", "{amount, money, EUR}", []Parameter{P("amount", 12), fs}, "12 EUR"},
+		{"error", "en", "{amount, money}", []Parameter{P("amount", 12), fs}, "12"},
+		{"unregistered", "en", "{amount, currency, EUR}", []Parameter{P("amount", 12)}, "12"},
+		{"plural", "en", "{n, plural, other {{id, ticket}}}", []Parameter{P("n", 2), P("id", 7), fs}, "#7"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Translate(tc.tag, tc.message, tc.parameters...)
+			if err != nil {
+				t.Errorf("parse: %s", err)
+			}
+			if tc.translated != got {
+				t.Errorf("expected: '%s', got: '%s'", tc.translated, got)
+			}
+		})
+	}
+}
+
+func TestTranslateStrict(t *testing.T) {
+	_, err := Translate("en", "{n, plural, other {{amount, currency, EUR}}}", P("n", 1), P("amount", 12), Strict())
+	if err == nil || !strings.Contains(err.Error(), `"currency"`) {
+		t.Errorf("expected an error for the unknown type, got: %v", err)
+	}
+	got, err := Translate("en", "{amount, money, EUR}", P("amount", 12), WithFormatters(Formatters{"money": money}), Strict())
+	if err != nil {
+		t.Errorf("parse: %s", err)
+	}
+	if want := "EUR 12"; want != got {
+		t.Errorf("expected: '%s', got: '%s'", want, got)
+	}
+}
+
+func TestBundleFormatters(t *testing.T) {
+	dir := t.TempDir()
+	content := "Tag = \"de\"\n[Translations]\ntotal = \"Summe: {amount, money, EUR}\"\nid = \"{id, ticket}\"\n"
+	if err := os.WriteFile(filepath.Join(dir, "de.toml"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	b := NewBundle(dir, "de")
+	b.RegisterFormatter("money", money)
+	tr := b.TranslatorForTag("de")
+	if got, want := tr.Translate("total", P("amount", 12)), "Summe: 12 EUR"; want != got {
+		t.Errorf("want: %s, got: %s", want, got)
+	}
+	if got, want := tr.Translate("id", P("id", 42)), "42"; want != got {
+		t.Errorf("want: %s, got: %s", want, got)
+	}
+
+	b.SetStrict(true)
+	tr = b.TranslatorForTag("de")
+	if got, want := tr.Translate("id", P("id", 42)), "id"; want != got {
+		t.Errorf("want: %s, got: %s", want, got)
+	}
+	if got, want := tr.Translate("total", P("amount", 12)), "Summe: 12 EUR"; want != got {
+		t.Errorf("want: %s, got: %s", want, got)
+	}
+
+	other := NewBundle(dir, "de")
+	if got, want := other.TranslatorForTag("de").Translate("total", P("amount", 12)), "Summe: 12"; want != got {
+		t.Errorf("want: %s, got: %s", want, got)
+	}
+}
+
+func TestBundleStrictValidate(t *testing.T) {
+	dir := t.TempDir()
+	content := "[Translations]\ntotal = \"Summe: {amount, money, EUR}\"\nid = \"{id, ticket}\"\n"
+	if err := os.WriteFile(filepath.Join(dir, "de.toml"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	b := NewBundle(dir, "de")
+	b.RegisterFormatter("money", money)
+	if err := b.Validate(); err != nil {
+		t.Errorf("want no error without strict mode, got: %v", err)
+	}
+
+	b.SetStrict(true)
+	var reported []error
+	b.SetErrorHandler(func(err error) { reported = append(reported, err) })
+	err := b.Validate()
+	if err == nil || !strings.Contains(err.Error(), `"ticket"`) {
+		t.Errorf("want an error for the unknown type, got: %v", err)
+	}
+	if len(reported) != 1 {
+		t.Errorf("want 1 reported error, got: %v", reported)
+	}
+	if errs := b.Errors(); len(errs) != 1 {
+		t.Errorf("want 1 error, got: %v", errs)
+	}
+
+	b.RegisterFormatter("ticket", func(tag Tag, v interface{}, args []string) (string, error) {
+		return fmt.Sprintf("#%v", v), nil
+	})
+	if err := b.Validate(); err != nil {
+		t.Errorf("want no error, got: %v", err)
+	}
+	if got, want := b.TranslatorForTag("de").Translate("id", P("id", 42)), "#42"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+}
+
+func TestRegisterFormatter(t *testing.T) {
+	registerFormatter(t, "test-ticket", ticket)
+	got, err := Translate("en", "Ticket {id, test-ticket}", P("id", 42))
+	if err != nil {
+		t.Errorf("parse: %s", err)
+	}
+	if want := "Ticket #42"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+	got, _ = Translate("en", "{id, test-ticket}", P("id", 42), WithFormatters(Formatters{"test-ticket": money}))
+	if want := "42"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+}
+
+func TestBundleStrictRegisterFormatter(t *testing.T) {
+	dir := t.TempDir()
+	content := "[Translations]\nid = \"{id, test-ticket-strict}\"\n"
+	if err := os.WriteFile(filepath.Join(dir, "de.toml"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	b := NewBundle(dir, "de")
+	b.SetStrict(true)
+	if got, want := b.TranslatorForTag("de").Translate("id", P("id", 42)), "id"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+
+	// Catalogs loaded before a global registration are checked again.
+	registerFormatter(t, "test-ticket-strict", ticket)
+	if got, want := b.TranslatorForTag("de").Translate("id", P("id", 42)), "#42"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+	if errs := b.Errors(); errs != nil {
+		t.Errorf("want no errors, got: %v", errs)
+	}
+}
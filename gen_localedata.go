@@ -0,0 +1,537 @@
+//go:build ignore
+
+// gen_localedata generates the locale data tables from the CLDR JSON snapshot
+// in the localedata directory. The -locales flag limits the tables to some
+// languages for small binaries, e.g.
+//
+//	go run gen_localedata.go -locales en,de -tags icu_subset -out localedata_subset_tables.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	dir     = flag.String("data", "localedata", "directory of the CLDR JSON snapshot")
+	out     = flag.String("out", "localedata_tables.go", "output file")
+	locales = flag.String("locales", "", "comma separated languages to include, all if empty")
+	tags    = flag.String("tags", "", "build constraint of the output file")
+)
+
+var pluralCategories = []string{"zero", "one", "two", "few", "many", "other"}
+
+// rightToLeftScripts are the scripts of bidi.go. Subsets keep the likely
+// subtags leading to them, so Tag.Direction works for all languages.
+var rightToLeftScripts = strings.Fields(`Adlm Arab Armi Avst Chrs Cprt Elym Hatr
+	Hebr Hung Khar Lydi Mand Mani Mend Merc Mero Narb Nbat Nkoo Orkh Ougr Palm
+	Phli Phlp Phnx Prti Rohg Samr Sarb Sogd Sogo Syrc Thaa Yezi`)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen_localedata: ")
+	flag.Parse()
+	g := &generator{}
+	g.load()
+	src, err := format.Source(g.generate())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type locale struct {
+	numbers   map[string]interface{}
+	gregorian map[string]interface{}
+	lists     map[string]interface{}
+	units     map[string]interface{}
+	cardinal  map[string]string
+	ordinal   map[string]string
+}
+
+type generator struct {
+	version   string
+	locales   map[string]*locale
+	parents   map[string]string
+	likely    map[string]string
+	aliases   map[string]map[string]string
+	matches   []languageMatch
+	regions   map[string]string
+	paradigms []string
+	calendars map[string]string
+}
+
+type languageMatch struct {
+	Desired   string `json:"_desired"`
+	Supported string `json:"_supported"`
+	Distance  string `json:"_distance"`
+	Oneway    string `json:"_oneway"`
+}
+
+func included(loc string) bool {
+	if *locales == "" || loc == "root" || strings.HasPrefix(loc, "und") {
+		return true
+	}
+	lang := strings.SplitN(loc, "-", 2)[0]
+	for _, l := range strings.Split(*locales, ",") {
+		if l == lang {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) locale(loc string) *locale {
+	l, ok := g.locales[loc]
+	if !ok {
+		l = &locale{}
+		g.locales[loc] = l
+	}
+	return l
+}
+
+func readJSON(path string, v interface{}) {
+	b, err := ioutil.ReadFile(filepath.Join(*dir, path))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		log.Fatalf("%s: %v", path, err)
+	}
+}
+
+// mainData reads the data of each locale of a cldr-json "main" package.
+func (g *generator) mainData(pkg string, file string, key string, f func(l *locale, v map[string]interface{})) {
+	paths, err := filepath.Glob(filepath.Join(*dir, pkg, "main", "*", file))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, p := range paths {
+		loc := filepath.Base(filepath.Dir(p))
+		if !included(loc) {
+			continue
+		}
+		var doc struct {
+			Main map[string]map[string]interface{} `json:"main"`
+		}
+		rel, _ := filepath.Rel(*dir, p)
+		readJSON(rel, &doc)
+		v, _ := doc.Main[loc][key].(map[string]interface{})
+		f(g.locale(loc), v)
+	}
+}
+
+func (g *generator) load() {
+	g.locales = map[string]*locale{}
+	g.mainData("cldr-numbers-full", "numbers.json", "numbers", func(l *locale, v map[string]interface{}) { l.numbers = v })
+	g.mainData("cldr-dates-full", "ca-gregorian.json", "dates", func(l *locale, v map[string]interface{}) {
+		l.gregorian = path(v, "calendars", "gregorian")
+	})
+	g.mainData("cldr-misc-full", "listPatterns.json", "listPatterns", func(l *locale, v map[string]interface{}) { l.lists = v })
+	g.mainData("cldr-units-full", "units.json", "units", func(l *locale, v map[string]interface{}) { l.units = v })
+
+	for _, kind := range []string{"cardinal", "ordinal"} {
+		name := map[string]string{"cardinal": "plurals.json", "ordinal": "ordinals.json"}[kind]
+		var doc struct {
+			Supplemental map[string]json.RawMessage `json:"supplemental"`
+		}
+		readJSON(filepath.Join("cldr-core", "supplemental", name), &doc)
+		var version struct {
+			CLDR string `json:"_cldrVersion"`
+		}
+		json.Unmarshal(doc.Supplemental["version"], &version)
+		g.version = version.CLDR
+		var rules map[string]map[string]string
+		json.Unmarshal(doc.Supplemental["plurals-type-"+kind], &rules)
+		for loc, rs := range rules {
+			if !included(loc) {
+				continue
+			}
+			m := map[string]string{}
+			for k, r := range rs {
+				category := strings.TrimPrefix(k, "pluralRule-count-")
+				if i := strings.Index(r, "@"); i >= 0 {
+					r = r[:i]
+				}
+				if r = strings.TrimSpace(r); r != "" {
+					m[category] = r
+				}
+			}
+			if kind == "cardinal" {
+				g.locale(loc).cardinal = m
+			} else {
+				g.locale(loc).ordinal = m
+			}
+		}
+	}
+
+	var doc struct {
+		Supplemental struct {
+			ParentLocales struct {
+				ParentLocale map[string]string `json:"parentLocale"`
+			} `json:"parentLocales"`
+		} `json:"supplemental"`
+	}
+	readJSON(filepath.Join("cldr-core", "supplemental", "parentLocales.json"), &doc)
+	g.parents = map[string]string{}
+	for k, v := range doc.Supplemental.ParentLocales.ParentLocale {
+		if included(k) {
+			g.parents[k] = v
+		}
+	}
+
+	var likely struct {
+		Supplemental struct {
+			LikelySubtags map[string]string `json:"likelySubtags"`
+		} `json:"supplemental"`
+	}
+	readJSON(filepath.Join("cldr-core", "supplemental", "likelySubtags.json"), &likely)
+	g.likely = map[string]string{}
+	for k, v := range likely.Supplemental.LikelySubtags {
+		if included(k) || rightToLeft(v) {
+			g.likely[k] = v
+		}
+	}
+
+	var aliases struct {
+		Supplemental struct {
+			Metadata struct {
+				Alias map[string]map[string]struct {
+					Replacement string `json:"_replacement"`
+				} `json:"alias"`
+			} `json:"metadata"`
+		} `json:"supplemental"`
+	}
+	readJSON(filepath.Join("cldr-core", "supplemental", "aliases.json"), &aliases)
+	g.aliases = map[string]map[string]string{}
+	for kind, as := range aliases.Supplemental.Metadata.Alias {
+		m := map[string]string{}
+		for k, a := range as {
+			// Grandfathered tags like "i-klingon" and replacements with
+			// private use subtags are not supported.
+			if kind == "languageAlias" && (!simpleAlias(k) || strings.Contains(a.Replacement, "-x-")) {
+				continue
+			}
+			m[k] = a.Replacement
+		}
+		g.aliases[kind] = m
+	}
+
+	var calendars struct {
+		Supplemental struct {
+			CalendarPreferenceData map[string]string `json:"calendarPreferenceData"`
+		} `json:"supplemental"`
+	}
+	readJSON(filepath.Join("cldr-core", "supplemental", "calendarPreferenceData.json"), &calendars)
+	g.calendars = map[string]string{}
+	for region, ordering := range calendars.Supplemental.CalendarPreferenceData {
+		// Only the most preferred calendar is used and Gregorian is the
+		// default anyway.
+		if ca := strings.Fields(ordering)[0]; ca != "gregorian" {
+			g.calendars[region] = ca
+		}
+	}
+
+	var matching struct {
+		Supplemental struct {
+			LanguageMatching struct {
+				Written []struct {
+					ParadigmLocales *struct {
+						Locales string `json:"_locales"`
+					} `json:"paradigmLocales"`
+					MatchVariable *struct {
+						ID    string `json:"_id"`
+						Value string `json:"_value"`
+					} `json:"matchVariable"`
+					LanguageMatch *languageMatch `json:"languageMatch"`
+				} `json:"written-new"`
+			} `json:"languageMatching"`
+		} `json:"supplemental"`
+	}
+	readJSON(filepath.Join("cldr-core", "supplemental", "languageMatching.json"), &matching)
+	var containment struct {
+		Supplemental struct {
+			TerritoryContainment map[string]struct {
+				Contains []string `json:"_contains"`
+			} `json:"territoryContainment"`
+		} `json:"supplemental"`
+	}
+	readJSON(filepath.Join("cldr-core", "supplemental", "territoryContainment.json"), &containment)
+	var contained func(region string) []string
+	contained = func(region string) []string {
+		rs := []string{region}
+		tc := containment.Supplemental.TerritoryContainment
+		for _, r := range append(tc[region].Contains, tc[region+"-status-grouping"].Contains...) {
+			rs = append(rs, contained(r)...)
+		}
+		return rs
+	}
+	g.regions = map[string]string{}
+	for _, w := range matching.Supplemental.LanguageMatching.Written {
+		if p := w.ParadigmLocales; p != nil {
+			for _, l := range strings.Fields(p.Locales) {
+				g.paradigms = append(g.paradigms, strconv.Quote(strings.Replace(l, "_", "-", -1)))
+			}
+		}
+		if v := w.MatchVariable; v != nil {
+			seen := map[string]bool{}
+			var rs []string
+			for _, r := range strings.Split(v.Value, "+") {
+				for _, c := range contained(r) {
+					if !seen[c] {
+						seen[c] = true
+						rs = append(rs, c)
+					}
+				}
+			}
+			sort.Strings(rs)
+			g.regions[v.ID] = strings.Join(rs, " ")
+		}
+		if m := w.LanguageMatch; m != nil {
+			m.Desired = strings.Replace(m.Desired, "_", "-", -1)
+			m.Supported = strings.Replace(m.Supported, "_", "-", -1)
+			g.matches = append(g.matches, *m)
+		}
+	}
+}
+
+// rightToLeft tells whether a maximized locale like "ar-Arab-EG" has a
+// right-to-left script.
+func rightToLeft(loc string) bool {
+	parts := strings.Split(loc, "-")
+	for _, s := range rightToLeftScripts {
+		if len(parts) > 1 && parts[1] == s {
+			return true
+		}
+	}
+	return false
+}
+
+// simpleAlias tells whether the key of a language alias is a language
+// optionally followed by a region or a variant, e.g. "iw", "sgn-DE" or
+// "hy-arevmda".
+func simpleAlias(key string) bool {
+	parts := strings.Split(key, "-")
+	switch len(parts) {
+	case 1:
+		return true
+	case 2:
+		p := parts[1]
+		return len(p) == 2 || len(p) == 3 && strings.Trim(p, "0123456789") == "" || len(p) >= 5
+	}
+	return false
+}
+
+func path(v map[string]interface{}, keys ...string) map[string]interface{} {
+	for _, k := range keys {
+		next, ok := v[k].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = next
+	}
+	return v
+}
+
+func str(v map[string]interface{}, key string) string {
+	s, _ := v[key].(string)
+	return s
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]interface{}:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]string:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*locale:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (g *generator) generate() []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen_localedata.go from CLDR %s; DO NOT EDIT.\n\n", g.version)
+	if *tags != "" {
+		fmt.Fprintf(&b, "//go:build %s\n\n", *tags)
+	}
+	fmt.Fprintf(&b, "package icu\n\n")
+	fmt.Fprintf(&b, "// Locale data, taken from CLDR %s.\n", g.version)
+	fmt.Fprintf(&b, "var localeDataTable = map[string]*localeData{\n")
+	for _, loc := range sortedKeys(g.locales) {
+		l := g.locales[loc]
+		fmt.Fprintf(&b, "%q: {\n", loc)
+		if l.numbers != nil {
+			writeNumbers(&b, l.numbers)
+		}
+		if l.gregorian != nil {
+			writeCalendar(&b, l.gregorian)
+		}
+		if l.lists != nil {
+			writeLists(&b, l.lists)
+		}
+		if l.units != nil {
+			writeUnits(&b, l.units)
+		}
+		writeRules(&b, "cardinal", l.cardinal)
+		writeRules(&b, "ordinal", l.ordinal)
+		fmt.Fprintf(&b, "},\n")
+	}
+	fmt.Fprintf(&b, "}\n")
+	writeMap(&b, "parentLocales", "Parents of locales which are not found by truncation", g.version, g.parents)
+	writeMap(&b, "likelySubtags", "Likely subtags", g.version, g.likely)
+	writeMap(&b, "languageAliases", "Replacements of deprecated and legacy language codes", g.version, g.aliases["languageAlias"])
+	writeMap(&b, "scriptAliases", "Replacements of deprecated script codes", g.version, g.aliases["scriptAlias"])
+	writeMap(&b, "territoryAliases", "Replacements of deprecated region codes, separated by spaces if a region was split", g.version, g.aliases["territoryAlias"])
+	writeMap(&b, "variantAliases", "Replacements of deprecated variants", g.version, g.aliases["variantAlias"])
+	writeMap(&b, "defaultCalendars", "Calendars preferred by regions other than the Gregorian one", g.version, g.calendars)
+	writeMap(&b, "matchRegions", "Regions of the variables in language matching rules, separated by spaces", g.version, g.regions)
+	fmt.Fprintf(&b, "\n// Locales preferred among equally close matches, taken from CLDR %s.\n", g.version)
+	fmt.Fprintf(&b, "var paradigmLocales = []Tag{%s}\n", strings.Join(g.paradigms, ", "))
+	fmt.Fprintf(&b, "\n// Language matching rules in order of precedence, taken from CLDR %s.\n", g.version)
+	fmt.Fprintf(&b, "var languageMatches = []languageMatch{\n")
+	for _, m := range g.matches {
+		fmt.Fprintf(&b, "{%q, %q, %s, %t},\n", m.Desired, m.Supported, m.Distance, m.Oneway == "true")
+	}
+	fmt.Fprintf(&b, "}\n")
+	return b.Bytes()
+}
+
+func writeMap(b *bytes.Buffer, name string, doc string, version string, m map[string]string) {
+	fmt.Fprintf(b, "\n// %s, taken from CLDR %s.\n", doc, version)
+	fmt.Fprintf(b, "var %s = map[string]string{\n", name)
+	for _, k := range sortedKeys(m) {
+		fmt.Fprintf(b, "%q: %q,\n", k, m[k])
+	}
+	fmt.Fprintf(b, "}\n")
+}
+
+func writeNumbers(b *bytes.Buffer, v map[string]interface{}) {
+	sy := path(v, "symbols-numberSystem-latn")
+	mg, err := strconv.Atoi(str(v, "minimumGroupingDigits"))
+	if err != nil {
+		mg = 1
+	}
+	fmt.Fprintf(b, "numbers: &numberSymbols{decimal: %q, group: %q, minus: %q, minimumGrouping: %d},\n",
+		str(sy, "decimal"), str(sy, "group"), str(sy, "minusSign"), mg)
+}
+
+// widths writes the symbolWidths of names keyed like "1" to "12" or "sun" to
+// "sat", up to the first missing one.
+func widths(b *bytes.Buffer, field string, v map[string]interface{}, keys []string, names map[string]string) {
+	fmt.Fprintf(b, "%s: symbolWidths{\n", field)
+	for _, w := range []string{"abbreviated", "wide", "narrow", "short"} {
+		key := w
+		if names != nil {
+			key = names[w]
+		}
+		ws := path(v, key)
+		if ws == nil {
+			continue
+		}
+		var items []string
+		for _, k := range keys {
+			if _, ok := ws[k]; !ok {
+				break
+			}
+			items = append(items, strconv.Quote(str(ws, k)))
+		}
+		fmt.Fprintf(b, "%s: []string{%s},\n", w, strings.Join(items, ", "))
+	}
+	fmt.Fprintf(b, "},\n")
+}
+
+func writeCalendar(b *bytes.Buffer, v map[string]interface{}) {
+	months := []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"}
+	days := []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+	fmt.Fprintf(b, "gregorian: &calendarSymbols{\n")
+	widths(b, "months", path(v, "months", "format"), months, nil)
+	widths(b, "monthsStandAlone", path(v, "months", "stand-alone"), months, nil)
+	widths(b, "days", path(v, "days", "format"), days, nil)
+	widths(b, "daysStandAlone", path(v, "days", "stand-alone"), days, nil)
+	widths(b, "quarters", path(v, "quarters", "format"), []string{"1", "2", "3", "4"}, nil)
+	widths(b, "dayPeriods", path(v, "dayPeriods", "format"), []string{"am", "pm"}, nil)
+	widths(b, "eras", path(v, "eras"), []string{"0", "1"}, map[string]string{"abbreviated": "eraAbbr", "wide": "eraNames", "narrow": "eraNarrow"})
+	for _, f := range []string{"timeFormats", "dateFormats"} {
+		fs := path(v, f)
+		fmt.Fprintf(b, "%s: [4]string{%q, %q, %q, %q},\n", f, str(fs, "full"), str(fs, "long"), str(fs, "medium"), str(fs, "short"))
+	}
+	fmt.Fprintf(b, "},\n")
+}
+
+func writeLists(b *bytes.Buffer, v map[string]interface{}) {
+	fmt.Fprintf(b, "lists: map[string]listPatterns{\n")
+	for _, k := range sortedKeys(v) {
+		p := path(v, k)
+		fmt.Fprintf(b, "%q: {pair: %q, start: %q, middle: %q, end: %q},\n",
+			strings.TrimPrefix(k, "listPattern-type-"), str(p, "2"), str(p, "start"), str(p, "middle"), str(p, "end"))
+	}
+	fmt.Fprintf(b, "},\n")
+}
+
+func writeUnits(b *bytes.Buffer, v map[string]interface{}) {
+	suffixes := map[string]string{"long": "", "short": "-short", "narrow": "-narrow"}
+	fmt.Fprintf(b, "units: map[string]unitPatterns{\n")
+	for _, w := range []string{"long", "short", "narrow"} {
+		us := path(v, w)
+		for _, u := range sortedKeys(us) {
+			if u == "per" || u == "times" {
+				continue
+			}
+			p := path(us, u)
+			var items []string
+			for _, c := range pluralCategories {
+				if s := str(p, "unitPattern-count-"+c); s != "" {
+					items = append(items, fmt.Sprintf("%q: %q", c, s))
+				}
+			}
+			if s := str(p, "perUnitPattern"); s != "" {
+				items = append(items, fmt.Sprintf("%q: %q", "per", s))
+			}
+			fmt.Fprintf(b, "%q: {%s},\n", u+suffixes[w], strings.Join(items, ", "))
+		}
+	}
+	fmt.Fprintf(b, "},\n")
+	fmt.Fprintf(b, "unitCompounds: map[string]string{\n")
+	for _, w := range []string{"long", "short", "narrow"} {
+		for _, k := range []string{"per", "times"} {
+			if s := str(path(v, w, k), "compoundUnitPattern"); s != "" {
+				fmt.Fprintf(b, "%q: %q,\n", k+suffixes[w], s)
+			}
+		}
+	}
+	fmt.Fprintf(b, "},\n")
+}
+
+func writeRules(b *bytes.Buffer, field string, rules map[string]string) {
+	if rules == nil {
+		return
+	}
+	var items []string
+	for _, c := range pluralCategories {
+		if r, ok := rules[c]; ok {
+			items = append(items, fmt.Sprintf("%q: %q", c, r))
+		}
+	}
+	fmt.Fprintf(b, "%s: map[string]string{%s},\n", field, strings.Join(items, ", "))
+}
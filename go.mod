@@ -1,5 +1,5 @@
 module github.com/cognicraft/icu
 
-go 1.13
+go 1.17
 
 require github.com/BurntSushi/toml v0.4.1
@@ -0,0 +1,221 @@
+package icu
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DateInterval is a range of dates or times formatted with the
+// "dateinterval" argument type, e.g. {stay, dateinterval, yMMMd}.
+type DateInterval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// defaultIntervalSkeleton is used by "dateinterval" arguments without a style.
+const defaultIntervalSkeleton = "yMMMd"
+
+type dateIntervalPatterns struct {
+	skeletons map[string]string
+	intervals map[string]map[string]string
+	fallback  string
+}
+
+func dateIntervalPatternsFor(tag Tag) *dateIntervalPatterns {
+	if t, ok := lookupLocale(tag, func(key string) bool { _, ok := dateIntervalData[key]; return ok }); ok {
+		return dateIntervalData[string(t)]
+	}
+	return dateIntervalData[TagEn]
+}
+
+// FormatDateInterval formats the range from start to end with the interval
+// formats of a skeleton like "yMMMd", repeating only the fields which
+// differ, e.g. "Jan 3 – 5, 2024" in English or "3.–5. Jan. 2024" in German.
+// Ranges within a single field of the skeleton are formatted as one date.
+func FormatDateInterval(tag Tag, start time.Time, end time.Time, skeleton string) (string, error) {
+	return formatDateInterval(baseLocale(tag), Calendar(tag), NumberingSystem(tag), start, end, skeleton)
+}
+
+func formatDateInterval(tag Tag, calendar string, numbering string, start time.Time, end time.Time, skeleton string) (string, error) {
+	ps := dateIntervalPatternsFor(tag)
+	pattern, ok := matchSkeleton(ps.skeletons, skeleton)
+	if !ok {
+		return "", fmt.Errorf("unknown date skeleton %q", skeleton)
+	}
+	end = end.In(start.Location())
+	field := greatestDifference(calendar, start, end, skeleton)
+	if field == "" {
+		return formatDateTime(tag, calendar, numbering, start, pattern), nil
+	}
+	intervals := map[string]string{}
+	for k, fs := range ps.intervals {
+		if p, ok := fs[field]; ok {
+			intervals[k] = p
+		}
+	}
+	if p, ok := matchSkeleton(intervals, skeleton); ok {
+		first, second := splitIntervalPattern(p)
+		return formatDateTime(tag, calendar, numbering, start, first) + formatDateTime(tag, calendar, numbering, end, second), nil
+	}
+	return fillList(ps.fallback, formatDateTime(tag, calendar, numbering, start, pattern), formatDateTime(tag, calendar, numbering, end, pattern)), nil
+}
+
+// matchSkeleton returns the pattern of a skeleton. Skeletons without one of
+// their own use the pattern of a skeleton with the same fields, adjusted to
+// the requested widths, e.g. "d MMMM y" for "yMMMMd" from "d MMM y" of
+// "yMMMd".
+func matchSkeleton(patterns map[string]string, skeleton string) (string, bool) {
+	if p, ok := patterns[skeleton]; ok {
+		return p, true
+	}
+	shape := skeletonShape(skeleton)
+	var best string
+	for k := range patterns {
+		if skeletonShape(k) == shape && (best == "" || k < best) {
+			best = k
+		}
+	}
+	if best == "" {
+		return "", false
+	}
+	widths := map[rune]int{}
+	forEachField(skeleton, func(r rune, n int) { widths[r] = n })
+	var b strings.Builder
+	quoted := false
+	rs := []rune(patterns[best])
+	for i := 0; i < len(rs); i++ {
+		r := rs[i]
+		if r == quote {
+			quoted = !quoted
+		}
+		if quoted || !isPatternLetter(r) {
+			b.WriteRune(r)
+			continue
+		}
+		n := 1
+		for i+1 < len(rs) && rs[i+1] == r {
+			n++
+			i++
+		}
+		if w, ok := widths[r]; ok && (w >= 3) == (n >= 3) {
+			n = w
+		}
+		b.WriteString(strings.Repeat(string(r), n))
+	}
+	return b.String(), true
+}
+
+// skeletonShape drops the widths of the fields of a skeleton but keeps
+// whether months and days are numeric or names, e.g. "yMMd" for "yMMMMd".
+func skeletonShape(skeleton string) string {
+	var b strings.Builder
+	forEachField(skeleton, func(r rune, n int) {
+		b.WriteRune(r)
+		if n >= 3 && strings.ContainsRune("MLEc", r) {
+			b.WriteRune(r)
+		}
+	})
+	return b.String()
+}
+
+func forEachField(skeleton string, f func(r rune, n int)) {
+	rs := []rune(skeleton)
+	for i := 0; i < len(rs); {
+		n := 1
+		for i+n < len(rs) && rs[i+n] == rs[i] {
+			n++
+		}
+		f(rs[i], n)
+		i += n
+	}
+}
+
+// greatestDifference returns the letter of the largest field in which start
+// and end differ, as used by the keys of interval formats. Fields count only
+// if the skeleton has them or a smaller one, so that e.g. different days do
+// not matter for "yMMM".
+func greatestDifference(calendar string, start time.Time, end time.Time, skeleton string) string {
+	a, b := dateIn(calendar, start), dateIn(calendar, end)
+	hour := "h"
+	if strings.ContainsAny(skeleton, "Hk") {
+		hour = "H"
+	}
+	fields := []struct {
+		key     string
+		letters string
+		differ  bool
+	}{
+		{"G", "G", a.era != b.era},
+		{"y", "yYu", a.year != b.year},
+		{"M", "ML", a.month != b.month},
+		{"d", "dEc", a.day != b.day},
+		{"a", "hK", start.Hour()/12 != end.Hour()/12},
+		{hour, "hHkK", start.Hour() != end.Hour()},
+		{"m", "m", start.Minute() != end.Minute()},
+		{"s", "s", start.Second() != end.Second()},
+	}
+	for i, f := range fields {
+		if !f.differ {
+			continue
+		}
+		for _, g := range fields[i:] {
+			if strings.ContainsAny(skeleton, g.letters) {
+				return f.key
+			}
+		}
+	}
+	return ""
+}
+
+// splitIntervalPattern splits an interval pattern like "MMM d – d, y" into
+// the pattern of the start and the one of the end, which begins with the
+// first field that occurs a second time.
+func splitIntervalPattern(pattern string) (string, string) {
+	seen := map[rune]bool{}
+	quoted := false
+	rs := []rune(pattern)
+	for i := 0; i < len(rs); i++ {
+		r := rs[i]
+		switch {
+		case r == quote:
+			quoted = !quoted
+		case !quoted && isPatternLetter(r):
+			if seen[r] {
+				return string(rs[:i]), string(rs[i:])
+			}
+			seen[r] = true
+			for i+1 < len(rs) && rs[i+1] == r {
+				i++
+			}
+		}
+	}
+	return pattern, ""
+}
+
+// nodeFormatDateInterval formats DateInterval values with the skeleton given
+// in the style.
+type nodeFormatDateInterval struct {
+	key   string
+	style string
+}
+
+func (n nodeFormatDateInterval) translate(ctx *context) string {
+	v, ok := ctx.values[n.key]
+	if !ok {
+		return ""
+	}
+	in, ok := v.(DateInterval)
+	if !ok {
+		return fmt.Sprintf("%v", v)
+	}
+	skeleton := n.style
+	if skeleton == "" {
+		skeleton = defaultIntervalSkeleton
+	}
+	s, err := formatDateInterval(ctx.tag, ctx.calendar, ctx.numbering, ctx.inZone(in.Start), ctx.inZone(in.End), skeleton)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return s
+}
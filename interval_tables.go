@@ -0,0 +1,1251 @@
+package icu
+
+// Skeleton patterns and interval formats of the Gregorian calendar. They
+// are maintained by hand after CLDR 48 and not generated like the locale
+// data, so languages missing here use the English formats.
+var dateIntervalData = map[string]*dateIntervalPatterns{
+	"en": {
+		skeletons: map[string]string{
+			"Bh":      "h B",
+			"Bhm":     "h:mm B",
+			"Bhms":    "h:mm:ss B",
+			"E":       "ccc",
+			"EBh":     "E h B",
+			"EBhm":    "E h:mm B",
+			"EBhms":   "E h:mm:ss B",
+			"EHm":     "E HH:mm",
+			"EHms":    "E HH:mm:ss",
+			"Ed":      "d E",
+			"Eh":      "E h\u202fa",
+			"Ehm":     "E h:mm\u202fa",
+			"Ehms":    "E h:mm:ss\u202fa",
+			"Gy":      "y G",
+			"GyM":     "M/y G",
+			"GyMEd":   "E, M/d/y G",
+			"GyMMM":   "MMM y G",
+			"GyMMMEd": "E, MMM d, y G",
+			"GyMMMd":  "MMM d, y G",
+			"GyMd":    "M/d/y G",
+			"H":       "HH",
+			"Hm":      "HH:mm",
+			"Hms":     "HH:mm:ss",
+			"Hmsv":    "HH:mm:ss v",
+			"Hmv":     "HH:mm v",
+			"Hv":      "HH'h' v",
+			"M":       "L",
+			"MEd":     "E, M/d",
+			"MMM":     "LLL",
+			"MMMEd":   "E, MMM d",
+			"MMMMd":   "MMMM d",
+			"MMMd":    "MMM d",
+			"Md":      "M/d",
+			"d":       "d",
+			"h":       "h\u202fa",
+			"hm":      "h:mm\u202fa",
+			"hms":     "h:mm:ss\u202fa",
+			"hmsv":    "h:mm:ss\u202fa v",
+			"hmv":     "h:mm\u202fa v",
+			"hv":      "h\u202fa v",
+			"ms":      "mm:ss",
+			"y":       "y",
+			"yM":      "M/y",
+			"yMEd":    "E, M/d/y",
+			"yMMM":    "MMM y",
+			"yMMMEd":  "E, MMM d, y",
+			"yMMMM":   "MMMM y",
+			"yMMMd":   "MMM d, y",
+			"yMd":     "M/d/y",
+			"yQQQ":    "QQQ y",
+			"yQQQQ":   "QQQQ y",
+		},
+		intervals: map[string]map[string]string{
+			"Bh":      {"B": "h B\u2009–\u2009h B", "h": "h\u2009–\u2009h B"},
+			"Bhm":     {"B": "h:mm B\u2009–\u2009h:mm B", "h": "h:mm\u2009–\u2009h:mm B", "m": "h:mm\u2009–\u2009h:mm B"},
+			"Gy":      {"G": "y G\u2009–\u2009y G", "y": "y\u2009–\u2009y G"},
+			"GyM":     {"G": "M/y G\u2009–\u2009M/y G", "M": "M/y\u2009–\u2009M/y G", "y": "M/y\u2009–\u2009M/y G"},
+			"GyMEd":   {"G": "E, M/d/y G\u2009–\u2009E, M/d/y G", "M": "E, M/d/y\u2009–\u2009E, M/d/y G", "d": "E, M/d/y\u2009–\u2009E, M/d/y G", "y": "E, M/d/y\u2009–\u2009E, M/d/y G"},
+			"GyMMM":   {"G": "MMM y G\u2009–\u2009MMM y G", "M": "MMM\u2009–\u2009MMM y G", "y": "MMM y\u2009–\u2009MMM y G"},
+			"GyMMMEd": {"G": "E, MMM d, y G\u2009–\u2009E, MMM d, y G", "M": "E, MMM d\u2009–\u2009E, MMM d, y G", "d": "E, MMM d\u2009–\u2009E, MMM d, y G", "y": "E, MMM d, y\u2009–\u2009E, MMM d, y G"},
+			"GyMMMd":  {"G": "MMM d, y G\u2009–\u2009MMM d, y G", "M": "MMM d\u2009–\u2009MMM d, y G", "d": "MMM d\u2009–\u2009d, y G", "y": "MMM d, y\u2009–\u2009MMM d, y G"},
+			"GyMd":    {"G": "M/d/y G\u2009–\u2009M/d/y G", "M": "M/d/y\u2009–\u2009M/d/y G", "d": "M/d/y\u2009–\u2009M/d/y G", "y": "M/d/y\u2009–\u2009M/d/y G"},
+			"H":       {"H": "HH\u2009–\u2009HH"},
+			"Hm":      {"H": "HH:mm\u2009–\u2009HH:mm", "m": "HH:mm\u2009–\u2009HH:mm"},
+			"Hmv":     {"H": "HH:mm\u2009–\u2009HH:mm v", "m": "HH:mm\u2009–\u2009HH:mm v"},
+			"Hv":      {"H": "HH\u2009–\u2009HH v"},
+			"M":       {"M": "M\u2009–\u2009M"},
+			"MEd":     {"M": "E, M/d\u2009–\u2009E, M/d", "d": "E, M/d\u2009–\u2009E, M/d"},
+			"MMM":     {"M": "MMM\u2009–\u2009MMM"},
+			"MMMEd":   {"M": "E, MMM d\u2009–\u2009E, MMM d", "d": "E, MMM d\u2009–\u2009E, MMM d"},
+			"MMMd":    {"M": "MMM d\u2009–\u2009MMM d", "d": "MMM d\u2009–\u2009d"},
+			"Md":      {"M": "M/d\u2009–\u2009M/d", "d": "M/d\u2009–\u2009M/d"},
+			"d":       {"d": "d\u2009–\u2009d"},
+			"h":       {"a": "h\u202fa\u2009–\u2009h\u202fa", "h": "h\u2009–\u2009h\u202fa"},
+			"hm":      {"a": "h:mm\u202fa\u2009–\u2009h:mm\u202fa", "h": "h:mm\u2009–\u2009h:mm\u202fa", "m": "h:mm\u2009–\u2009h:mm\u202fa"},
+			"hmv":     {"a": "h:mm\u202fa\u2009–\u2009h:mm\u202fa v", "h": "h:mm\u2009–\u2009h:mm\u202fa v", "m": "h:mm\u2009–\u2009h:mm\u202fa v"},
+			"hv":      {"a": "h\u202fa\u2009–\u2009h\u202fa v", "h": "h\u2009–\u2009h\u202fa v"},
+			"y":       {"y": "y\u2009–\u2009y"},
+			"yM":      {"M": "M/y\u2009–\u2009M/y", "y": "M/y\u2009–\u2009M/y"},
+			"yMEd":    {"M": "E, M/d/y\u2009–\u2009E, M/d/y", "d": "E, M/d/y\u2009–\u2009E, M/d/y", "y": "E, M/d/y\u2009–\u2009E, M/d/y"},
+			"yMMM":    {"M": "MMM\u2009–\u2009MMM y", "y": "MMM y\u2009–\u2009MMM y"},
+			"yMMMEd":  {"M": "E, MMM d\u2009–\u2009E, MMM d, y", "d": "E, MMM d\u2009–\u2009E, MMM d, y", "y": "E, MMM d, y\u2009–\u2009E, MMM d, y"},
+			"yMMMM":   {"M": "MMMM\u2009–\u2009MMMM y", "y": "MMMM y\u2009–\u2009MMMM y"},
+			"yMMMd":   {"M": "MMM d\u2009–\u2009MMM d, y", "d": "MMM d\u2009–\u2009d, y", "y": "MMM d, y\u2009–\u2009MMM d, y"},
+			"yMd":     {"M": "M/d/y\u2009–\u2009M/d/y", "d": "M/d/y\u2009–\u2009M/d/y", "y": "M/d/y\u2009–\u2009M/d/y"},
+		},
+		fallback: "{0}\u2009–\u2009{1}",
+	},
+	"de": {
+		skeletons: map[string]string{
+			"Bh":      "h 'Uhr' B",
+			"Bhm":     "h:mm B",
+			"Bhms":    "h:mm:ss B",
+			"E":       "ccc",
+			"EBh":     "E, h 'Uhr' B",
+			"EBhm":    "E, h:mm 'Uhr' B",
+			"EBhms":   "E, h:mm:ss 'Uhr' B",
+			"EHm":     "E, HH:mm",
+			"EHms":    "E, HH:mm:ss",
+			"Ed":      "E, d.",
+			"Eh":      "E, h\u202fa",
+			"Ehm":     "E h:mm\u202fa",
+			"Ehms":    "E, h:mm:ss\u202fa",
+			"Gy":      "y G",
+			"GyM":     "MM/y G",
+			"GyMEd":   "E, dd.MM.y G",
+			"GyMMM":   "MMM y G",
+			"GyMMMEd": "E, d. MMM y G",
+			"GyMMMd":  "d. MMM y G",
+			"GyMd":    "dd.MM.y G",
+			"H":       "HH 'Uhr'",
+			"Hm":      "HH:mm",
+			"Hms":     "HH:mm:ss",
+			"Hmsv":    "HH:mm:ss v",
+			"Hmv":     "HH:mm v",
+			"Hv":      "HH 'Uhr' v",
+			"M":       "L",
+			"MEd":     "E, d.M.",
+			"MMM":     "LLL",
+			"MMMEd":   "E, d. MMM",
+			"MMMMEd":  "E, d. MMMM",
+			"MMMMd":   "d. MMMM",
+			"MMMd":    "d. MMM",
+			"MMd":     "dd.MM.",
+			"MMdd":    "dd.MM.",
+			"Md":      "d.M.",
+			"d":       "d",
+			"h":       "h\u202fa",
+			"hm":      "h:mm\u202fa",
+			"hms":     "h:mm:ss\u202fa",
+			"hmsv":    "h:mm:ss\u202fa v",
+			"hmv":     "h:mm\u202fa v",
+			"hv":      "h\u202fa v",
+			"ms":      "mm:ss",
+			"y":       "y",
+			"yM":      "M/y",
+			"yMEd":    "E, d.M.y",
+			"yMM":     "MM/y",
+			"yMMM":    "MMM y",
+			"yMMMEd":  "E, d. MMM y",
+			"yMMMM":   "MMMM y",
+			"yMMMd":   "d. MMM y",
+			"yMMdd":   "dd.MM.y",
+			"yMd":     "d.M.y",
+			"yQQQ":    "QQQ y",
+			"yQQQQ":   "QQQQ y",
+		},
+		intervals: map[string]map[string]string{
+			"Bh":      {"B": "h 'Uhr' B\u2009–\u2009h 'Uhr' B", "h": "h–h 'Uhr' B"},
+			"Bhm":     {"B": "h:mm 'Uhr' B\u2009–\u2009h:mm 'Uhr' B", "h": "h:mm\u2009–\u2009h:mm 'Uhr' B", "m": "h:mm\u2009–\u2009h:mm 'Uhr' B"},
+			"Gy":      {"G": "y G\u2009–\u2009y G", "y": "y–y G"},
+			"GyM":     {"G": "MM/y G\u2009–\u2009MM/y G", "M": "MM/y\u2009–\u2009MM/y G", "y": "MM/y\u2009–\u2009MM/y G"},
+			"GyMEd":   {"G": "E, dd.MM.y G\u2009–\u2009E, dd.MM.y G", "M": "E, dd.MM.\u2009–\u2009E, dd.MM.y G", "d": "E, dd.MM.y\u2009–\u2009E, dd.MM.y G", "y": "E, dd.MM.y\u2009–\u2009E, dd.MM.y G"},
+			"GyMMM":   {"G": "MMM y G\u2009–\u2009MMM y G", "M": "MMM–MMM y G", "y": "MMM y\u2009–\u2009MMM y G"},
+			"GyMMMEd": {"G": "E, d. MMM y G\u2009–\u2009E E, d. MMM y G", "M": "E, d. MMM\u2009–\u2009E, d. MMM y G", "d": "E, d.\u2009–\u2009E, d. MMM y G", "y": "E, d. MMM y\u2009–\u2009E, d. MMM y G"},
+			"GyMMMd":  {"G": "d. MMM y G\u2009–\u2009d. MMM y G", "M": "d. MMM\u2009–\u2009d. MMM y G", "d": "d.–d. MMM y G", "y": "d. MMM y\u2009–\u2009d. MMM y G"},
+			"GyMd":    {"G": "dd.MM.y G\u2009–\u2009dd.MM.y G", "M": "dd.MM.\u2009–\u2009dd.MM.y G", "d": "dd.–dd.MM.y G", "y": "dd.MM.y\u2009–\u2009dd.MM.y G"},
+			"H":       {"H": "HH–HH 'Uhr'"},
+			"Hm":      {"H": "HH:mm–HH:mm 'Uhr'", "m": "HH:mm–HH:mm 'Uhr'"},
+			"Hmv":     {"H": "HH:mm–HH:mm 'Uhr' v", "m": "HH:mm–HH:mm 'Uhr' v"},
+			"Hv":      {"H": "HH–HH 'Uhr' v"},
+			"M":       {"M": "MM–MM"},
+			"MEd":     {"M": "E, dd.MM.\u2009–\u2009E, dd.MM.", "d": "E, dd.\u2009–\u2009E, dd.MM."},
+			"MMM":     {"M": "MMM–MMM"},
+			"MMMEd":   {"M": "E, d. MMM\u2009–\u2009E, d. MMM", "d": "E, d.\u2009–\u2009E, d. MMM"},
+			"MMMM":    {"M": "LLLL–LLLL"},
+			"MMMd":    {"M": "d. MMM\u2009–\u2009d. MMM", "d": "d.–d. MMM"},
+			"Md":      {"M": "dd.MM.\u2009–\u2009dd.MM.", "d": "dd.–dd.MM."},
+			"d":       {"d": "d.–d."},
+			"h":       {"a": "h\u202fa\u2009–\u2009h\u202fa", "h": "h\u2009–\u2009h\u202fa"},
+			"hm":      {"a": "h:mm\u202fa\u2009–\u2009h:mm\u202fa", "h": "h:mm–h:mm\u202fa", "m": "h:mm–h:mm\u202fa"},
+			"hmv":     {"a": "h:mm\u202fa\u2009–\u2009h:mm\u202fa v", "h": "h:mm–h:mm\u202fa v", "m": "h:mm–h:mm\u202fa v"},
+			"hv":      {"a": "h\u202fa\u2009–\u2009h\u202fa v", "h": "h–h\u202fa v"},
+			"y":       {"y": "y–y"},
+			"yM":      {"M": "M/y\u2009–\u2009M/y", "y": "M/y\u2009–\u2009M/y"},
+			"yMEd":    {"M": "E, dd.MM.\u2009–\u2009E, dd.MM.y", "d": "E, dd.\u2009–\u2009E, dd.MM.y", "y": "E, dd.MM.y\u2009–\u2009E, dd.MM.y"},
+			"yMMM":    {"M": "MMM–MMM y", "y": "MMM y\u2009–\u2009MMM y"},
+			"yMMMEd":  {"M": "E, d. MMM\u2009–\u2009E, d. MMM y", "d": "E, d.\u2009–\u2009E, d. MMM y", "y": "E, d. MMM y\u2009–\u2009E, d. MMM y"},
+			"yMMMM":   {"M": "MMMM–MMMM y", "y": "MMMM y\u2009–\u2009MMMM y"},
+			"yMMMd":   {"M": "d. MMM\u2009–\u2009d. MMM y", "d": "d.–d. MMM y", "y": "d. MMM y\u2009–\u2009d. MMM y"},
+			"yMd":     {"M": "dd.MM.\u2009–\u2009dd.MM.y", "d": "dd.–dd.MM.y", "y": "dd.MM.y\u2009–\u2009dd.MM.y"},
+		},
+		fallback: "{0}\u2009–\u2009{1}",
+	},
+	"fr": {
+		skeletons: map[string]string{
+			"Bh":      "h B",
+			"Bhm":     "h:mm B",
+			"Bhms":    "h:mm:ss B",
+			"E":       "E",
+			"EBh":     "E h B",
+			"EBhm":    "E h:mm B",
+			"EBhms":   "E h:mm:ss B",
+			"EHm":     "E HH:mm",
+			"EHms":    "E HH:mm:ss",
+			"Ed":      "E d",
+			"Eh":      "E h\u202fa",
+			"Ehm":     "E h:mm\u202fa",
+			"Ehms":    "E h:mm:ss\u202fa",
+			"Gy":      "y G",
+			"GyM":     "MM/y G",
+			"GyMEd":   "E dd/MM/y GGGGG",
+			"GyMMM":   "MMM y G",
+			"GyMMMEd": "E d MMM y G",
+			"GyMMMd":  "d MMM y G",
+			"GyMd":    "dd/MM/y GGGGG",
+			"H":       "HH 'h'",
+			"Hm":      "HH:mm",
+			"Hms":     "HH:mm:ss",
+			"Hmsv":    "HH:mm:ss v",
+			"Hmv":     "HH:mm v",
+			"Hv":      "HH 'h' v",
+			"M":       "L",
+			"MEd":     "E dd/MM",
+			"MMM":     "LLL",
+			"MMMEd":   "E d MMM",
+			"MMMMd":   "d MMMM",
+			"MMMd":    "d MMM",
+			"Md":      "dd/MM",
+			"d":       "d",
+			"h":       "h\u202fa",
+			"hm":      "h:mm\u202fa",
+			"hms":     "h:mm:ss\u202fa",
+			"hmsv":    "h:mm:ss\u202fa v",
+			"hmv":     "h:mm\u202fa v",
+			"hv":      "h\u202fa v",
+			"ms":      "mm:ss",
+			"y":       "y",
+			"yM":      "MM/y",
+			"yMEd":    "E dd/MM/y",
+			"yMMM":    "MMM y",
+			"yMMMEd":  "E d MMM y",
+			"yMMMM":   "MMMM y",
+			"yMMMd":   "d MMM y",
+			"yMd":     "dd/MM/y",
+			"yQQQ":    "QQQ y",
+			"yQQQQ":   "QQQQ y",
+		},
+		intervals: map[string]map[string]string{
+			"Bh":      {"B": "h B\u2009–\u2009h B", "h": "h\u2009–\u2009h B"},
+			"Bhm":     {"B": "h:mm B\u2009–\u2009h:mm B", "h": "h:mm\u2009–\u2009h:mm B", "m": "h:mm\u2009–\u2009h:mm B"},
+			"Gy":      {"G": "y\u00a0G 'à' y\u00a0G", "y": "y–y\u00a0G"},
+			"GyM":     {"G": "MM/y G\u2009–\u2009MM/y G", "M": "MM–MM/y G", "y": "MM/y\u2009–\u2009MM/y G"},
+			"GyMEd":   {"G": "E d/MM/y G\u2009–\u2009E d/MM/y G", "M": "E d/MM\u2009–\u2009E d/MM/y G", "d": "E\u00a0d\u2009–\u2009E d/MM/y G", "y": "E d/MM/y\u2009–\u2009E d/MM/y G"},
+			"GyMMM":   {"G": "MMM y G\u2009–\u2009MMM y G", "M": "MMM\u2009–\u2009MMM y G", "y": "MMM y\u2009–\u2009MMM y G"},
+			"GyMMMEd": {"G": "E d MMM y G\u2009–\u2009E d MMM y G", "M": "E d MMM\u2009–\u2009E d MMM y G", "d": "E d\u2009–\u2009E d MMM y G", "y": "E d MMM y\u2009–\u2009E d MMM y G"},
+			"GyMMMd":  {"G": "d MMM y G\u2009–\u2009d MMM y G", "M": "d MMM\u2009–\u2009d MMM y G", "d": "d–d\u00a0MMM\u00a0y\u00a0G", "y": "d MMM y\u2009–\u2009d MMM y G"},
+			"GyMd":    {"G": "d/MM/y G\u2009–\u2009d/MM/y G", "M": "d/MM\u2009–\u2009d/MM/y G", "d": "d–d/MM/y G", "y": "d/MM/y\u2009–\u2009d/MM/y G"},
+			"H":       {"H": "HH\u2009–\u2009HH"},
+			"Hm":      {"H": "HH:mm\u2009–\u2009HH:mm", "m": "HH:mm\u2009–\u2009HH:mm"},
+			"Hmv":     {"H": "HH:mm\u2009–\u2009HH:mm v", "m": "HH:mm\u2009–\u2009HH:mm v"},
+			"Hv":      {"H": "HH\u2009–\u2009HH v"},
+			"M":       {"M": "M–M"},
+			"MEd":     {"M": "E dd/MM\u2009–\u2009E dd/MM", "d": "E dd/MM\u2009–\u2009E dd/MM"},
+			"MMM":     {"M": "MMM–MMM"},
+			"MMMEd":   {"M": "E d MMM\u2009–\u2009E d MMM", "d": "E d\u2009–\u2009E d MMM"},
+			"MMMd":    {"M": "d MMM\u2009–\u2009d MMM", "d": "d–d MMM"},
+			"Md":      {"M": "dd/MM\u2009–\u2009dd/MM", "d": "dd/MM\u2009–\u2009dd/MM"},
+			"d":       {"d": "d–d"},
+			"h":       {"a": "h\u202fa\u2009–\u2009h\u202fa", "h": "h\u2009–\u2009h\u202fa"},
+			"hm":      {"a": "h:mm\u202fa\u2009–\u2009h:mm\u202fa", "h": "h:mm\u2009–\u2009h:mm\u202fa", "m": "h:mm\u2009–\u2009h:mm\u202fa"},
+			"hmv":     {"a": "h:mm\u202fa\u2009–\u2009h:mm\u202fa v", "h": "h:mm\u2009–\u2009h:mm\u202fa v", "m": "h:mm\u2009–\u2009h:mm\u202fa v"},
+			"hv":      {"a": "h\u202fa\u2009–\u2009h\u202fa v", "h": "h\u2009–\u2009h\u202fa v"},
+			"y":       {"y": "y–y"},
+			"yM":      {"M": "MM/y\u2009–\u2009MM/y", "y": "MM/y\u2009–\u2009MM/y"},
+			"yMEd":    {"M": "E dd/MM/y\u2009–\u2009E dd/MM/y", "d": "E dd/MM/y\u2009–\u2009E dd/MM/y", "y": "E dd/MM/y\u2009–\u2009E dd/MM/y"},
+			"yMMM":    {"M": "MMM–MMM y", "y": "MMM y\u2009–\u2009MMM y"},
+			"yMMMEd":  {"M": "E d MMM\u2009–\u2009E d MMM y", "d": "E d\u2009–\u2009E d MMM y", "y": "E d MMM y\u2009–\u2009E d MMM y"},
+			"yMMMM":   {"M": "MMMM\u2009–\u2009MMMM y", "y": "MMMM y\u2009–\u2009MMMM y"},
+			"yMMMd":   {"M": "d MMM\u2009–\u2009d MMM y", "d": "d–d MMM y", "y": "d MMM y\u2009–\u2009d MMM y"},
+			"yMd":     {"M": "dd/MM/y\u2009–\u2009dd/MM/y", "d": "dd/MM/y\u2009–\u2009dd/MM/y", "y": "dd/MM/y\u2009–\u2009dd/MM/y"},
+		},
+		fallback: "{0}\u2009–\u2009{1}",
+	},
+	"es": {
+		skeletons: map[string]string{
+			"Bh":       "h B",
+			"Bhm":      "h:mm B",
+			"Bhms":     "h:mm:ss B",
+			"E":        "ccc",
+			"EBh":      "E h B",
+			"EBhm":     "E h:mm B",
+			"EBhms":    "E h:mm:ss B",
+			"EHm":      "E, H:mm",
+			"EHms":     "E, H:mm:ss",
+			"Ed":       "E d",
+			"Eh":       "E h\u202fa",
+			"Ehm":      "E, h:mm\u202fa",
+			"Ehms":     "E, h:mm:ss\u202fa",
+			"Gy":       "y G",
+			"GyM":      "M/y G",
+			"GyMEd":    "E, d/M/y G",
+			"GyMMM":    "MMM y G",
+			"GyMMMEd":  "E, d MMM y G",
+			"GyMMMM":   "MMMM 'de' y G",
+			"GyMMMMEd": "E, d 'de' MMMM 'de' y G",
+			"GyMMMMd":  "d 'de' MMMM 'de' y G",
+			"GyMMMd":   "d MMM y G",
+			"GyMd":     "d/M/y G",
+			"H":        "H",
+			"Hm":       "H:mm",
+			"Hms":      "H:mm:ss",
+			"Hmsv":     "H:mm:ss v",
+			"Hmsvvvv":  "H:mm:ss (vvvv)",
+			"Hmv":      "H:mm v",
+			"Hv":       "H 'h' v",
+			"M":        "L",
+			"MEd":      "E, d/M",
+			"MMM":      "LLL",
+			"MMMEd":    "E, d MMM",
+			"MMMMEd":   "E, d 'de' MMMM",
+			"MMMMd":    "d 'de' MMMM",
+			"MMMd":     "d MMM",
+			"MMd":      "d/M",
+			"MMdd":     "d/M",
+			"Md":       "d/M",
+			"d":        "d",
+			"h":        "h\u202fa",
+			"hm":       "h:mm\u202fa",
+			"hms":      "h:mm:ss\u202fa",
+			"hmsv":     "h:mm:ss\u202fa v",
+			"hmsvvvv":  "h:mm:ss\u202fa (vvvv)",
+			"hmv":      "h:mm\u202fa v",
+			"hv":       "h\u202fa v",
+			"ms":       "mm:ss",
+			"y":        "y",
+			"yM":       "M/y",
+			"yMEd":     "EEE, d/M/y",
+			"yMM":      "M/y",
+			"yMMM":     "MMM y",
+			"yMMMEd":   "EEE, d MMM y",
+			"yMMMM":    "MMMM 'de' y",
+			"yMMMMEd":  "EEE, d 'de' MMMM 'de' y",
+			"yMMMMd":   "d 'de' MMMM 'de' y",
+			"yMMMd":    "d MMM y",
+			"yMd":      "d/M/y",
+			"yQQQ":     "QQQ y",
+			"yQQQQ":    "QQQQ 'de' y",
+		},
+		intervals: map[string]map[string]string{
+			"Bh":      {"B": "h B\u2009–\u2009h B", "h": "h–h B"},
+			"Bhm":     {"B": "h:mm B\u2009–\u2009h:mm B", "h": "h:mm–h:mm B", "m": "h:mm–h:mm B"},
+			"Gy":      {"G": "y G\u2009–\u2009y G", "y": "y–y G"},
+			"GyM":     {"G": "M/y G\u2009–\u2009M/y G", "M": "M/y\u2009–\u2009M/y G", "y": "M/y\u2009–\u2009M/y G"},
+			"GyMEd":   {"G": "E, d/M/y G\u2009–\u2009E, d/M/y G", "M": "E, d/M/y\u2009–\u2009E, d/M/y G", "d": "E, d/M/y\u2009–\u2009E, d/M/y G", "y": "E, d/M/y\u2009–\u2009E, d/M/y G"},
+			"GyMMM":   {"G": "MMM y G\u2009–\u2009MMM y G", "M": "MMM–MMM y G", "y": "MMM y\u2009–\u2009MMM y G"},
+			"GyMMMEd": {"G": "E, d MMM y G\u2009–\u2009E, d MMM y G", "M": "E, d MMM\u2009–\u2009E, d MMM y G", "d": "E, d MMM\u2009–\u2009E, d MMM y G", "y": "E, d MMM y\u2009–\u2009E, d MMM y G"},
+			"GyMMMd":  {"G": "d MMM y G\u2009–\u2009d MMM y G", "M": "d MMM\u2009–\u2009d MMM y G", "d": "d–d MMM y G", "y": "d MMM y\u2009–\u2009d MMM y G"},
+			"GyMd":    {"G": "d/M/y G\u2009–\u2009d/M/y G", "M": "d/M/y\u2009–\u2009d/M/y G", "d": "d/M/y\u2009–\u2009d/M/y G", "y": "d/M/y\u2009–\u2009d/M/y G"},
+			"H":       {"H": "H–H"},
+			"Hm":      {"H": "H:mm–H:mm", "m": "H:mm–H:mm"},
+			"Hmv":     {"H": "H:mm–H:mm v", "m": "H:mm–H:mm v"},
+			"Hv":      {"H": "H–H v"},
+			"M":       {"M": "M–M"},
+			"MEd":     {"M": "E, d/M\u2009–\u2009E, d/M", "d": "E, d/M\u2009–\u2009E, d/M"},
+			"MMM":     {"M": "MMM–MMM"},
+			"MMMEd":   {"M": "E, d MMM\u2009–\u2009E, d MMM", "d": "E, d MMM\u2009–\u2009E, d MMM"},
+			"MMMMEd":  {"M": "E, d 'de' MMMM\u2009–\u2009E, d 'de' MMMM", "d": "E, d 'de' MMMM\u2009–\u2009E, d 'de' MMMM"},
+			"MMMMd":   {"M": "d 'de' MMMM\u2009–\u2009d 'de' MMMM", "d": "d–d 'de' MMMM"},
+			"MMMd":    {"M": "d MMM\u2009–\u2009d MMM", "d": "d–d MMM"},
+			"Md":      {"M": "d/M\u2009–\u2009d/M", "d": "d/M\u2009–\u2009d/M"},
+			"d":       {"d": "d–d"},
+			"h":       {"a": "h\u202fa\u2009–\u2009h\u202fa", "h": "h–h\u202fa"},
+			"hm":      {"a": "h:mm\u202fa\u2009–\u2009h:mm\u202fa", "h": "h:mm\u2009–\u2009h:mm\u202fa", "m": "h:mm\u2009–\u2009h:mm\u202fa"},
+			"hmv":     {"a": "h:mm\u202fa\u2009–\u2009h:mm\u202fa v", "h": "h:mm–h:mm\u202fa v", "m": "h:mm–h:mm\u202fa v"},
+			"hv":      {"a": "h\u202fa\u2009–\u2009h\u202fa v", "h": "h–h\u202fa v"},
+			"y":       {"y": "y–y"},
+			"yM":      {"M": "M/y\u2009–\u2009M/y", "y": "M/y\u2009–\u2009M/y"},
+			"yMEd":    {"M": "E, d/M/y\u2009–\u2009E, d/M/y", "d": "E, d/M/y\u2009–\u2009E, d/M/y", "y": "E, d/M/y\u2009–\u2009E, d/M/y"},
+			"yMMM":    {"M": "MMM–MMM y", "y": "MMM y\u2009–\u2009MMM y"},
+			"yMMMEd":  {"M": "E, d MMM\u2009–\u2009E, d MMM y", "d": "E, d MMM\u2009–\u2009E, d MMM y", "y": "E, d MMM y\u2009–\u2009E, d MMM y"},
+			"yMMMM":   {"M": "MMMM–MMMM 'de' y", "y": "MMMM 'de' y\u2009–\u2009MMMM 'de' y"},
+			"yMMMMEd": {"M": "E, d 'de' MMMM\u2009–\u2009E, d 'de' MMMM 'de' y", "d": "E, d 'de' MMMM\u2009–\u2009E, d 'de' MMMM 'de' y", "y": "E, d 'de' MMMM 'de' y\u2009–\u2009E, d 'de' MMMM 'de' y"},
+			"yMMMMd":  {"M": "d 'de' MMMM\u2009–\u2009d 'de' MMMM 'de' y", "d": "d–d 'de' MMMM 'de' y", "y": "d 'de' MMMM 'de' y\u2009–\u2009d 'de' MMMM 'de' y"},
+			"yMMMd":   {"M": "d MMM\u2009–\u2009d MMM y", "d": "d–d MMM y", "y": "d MMM y\u2009–\u2009d MMM y"},
+			"yMd":     {"M": "d/M/y\u2009–\u2009d/M/y", "d": "d/M/y\u2009–\u2009d/M/y", "y": "d/M/y\u2009–\u2009d/M/y"},
+		},
+		fallback: "{0}\u2009–\u2009{1}",
+	},
+	"it": {
+		skeletons: map[string]string{
+			"Bh":      "h B",
+			"Bhm":     "h:mm B",
+			"Bhms":    "h:mm:ss B",
+			"E":       "ccc",
+			"EBh":     "E h B",
+			"EBhm":    "E h:mm B",
+			"EBhms":   "E h:mm:ss B",
+			"EHm":     "E HH:mm",
+			"EHms":    "E HH:mm:ss",
+			"Ed":      "E d",
+			"Eh":      "E h\u202fa",
+			"Ehm":     "E h:mm\u202fa",
+			"Ehms":    "E h:mm:ss\u202fa",
+			"Gy":      "y G",
+			"GyM":     "M/y G",
+			"GyMEd":   "E dd/MM/y G",
+			"GyMMM":   "MMM y G",
+			"GyMMMEd": "E d MMM y G",
+			"GyMMMd":  "d MMM y G",
+			"GyMd":    "dd/MM/y G",
+			"H":       "H",
+			"Hm":      "HH:mm",
+			"Hms":     "HH:mm:ss",
+			"Hmsv":    "HH:mm:ss v",
+			"Hmv":     "HH:mm v",
+			"Hv":      "'h'HH v",
+			"M":       "L",
+			"MEd":     "E dd/MM",
+			"MMM":     "LLL",
+			"MMMEd":   "E d MMM",
+			"MMMMd":   "d MMMM",
+			"MMMd":    "d MMM",
+			"Md":      "dd/MM",
+			"d":       "d",
+			"h":       "h\u202fa",
+			"hm":      "h:mm\u202fa",
+			"hms":     "h:mm:ss\u202fa",
+			"hmsv":    "h:mm:ss\u202fa v",
+			"hmv":     "h:mm\u202fa v",
+			"hv":      "h\u202fa v",
+			"ms":      "mm:ss",
+			"y":       "y",
+			"yM":      "MM/y",
+			"yMEd":    "E dd/MM/y",
+			"yMMM":    "MMM y",
+			"yMMMEd":  "E d MMM y",
+			"yMMMM":   "MMMM y",
+			"yMMMd":   "d MMM y",
+			"yMd":     "dd/MM/y",
+			"yQQQ":    "QQQ y",
+			"yQQQQ":   "QQQQ y",
+		},
+		intervals: map[string]map[string]string{
+			"Bh":      {"B": "h B\u2009–\u2009h B", "h": "h\u2009–\u2009h B"},
+			"Bhm":     {"B": "h:mm B\u2009–\u2009h:mm B", "h": "h:mm\u2009–\u2009h:mm B", "m": "h:mm\u2009–\u2009h:mm B"},
+			"Gy":      {"G": "y G\u2009–\u2009y G", "y": "y\u2009–\u2009y G"},
+			"GyM":     {"G": "MM/y GGGGG\u2009–\u2009MM/y GGGGG", "M": "MM/y\u2009–\u2009MM/y GGGGG", "y": "MM/y\u2009–\u2009MM/y GGGGG"},
+			"GyMEd":   {"G": "E dd/MM/y\u2009–\u2009E d/dMM/y GGGGG", "M": "E dd/MM/y\u2009–\u2009E dd/MM/y GGGGG", "d": "E dd/MM/y\u2009–\u2009E dd/MM/y GGGGG", "y": "E dd/MM/y\u2009–\u2009E dd/MM/y GGGGG"},
+			"GyMMM":   {"G": "MMM y G\u2009–\u2009MMM y G", "M": "MMM\u2009–\u2009MMM y G", "y": "MMM y\u2009–\u2009MMM y G"},
+			"GyMMMEd": {"G": "E d MMM y G\u2009–\u2009E d MMM y G", "M": "E d MMM\u2009–\u2009E d MMM y G", "d": "E d MMM\u2009–\u2009E d MMM y G", "y": "E d MMM y\u2009–\u2009E d MMM y G"},
+			"GyMMMd":  {"G": "d MMM y G\u2009–\u2009d MMM y G", "M": "d MMM\u2009–\u2009d MMM y G", "d": "d\u2009–\u2009d MMM y G", "y": "d MMM y\u2009–\u2009d MMM y G"},
+			"GyMd":    {"G": "dd/MM/y GGGGG\u2009–\u2009dd/MM/y GGGGG", "M": "dd/MM/y\u2009–\u2009dd/MM/y GGGGG", "d": "dd/MM/y\u2009–\u2009dd/MM/y GGGGG", "y": "dd/MM/y\u2009–\u2009dd/MM/y GGGGG"},
+			"H":       {"H": "HH–HH"},
+			"Hm":      {"H": "HH:mm–HH:mm", "m": "HH:mm–HH:mm"},
+			"Hmv":     {"H": "HH:mm–HH:mm v", "m": "HH:mm–HH:mm v"},
+			"Hv":      {"H": "HH–HH v"},
+			"M":       {"M": "M–M"},
+			"MEd":     {"M": "E dd/MM\u2009–\u2009E dd/MM", "d": "E dd/MM\u2009–\u2009E dd/MM"},
+			"MMM":     {"M": "MMM–MMM"},
+			"MMMEd":   {"M": "E dd MMM\u2009–\u2009E dd MMM", "d": "E dd\u2009–\u2009E dd MMM"},
+			"MMMd":    {"M": "dd MMM\u2009–\u2009dd MMM", "d": "dd–dd MMM"},
+			"Md":      {"M": "dd/MM\u2009–\u2009dd/MM", "d": "dd/MM\u2009–\u2009dd/MM"},
+			"d":       {"d": "d–d"},
+			"h":       {"a": "h\u202fa\u2009–\u2009h\u202fa", "h": "h–h\u202fa"},
+			"hm":      {"a": "h:mm\u202fa\u2009–\u2009h:mm\u202fa", "h": "h:mm–h:mm\u202fa", "m": "h:mm–h:mm\u202fa"},
+			"hmv":     {"a": "h:mm\u202fa\u2009–\u2009h:mm\u202fa v", "h": "h:mm–h:mm\u202fa v", "m": "h:mm–h:mm\u202fa v"},
+			"hv":      {"a": "h\u202fa\u2009–\u2009h\u202fa v", "h": "h–h\u202fa v"},
+			"y":       {"y": "y–y"},
+			"yM":      {"M": "MM/y\u2009–\u2009MM/y", "y": "MM/y\u2009–\u2009MM/y"},
+			"yMEd":    {"M": "E dd/MM/y\u2009–\u2009E dd/MM/y", "d": "E dd/MM/y\u2009–\u2009E dd/MM/y", "y": "E dd/MM/y\u2009–\u2009E dd/MM/y"},
+			"yMMM":    {"M": "MMM–MMM y", "y": "MMM y\u2009–\u2009MMM y"},
+			"yMMMEd":  {"M": "E d MMM\u2009–\u2009E d MMM y", "d": "E d\u2009–\u2009E d MMM y", "y": "E d MMM y\u2009–\u2009E d MMM y"},
+			"yMMMM":   {"M": "MMMM–MMMM y", "y": "MMMM y\u2009–\u2009MMMM y"},
+			"yMMMd":   {"M": "dd MMM\u2009–\u2009dd MMM y", "d": "dd–dd MMM y", "y": "dd MMM y\u2009–\u2009dd MMM y"},
+			"yMd":     {"M": "dd/MM/y\u2009–\u2009dd/MM/y", "d": "dd/MM/y\u2009–\u2009dd/MM/y", "y": "dd/MM/y\u2009–\u2009dd/MM/y"},
+		},
+		fallback: "{0}\u2009–\u2009{1}",
+	},
+	"pt": {
+		skeletons: map[string]string{
+			"Bh":      "h B",
+			"Bhm":     "h:mm B",
+			"Bhms":    "h:mm:ss B",
+			"E":       "ccc",
+			"EBh":     "E, h B",
+			"EBhm":    "E h:mm B",
+			"EBhms":   "E h:mm:ss B",
+			"EHm":     "E, HH:mm",
+			"EHms":    "E, HH:mm:ss",
+			"Ed":      "E, d",
+			"Eh":      "E, h\u202fa",
+			"Ehm":     "E, h:mm\u202fa",
+			"Ehms":    "E, h:mm:ss\u202fa",
+			"Gy":      "y G",
+			"GyM":     "MM/y G",
+			"GyMEd":   "E, MM/dd/y G",
+			"GyMMM":   "MMM 'de' y G",
+			"GyMMMEd": "E, d 'de' MMM 'de' y G",
+			"GyMMMd":  "d 'de' MMM 'de' y G",
+			"GyMd":    "dd/MM/y GGGGG",
+			"H":       "HH",
+			"Hm":      "HH:mm",
+			"Hms":     "HH:mm:ss",
+			"Hmsv":    "HH:mm:ss v",
+			"Hmv":     "HH:mm v",
+			"Hv":      "HH'h', v",
+			"M":       "L",
+			"MEd":     "E, dd/MM",
+			"MMM":     "LLL",
+			"MMMEd":   "E, d 'de' MMM",
+			"MMMMEd":  "E, d 'de' MMMM",
+			"MMMMd":   "d 'de' MMMM",
+			"MMMd":    "d 'de' MMM",
+			"MMdd":    "dd/MM",
+			"Md":      "dd/MM",
+			"d":       "d",
+			"h":       "h\u202fa",
+			"hm":      "h:mm\u202fa",
+			"hms":     "h:mm:ss\u202fa",
+			"hmsv":    "h:mm:ss\u202fa v",
+			"hmv":     "h:mm\u202fa v",
+			"hv":      "h\u202fa, v",
+			"ms":      "mm:ss",
+			"y":       "y",
+			"yM":      "MM/y",
+			"yMEd":    "E, dd/MM/y",
+			"yMM":     "MM/y",
+			"yMMM":    "MMM 'de' y",
+			"yMMMEd":  "E, d 'de' MMM 'de' y",
+			"yMMMM":   "MMMM 'de' y",
+			"yMMMMEd": "E, d 'de' MMMM 'de' y",
+			"yMMMMd":  "d 'de' MMMM 'de' y",
+			"yMMMd":   "d 'de' MMM 'de' y",
+			"yMd":     "dd/MM/y",
+			"yQQQ":    "QQQ 'de' y",
+			"yQQQQ":   "QQQQ 'de' y",
+		},
+		intervals: map[string]map[string]string{
+			"Bh":      {"B": "h B\u2009–\u2009h B", "h": "h\u2009–\u2009h B"},
+			"Bhm":     {"B": "h:mm B\u2009–\u2009h:mm B", "h": "h:mm\u2009–\u2009h:mm B", "m": "h:mm\u2009–\u2009h:mm B"},
+			"Gy":      {"G": "y G\u2009–\u2009y G", "y": "y\u2009–\u2009y G"},
+			"GyM":     {"G": "MM/y GGGGG\u2009–\u2009MM/y GGGGG", "M": "MM/y\u2009–\u2009MM/y GGGGG", "y": "MM/y\u2009–\u2009MM/y G"},
+			"GyMEd":   {"G": "E, dd/MM/y GGGGG\u2009–\u2009E, dd/MM/y GGGGG", "M": "E, dd/MM/y\u2009–\u2009E, dd/MM/y GGGGG", "d": "E, dd/MM/y\u2009–\u2009E, dd/MM/y GGGGG", "y": "E, dd/MM/y\u2009–\u2009E, dd/MM/y GGGGG"},
+			"GyMMM":   {"G": "MMM y G\u2009–\u2009MMM y G", "M": "MMM\u2009–\u2009MMM y G", "y": "MMM y\u2009–\u2009MMM y G"},
+			"GyMMMEd": {"G": "E, d 'de' MMM 'de' y G\u2009–\u2009E, d 'de' MMM 'de' y G", "M": "E, d 'de' MMM –\u2009E, d 'de' MMM 'de' y G", "d": "E, d 'de' MMM\u2009–\u2009E, d 'de' MMM 'de' y G", "y": "E, d 'de' MMM 'de' y\u2009–\u2009E, d 'de' MMM 'de' y G"},
+			"GyMMMd":  {"G": "d 'de' MMM 'de' y G\u2009–\u2009d 'de' MMM 'de' y G", "M": "dd 'de' MMM\u2009–\u2009dd 'de' MMM 'de' y G", "d": "d\u2009–\u2009d 'de' MMM, y G", "y": "d 'de' MMM 'de' y G\u2009–\u2009d 'de' MMM 'de' y G"},
+			"GyMd":    {"G": "M/d/y GGGGG\u2009–\u2009M/d/y GGGGG", "M": "M/d/y\u2009–\u2009M/d/y GGGGG", "d": "M/d/y\u2009–\u2009M/d/y GGGGG", "y": "M/d/y\u2009–\u2009M/d/y GGGGG"},
+			"H":       {"H": "HH'h' - HH'h'"},
+			"Hm":      {"H": "HH:mm\u2009–\u2009HH:mm", "m": "HH:mm\u2009–\u2009HH:mm"},
+			"Hmv":     {"H": "HH:mm\u2009–\u2009HH:mm v", "m": "HH:mm\u2009–\u2009HH:mm v"},
+			"Hv":      {"H": "HH\u2009–\u2009HH v"},
+			"M":       {"M": "M\u2009–\u2009M"},
+			"MEd":     {"M": "E, dd/MM\u2009–\u2009E, dd/MM", "d": "E, dd/MM\u2009–\u2009E, dd/MM"},
+			"MMM":     {"M": "MMM\u2009–\u2009MMM"},
+			"MMMEd":   {"M": "E, d 'de' MMM\u2009–\u2009E, d 'de' MMM", "d": "E, d 'de' MMM\u2009–\u2009E, d 'de' MMM"},
+			"MMMd":    {"M": "d 'de' MMM\u2009–\u2009d 'de' MMM", "d": "d\u2009–\u2009d 'de' MMM"},
+			"Md":      {"M": "dd/MM\u2009–\u2009dd/MM", "d": "dd/MM\u2009–\u2009dd/MM"},
+			"d":       {"d": "d\u2009–\u2009d"},
+			"h":       {"a": "h\u202fa\u2009–\u2009h\u202fa", "h": "h\u2009–\u2009h\u202fa"},
+			"hm":      {"a": "h:mm\u202fa\u2009–\u2009h:mm\u202fa", "h": "h:mm\u2009–\u2009h:mm\u202fa", "m": "h:mm\u2009–\u2009h:mm\u202fa"},
+			"hmv":     {"a": "h:mm\u202fa\u2009–\u2009h:mm\u202fa v", "h": "h:mm\u2009–\u2009h:mm\u202fa v", "m": "h:mm\u2009–\u2009h:mm\u202fa v"},
+			"hv":      {"a": "h\u202fa\u2009–\u2009h\u202fa v", "h": "h\u2009–\u2009h\u202fa v"},
+			"y":       {"y": "y\u2009–\u2009y"},
+			"yM":      {"M": "MM/y\u2009–\u2009MM/y", "y": "MM/y\u2009–\u2009MM/y"},
+			"yMEd":    {"M": "E, dd/MM/y\u2009–\u2009E, dd/MM/y", "d": "E, dd/MM/y\u2009–\u2009E, dd/MM/y", "y": "E, dd/MM/y\u2009–\u2009E, dd/MM/y"},
+			"yMMM":    {"M": "MMM\u2009–\u2009MMM 'de' y", "y": "MMM 'de' y\u2009–\u2009MMM 'de' y"},
+			"yMMMEd":  {"M": "E, d 'de' MMM\u2009–\u2009E, d 'de' MMM 'de' y", "d": "E, d 'de' MMM\u2009–\u2009E, d 'de' MMM 'de' y", "y": "E, d 'de' MMM 'de' y\u2009–\u2009E, d 'de' MMM 'de' y"},
+			"yMMMM":   {"M": "MMMM\u2009–\u2009MMMM 'de' y", "y": "MMMM 'de' y\u2009–\u2009MMMM 'de' y"},
+			"yMMMd":   {"M": "d 'de' MMM\u2009–\u2009d 'de' MMM 'de' y", "d": "d\u2009–\u2009d 'de' MMM 'de' y", "y": "d 'de' MMM 'de' y\u2009–\u2009d 'de' MMM 'de' y"},
+			"yMd":     {"M": "dd/MM/y\u2009–\u2009dd/MM/y", "d": "dd/MM/y\u2009–\u2009dd/MM/y", "y": "dd/MM/y\u2009–\u2009dd/MM/y"},
+		},
+		fallback: "{0}\u2009–\u2009{1}",
+	},
+	"bg": {
+		skeletons: map[string]string{
+			"Bh":       "h 'ч'. B",
+			"Bhm":      "h:mm 'ч'. B",
+			"Bhms":     "h:mm:ss 'ч'. B",
+			"E":        "ccc",
+			"EBh":      "E, h B",
+			"EBhm":     "E, h:mm 'ч'. B",
+			"EBhms":    "E, h:mm:ss 'ч'. B",
+			"EHm":      "E, HH:mm 'ч'.",
+			"EHms":     "E, HH:mm:ss 'ч'.",
+			"Ed":       "E, d",
+			"Eh":       "E, h\u202fa",
+			"Ehm":      "E, h:mm 'ч'. a",
+			"Ehms":     "E, h:mm:ss 'ч'. a",
+			"Gy":       "y\u202f'г'. G",
+			"GyM":      "M.y\u202f'г'. G",
+			"GyMEd":    "E, d.M.y\u202f'г'. G",
+			"GyMMM":    "MM.y\u202f'г'. G",
+			"GyMMMEd":  "E, d.MM.y\u202f'г'. G",
+			"GyMMMM":   "MMMM y\u202f'г'. G",
+			"GyMMMMEd": "E, d MMMM y\u202f'г'. G",
+			"GyMMMMd":  "d MMMM y\u202f'г'. G",
+			"GyMMMd":   "d.MM.y\u202f'г'. G",
+			"GyMd":     "dd.MM.y\u202f'г'. GGGGG",
+			"H":        "HH 'ч'.",
+			"Hm":       "HH:mm 'ч'.",
+			"Hms":      "HH:mm:ss 'ч'.",
+			"Hmsv":     "HH:mm:ss 'ч'. v",
+			"Hmv":      "HH:mm 'ч'. v",
+			"Hv":       "HH 'ч' v",
+			"M":        "L",
+			"MEd":      "E, d.MM",
+			"MMM":      "MM",
+			"MMMEd":    "E, d.MM",
+			"MMMM":     "LLLL",
+			"MMMMEd":   "E, d MMMM",
+			"MMMMd":    "d MMMM",
+			"MMMMdd":   "d MMMM",
+			"MMMd":     "d.MM",
+			"Md":       "d.MM",
+			"d":        "d",
+			"h":        "h 'ч'. a",
+			"hm":       "h:mm 'ч'. a",
+			"hms":      "h:mm:ss 'ч'. a",
+			"hmsv":     "h:mm:ss 'ч'. a v",
+			"hmv":      "h:mm 'ч'. a v",
+			"hv":       "h 'ч'. a v",
+			"ms":       "m:ss",
+			"y":        "y\u202f'г'.",
+			"yM":       "MM.y\u202f'г'.",
+			"yMEd":     "E, d.MM.y\u202f'г'.",
+			"yMMM":     "MM.y\u202f'г'.",
+			"yMMMEd":   "E, d.MM.y\u202f'г'.",
+			"yMMMM":    "MMMM y\u202f'г'.",
+			"yMMMMEd":  "E, d MMMM y\u202f'г'.",
+			"yMMMMd":   "d MMMM y\u202f'г'.",
+			"yMMMd":    "d.MM.y\u202f'г'.",
+			"yMd":      "d.MM.y\u202f'г'.",
+			"yQQQ":     "QQQ y\u202f'г'.",
+			"yQQQQ":    "QQQQ y\u202f'г'.",
+		},
+		intervals: map[string]map[string]string{
+			"Bh":      {"B": "h B\u2009–\u2009h B", "h": "h – h B"},
+			"Bhm":     {"B": "h:mm B\u2009–\u2009h:mm B", "h": "h:mm – h:mm B", "m": "h:mm – h:mm B"},
+			"Gy":      {"G": "y G – y G", "y": "y – y G"},
+			"GyM":     {"G": "MM.y GGGGG – MM.y GGGGG", "M": "MM.y – MM.y GGGGG", "y": "MM.y – MM.y GGGGG"},
+			"GyMEd":   {"G": "E, dd.MM.y GGGGG – E, dd.MM.y GGGGG", "M": "E, dd.MM.y – E, dd.MM.y GGGGG", "d": "E, dd.MM.y – E, dd.MM.y GGGGG", "y": "E, dd.MM.y – E, dd.MM.y GGGGG"},
+			"GyMMM":   {"G": "MMM y G – MMM y G", "M": "MMM – MMM y G", "y": "MMM y – MMM y G"},
+			"GyMMMEd": {"G": "E, d MMM y G – E, d MMM y G", "M": "E, d MMM – E, d MMM y G", "d": "E, d MMM – E, d MMM y G", "y": "E, d MMM y – E, d MMM y G"},
+			"GyMMMd":  {"G": "d MMM y G – d MMM y G", "M": "d MMM – d MMM y G", "d": "d – d MMM y G", "y": "d MMM y – d MMM y G"},
+			"GyMd":    {"G": "dd.MM.y GGGGG – dd.MM.y GGGGG", "M": "dd.MM.y – dd.MM.y GGGGG", "d": "dd.MM.y – dd.MM.y GGGGG", "y": "dd.MM.y – dd.MM.y GGGGG"},
+			"H":       {"H": "H – H 'ч'."},
+			"Hm":      {"H": "H:mm 'ч'. – H:mm 'ч'.", "m": "H:mm 'ч'. – H:mm 'ч'."},
+			"Hmv":     {"H": "H:mm 'ч'. – H:mm 'ч'. v", "m": "H:mm 'ч'. – H:mm 'ч'. v"},
+			"Hv":      {"H": "H – H 'ч'. v"},
+			"M":       {"M": "M – M"},
+			"MEd":     {"M": "E, d.MM – E, d.MM", "d": "E, d.MM – E, d.MM"},
+			"MMM":     {"M": "MM – MM"},
+			"MMMEd":   {"M": "E, d.MM – E, d.MM", "d": "E, d.MM – E, d.MM"},
+			"MMMM":    {"M": "LLLL – LLLL"},
+			"MMMMEd":  {"M": "E, d MMMM – E, d MMMM", "d": "E, d MMMM – E, d MMMM"},
+			"MMMMd":   {"M": "d MMMM – d MMMM", "d": "d – d MMMM"},
+			"MMMd":    {"M": "d.MM – d.MM", "d": "d.MM – d.MM"},
+			"Md":      {"M": "d.MM – d.MM", "d": "d.MM – d.MM"},
+			"d":       {"d": "d – d"},
+			"h":       {"a": "h 'ч'. a – h 'ч'. a", "h": "h 'ч'. – h 'ч'. a"},
+			"hm":      {"a": "h:mm 'ч'. a – h:mm 'ч'. a", "h": "h:mm 'ч'. – h:mm 'ч'. a", "m": "h:mm 'ч'. – h:mm 'ч'. a"},
+			"hmv":     {"a": "h:mm 'ч'. a – h:mm 'ч'. a v", "h": "h:mm 'ч'. a – h:mm 'ч'. a v", "m": "h:mm 'ч'. a – h:mm 'ч'. a v"},
+			"hv":      {"a": "h 'ч'. a – h 'ч'. a v", "h": "h 'ч'. – h 'ч'. a v"},
+			"y":       {"y": "y – y\u202f'г'."},
+			"yM":      {"M": "MM.y\u202f'г'. – MM.y\u202f'г'.", "y": "MM.y\u202f'г'. – MM.y\u202f'г'."},
+			"yMEd":    {"M": "E, d.MM – E, d.MM.y\u202f'г'.", "d": "E, d.MM – E, d.MM.y\u202f'г'.", "y": "E, d.MM.y\u202f'г'. – E, d.MM.y\u202f'г'."},
+			"yMMM":    {"M": "MM.y\u202f'г'. – MM.y\u202f'г'.", "y": "MM.y\u202f'г'. – MM.y\u202f'г'."},
+			"yMMMEd":  {"M": "E, d.MM – E, d.MM.y\u202f'г'.", "d": "E, d.MM – E, d.MM.y\u202f'г'.", "y": "E, d.MM.y\u202f'г'. – E, d.MM.y\u202f'г'."},
+			"yMMMM":   {"M": "MMMM – MMMM y\u202f'г'.", "y": "MMMM y\u202f'г'. – MMMM y\u202f'г'."},
+			"yMMMMEd": {"M": "E, d MMMM – E, d MMMM y\u202f'г'.", "d": "E, d MMMM – E, d MMMM y\u202f'г'.", "y": "E, d MMMM y\u202f'г'. – E, d MMMM y\u202f'г'."},
+			"yMMMMd":  {"M": "d MMMM – d MMMM y\u202f'г'.", "d": "d – d MMMM y\u202f'г'.", "y": "d MMMM y\u202f'г'. – d MMMM y\u202f'г'."},
+			"yMMMd":   {"M": "d.MM – d.MM.y\u202f'г'.", "d": "d.MM – d.MM.y\u202f'г'.", "y": "d.MM.y\u202f'г'. – d.MM.y\u202f'г'."},
+			"yMd":     {"M": "d.MM – d.MM.y\u202f'г'.", "d": "d.MM – d.MM.y\u202f'г'.", "y": "d.MM.y\u202f'г'. – d.MM.y\u202f'г'."},
+		},
+		fallback: "{0} – {1}",
+	},
+	"zh": {
+		skeletons: map[string]string{
+			"Bh":      "Bh时",
+			"Bhm":     "Bh:mm",
+			"Bhms":    "Bh:mm:ss",
+			"E":       "ccc",
+			"EBh":     "EBh时",
+			"EBhm":    "EBh:mm",
+			"EBhms":   "EBh:mm:ss",
+			"EHm":     "EHH:mm",
+			"EHms":    "EHH:mm:ss",
+			"Ed":      "d日E",
+			"Eh":      "Eah时",
+			"Ehm":     "Eah:mm",
+			"Ehms":    "Eah:mm:ss",
+			"Gy":      "Gy年",
+			"GyM":     "Gy年M月",
+			"GyMEd":   "Gy-MM-ddE",
+			"GyMMM":   "Gy年M月",
+			"GyMMMEd": "Gy年M月d日E",
+			"GyMMMd":  "Gy年M月d日",
+			"GyMd":    "Gy-MM-dd",
+			"H":       "H时",
+			"Hm":      "HH:mm",
+			"Hms":     "HH:mm:ss",
+			"Hmsv":    "vHH:mm:ss",
+			"Hmv":     "v HH:mm",
+			"Hv":      "vH时",
+			"M":       "M月",
+			"MEd":     "M/dE",
+			"MMM":     "LLL",
+			"MMMEd":   "M月d日E",
+			"MMMMd":   "M月d日",
+			"MMMd":    "M月d日",
+			"MMdd":    "MM/dd",
+			"Md":      "M/d",
+			"d":       "d日",
+			"h":       "ah时",
+			"hm":      "ah:mm",
+			"hms":     "ah:mm:ss",
+			"hmsv":    "vah:mm:ss",
+			"hmv":     "vah:mm",
+			"hv":      "vah时",
+			"ms":      "mm:ss",
+			"y":       "y年",
+			"yM":      "y/M",
+			"yMEEEEd": "y年M月d日EEEE",
+			"yMEd":    "y/M/dE",
+			"yMM":     "y年M月",
+			"yMMM":    "y年M月",
+			"yMMMEd":  "y年M月d日E",
+			"yMMMM":   "y年M月",
+			"yMMMd":   "y年M月d日",
+			"yMd":     "y/M/d",
+			"yQQQ":    "y年第Q季度",
+			"yQQQQ":   "y年第Q季度",
+		},
+		intervals: map[string]map[string]string{
+			"Bh":      {"B": "Bh时至Bh时", "h": "Bh时至h时"},
+			"Bhm":     {"B": "Bh:mm至Bh:mm", "h": "Bh:mm至h:mm", "m": "Bh:mm至h:mm"},
+			"Gy":      {"G": "Gy年 – Gy年", "y": "Gy年–y年"},
+			"GyM":     {"G": "GGGGGy-MM – GGGGGy-MM", "M": "GGGGGy-MM – y-MM", "y": "GGGGGy-MM – y-MM"},
+			"GyMEd":   {"G": "GGGGGy-MM-ddE – GGGGGy-MM-ddE", "M": "GGGGGy-MM-ddE – y-MM-ddE", "d": "GGGGGy-MM-ddE – y-MM-ddE", "y": "GGGGGy-MM-ddE – y-MM-ddE"},
+			"GyMMM":   {"G": "Gy年MMM – Gy年MMM", "M": "Gy年MMM–MMM", "y": "Gy年MMM – y年MMM"},
+			"GyMMMEd": {"G": "Gy年MMMd日E – Gy年MMMd日E", "M": "Gy年MMMd日E – MMMd日E", "d": "Gy年MMMd日E – MMMd日E", "y": "Gy年MMMd日E – y年MMMd日E"},
+			"GyMMMd":  {"G": "Gy年MMMd日 – Gy年MMMd日", "M": "Gy年MMMd日 – MMMd日", "d": "Gy年MMMd–d日", "y": "Gy年MMMd日 – y年MMMd日"},
+			"GyMd":    {"G": "GGGGGy-MM-dd – GGGGGy-MM-dd", "M": "GGGGGy-MM-dd – y-MM-dd", "d": "GGGGGy-MM-dd – y-MM-dd", "y": "GGGGGy-MM-dd – y-MM-dd"},
+			"H":       {"H": "HH–HH"},
+			"Hm":      {"H": "HH:mm–HH:mm", "m": "HH:mm–HH:mm"},
+			"Hmv":     {"H": "v HH:mm–HH:mm", "m": "v HH:mm–HH:mm"},
+			"Hv":      {"H": "v HH–HH"},
+			"M":       {"M": "M–M月"},
+			"MEd":     {"M": "M/dE至M/dE", "d": "M/dE至M/dE"},
+			"MMM":     {"M": "MMM – MMM"},
+			"MMMEd":   {"M": "M月d日E至M月d日E", "d": "M月d日E至d日E"},
+			"MMMd":    {"M": "M月d日至M月d日", "d": "M月d日至d日"},
+			"Md":      {"M": "M/d – M/d", "d": "M/d – M/d"},
+			"d":       {"d": "d–d日"},
+			"h":       {"a": "ah时至ah时", "h": "ah时至h时"},
+			"hm":      {"a": "ah:mm至ah:mm", "h": "ah:mm至h:mm", "m": "ah:mm至h:mm"},
+			"hmv":     {"a": "vah:mm至ah:mm", "h": "vah:mm至h:mm", "m": "vah:mm至h:mm"},
+			"hv":      {"a": "vah时至ah时", "h": "vah时至h时"},
+			"y":       {"y": "y–y年"},
+			"yM":      {"M": "y/M\u2009–\u2009y/M", "y": "y/M\u2009–\u2009y/M"},
+			"yMEd":    {"M": "y/M/dE至y/M/dE", "d": "y/M/dE至y/M/dE", "y": "y/M/dE – y/M/dE"},
+			"yMMM":    {"M": "y年MMM\u2009–\u2009MMM", "y": "y年MMM\u2009–\u2009y年MMM"},
+			"yMMMEd":  {"M": "y年M月d日E至M月d日E", "d": "y年MMMd日E\u2009–\u2009MMMd日E", "y": "y年M月d日E至y年M月d日E"},
+			"yMMMM":   {"M": "y年M月 – M月", "y": "y年M月 – y年M月"},
+			"yMMMd":   {"M": "y年MMMd日\u2009–\u2009MMMd日", "d": "y年MMMd日\u2009–\u2009d日", "y": "y年MMMd日\u2009–\u2009y年MMMd日"},
+			"yMd":     {"M": "y/M/d – y/M/d", "d": "y/M/d – y/M/d", "y": "y/M/d – y/M/d"},
+		},
+		fallback: "{0} – {1}",
+	},
+	"th": {
+		skeletons: map[string]string{
+			"Bh":         "h B",
+			"Bhm":        "h:mm B",
+			"Bhms":       "h:mm:ss B",
+			"E":          "ccc",
+			"EBh":        "E h B",
+			"EBhm":       "E h:mm B",
+			"EBhms":      "E h:mm:ss B",
+			"EHm":        "E HH:mm น.",
+			"EHms":       "E HH:mm:ss",
+			"Ed":         "E d",
+			"Eh":         "E h\u202fa",
+			"Ehm":        "E h:mm a",
+			"Ehms":       "E h:mm:ss a",
+			"Gy":         "G y",
+			"GyM":        "G y-MM",
+			"GyMEd":      "E d/M/G y",
+			"GyMMM":      "MMM G y",
+			"GyMMMEEEEd": "EEEEที่ d MMM G y",
+			"GyMMMEd":    "E d MMM G y",
+			"GyMMMd":     "d MMM G y",
+			"GyMd":       "d/M/GGGGG y",
+			"H":          "HH",
+			"Hm":         "HH:mm น.",
+			"Hms":        "HH:mm:ss",
+			"Hmsv":       "HH:mm:ss v",
+			"Hmv":        "HH:mm v",
+			"Hv":         "HH'h' v",
+			"M":          "L",
+			"MEd":        "E d/M",
+			"MMM":        "LLL",
+			"MMMEEEEd":   "EEEEที่ d MMM",
+			"MMMEd":      "E d MMM",
+			"MMMMEEEEd":  "EEEEที่ d MMMM",
+			"MMMMEd":     "E d MMMM",
+			"MMMMd":      "d MMMM",
+			"MMMd":       "d MMM",
+			"Md":         "d/M",
+			"d":          "d",
+			"h":          "h\u202fa",
+			"hm":         "h:mm a",
+			"hms":        "h:mm:ss a",
+			"hmsv":       "h:mm:ss a v",
+			"hmv":        "h:mm น. a v",
+			"hv":         "h\u202fa v",
+			"mmss":       "mm:ss",
+			"ms":         "mm:ss",
+			"y":          "y",
+			"yM":         "M/y",
+			"yMEd":       "E d/M/y",
+			"yMMM":       "MMM y",
+			"yMMMEEEEd":  "EEEEที่ d MMM y",
+			"yMMMEd":     "E d MMM y",
+			"yMMMM":      "MMMM y",
+			"yMMMMEEEEd": "EEEEที่ d MMMM y",
+			"yMMMMEd":    "E d MMMM y",
+			"yMMMMd":     "d MMMM y",
+			"yMMMd":      "d MMM y",
+			"yMd":        "d/M/y",
+			"yQQQ":       "QQQ y",
+			"yQQQQ":      "QQQQ G y",
+		},
+		intervals: map[string]map[string]string{
+			"Bh":         {"B": "h B\u2009–\u2009h B", "h": "h – h B"},
+			"Bhm":        {"B": "h:mm B\u2009–\u2009h:mm B", "h": "h:mm – h:mm B", "m": "h:mm – h:mm B"},
+			"Gy":         {"G": "G y\u2009–\u2009G y", "y": "G y–y"},
+			"GyM":        {"G": "MM/GGGGG y – MM/GGGGG y", "M": "MM/GGGGG y – MM/GGGGG y", "y": "MM/GGGGG y – MM/GGGGG y"},
+			"GyMEd":      {"G": "E d/MM/GGGGG y – E d/MM/GGGGG y", "M": "E d/MM/GGGGG y – E d/MM/GGGGG y", "d": "E d/MM/GGGGG y – E d/MM/GGGGG y", "y": "E d/MM/GGGGG y – E d/MM/GGGGG y"},
+			"GyMMM":      {"G": "MMM G y – MMM G y", "M": "MMM – MMM G y", "y": "MMM G y – MMM G y"},
+			"GyMMMEd":    {"G": "E d MMM G y – E d MMM G y", "M": "E d MMM – E d MMM G y", "d": "E d MMM – E d MMM G y", "y": "E d MMM G y – E d MMM y"},
+			"GyMMMd":     {"G": "d MMM G y – d MMM G y", "M": "d MMM – d MMM G y", "d": "d – d MMM G y", "y": "d MMM G y – d MMM y"},
+			"GyMd":       {"G": "d/MM/GGGGG y – d/MM/GGGGG y", "M": "d/MM/GGGGG y – d/MM/GGGGG y", "d": "d/MM/GGGGG y – d/MM/GGGGG y", "y": "d/MM/GGGGG y – d/MM/GGGGG y"},
+			"H":          {"H": "HH–HH"},
+			"Hm":         {"H": "HH:mm น. – HH:mm น.", "m": "HH:mm น. – HH:mm น."},
+			"Hmv":        {"H": "H:mm น. – H:mm น. v", "m": "H:mm น. – H:mm น. v"},
+			"Hv":         {"H": "HH–HH v"},
+			"M":          {"M": "M–M"},
+			"MEd":        {"M": "E d/M – E d/M", "d": "E d/M – E d/M/"},
+			"MMM":        {"M": "MMM – MMM"},
+			"MMMEEEEd":   {"M": "EEEEที่ d MMM – EEEEที่ d MMM", "d": "EEEEที่ d – EEEEที่ d MMM"},
+			"MMMEd":      {"M": "E d MMM – E d MMM", "d": "E d – E d MMM"},
+			"MMMd":       {"M": "d MMM – d MMM", "d": "d–d MMM"},
+			"Md":         {"M": "d/M – d/M", "d": "d/M – d/M"},
+			"d":          {"d": "d–d"},
+			"h":          {"a": "h\u202fa\u2009–\u2009h\u202fa", "h": "h–h\u202fa"},
+			"hm":         {"a": "h:mm a\u2009–\u2009h:mm a", "h": "h:mm–h:mm a", "m": "h:mm–h:mm a"},
+			"hmv":        {"a": "h:mm a\u2009–\u2009h:mm a v", "h": "h:mm–h:mm a v", "m": "h:mm–h:mm a v"},
+			"hv":         {"a": "h\u202fa\u2009–\u2009h\u202fa v", "h": "h–h\u202fa v"},
+			"y":          {"y": "y–y"},
+			"yM":         {"M": "M/y – M/y", "y": "M/y – M/y"},
+			"yMEd":       {"M": "E d/M/y – E d/M/y", "d": "E d/M/y – E d/M/y", "y": "E d/M/y – E d/M/y"},
+			"yMMM":       {"M": "MMM–MMM y", "y": "MMM y – MMM y"},
+			"yMMMEEEEd":  {"M": "EEEEที่ d MMM – EEEEที่ d MMM y", "d": "EEEEที่ d – EEEEที่ d MMM y", "y": "EEEEที่ d MMM y – EEEEที่ d MMM y"},
+			"yMMMEd":     {"M": "E d MMM – E d MMM y", "d": "E d MMM – E d MMM y", "y": "E d MMM y – E d MMM y"},
+			"yMMMM":      {"M": "MMMM – MMMM y", "y": "MMMM y – MMMM y"},
+			"yMMMMEEEEd": {"M": "EEEEที่ d MMMM – EEEEที่ d MMMM G y", "d": "EEEEที่ d – EEEEที่ d MMMM G y", "y": "EEEEที่ d MMMM G y – EEEEที่ d MMMM y"},
+			"yMMMMEd":    {"M": "E d MMMM\u2009–\u2009E d MMMM y", "d": "E d\u2009–\u2009E d MMMM y", "y": "E d MMMM y\u2009–\u2009E d MMMM y"},
+			"yMMMMd":     {"M": "d MMMM\u2009–\u2009d MMMM y", "d": "d–d MMMM y", "y": "d MMMM y\u2009–\u2009d MMMM y"},
+			"yMMMd":      {"M": "d MMM – d MMM y", "d": "d–d MMM y", "y": "d MMM y – d MMM y"},
+			"yMd":        {"M": "d/M/y – d/M/y", "d": "d/M/y – d/M/y", "y": "d/M/y – d/M/y"},
+		},
+		fallback: "{0} – {1}",
+	},
+	"fa": {
+		skeletons: map[string]string{
+			"Bh":         "h B",
+			"Bhm":        "h:mm B",
+			"Bhms":       "h:mm:ss B",
+			"E":          "ccc",
+			"EBh":        "E h B",
+			"EBhm":       "E h:mm B",
+			"EBhms":      "E h:mm:ss B",
+			"EHm":        "E H:mm",
+			"EHms":       "E H:mm:ss",
+			"Ed":         "E d",
+			"Eh":         "E h\u202fa",
+			"Ehm":        "E h:mm a",
+			"Ehms":       "E h:mm:ss a",
+			"Gy":         "y G",
+			"GyM":        "y/M G",
+			"GyMEd":      "E، y/M/d G",
+			"GyMMM":      "MMM y G",
+			"GyMMMEd":    "E d MMM y G",
+			"GyMMMd":     "d MMM y G",
+			"GyMd":       "y/M/d GGGGG",
+			"H":          "H",
+			"HHmmZ":      "HH:mm (Z)",
+			"Hm":         "H:mm",
+			"Hms":        "H:mm:ss",
+			"Hmsv":       "H:mm:ss v",
+			"Hmv":        "H:mm v",
+			"Hv":         "سHH v",
+			"M":          "L",
+			"MEd":        "E M/d",
+			"MMM":        "LLL",
+			"MMMEd":      "E d LLL",
+			"MMMMEd":     "E d LLLL",
+			"MMMMd":      "d LLLL",
+			"MMMd":       "d LLL",
+			"Md":         "M/d",
+			"d":          "d",
+			"h":          "h\u202fa",
+			"hm":         "h:mm a",
+			"hms":        "h:mm:ss a",
+			"hmsv":       "h:mm:ss a v",
+			"hmv":        "h:mm a v",
+			"hv":         "h\u202fa v",
+			"mmss":       "mm:ss",
+			"ms":         "m:ss",
+			"y":          "y",
+			"yM":         "y/M",
+			"yMEd":       "E y/M/d",
+			"yMMM":       "MMM y",
+			"yMMMEd":     "E d MMM y",
+			"yMMMM":      "MMMM y",
+			"yMMMMEEEEd": "EEEE d MMMM y",
+			"yMMMd":      "d MMM y",
+			"yMd":        "y/M/d",
+			"yQQQ":       "QQQQ y",
+			"yQQQQ":      "QQQQ y",
+		},
+		intervals: map[string]map[string]string{
+			"Bh":      {"B": "h B تا h B", "h": "h تا h B"},
+			"Bhm":     {"B": "h:mm B تا h:mm B", "h": "h:mm تا h:mm B", "m": "h:mm تا h:mm B"},
+			"Gy":      {"G": "y G تا y G", "y": "y تا y G"},
+			"GyM":     {"G": "y/M GGGGG تا y/M GGGGG", "M": "y/M تا y/M GGGGG", "y": "y/M تا y/M GGGGG"},
+			"GyMEd":   {"G": "E y/M/d GGGGG تا E y/M/d GGGGG", "M": "E y/M/d تا E y/M/d GGGGG", "d": "E y/M/d تا E y/M/d GGGGG", "y": "E y/M/d تا E y/M/d GGGGG"},
+			"GyMMM":   {"G": "MMM y G تا MMM y G", "M": "LLL تا MMM y G", "y": "MMM y تا MMM y G"},
+			"GyMMMEd": {"G": "E d MMM y G تا E d MMM y G", "M": "E d LLL تا E d MMM y G", "d": "E d LLL تا E d MMM y G", "y": "E d MMM y تا E d MMM y G"},
+			"GyMMMd":  {"G": "d MMM y G تا d MMM y G", "M": "d LLL تا d MMM y G", "d": "d تا d MMM y G", "y": "d MMM y تا d MMM y G"},
+			"GyMd":    {"G": "y/M/d GGGGG تا y/M/d GGGGG", "M": "y/M/d تا y/M/d GGGGG", "d": "y/M/d تا y/M/d GGGGG", "y": "y/M/d تا y/M/d GGGGG"},
+			"H":       {"H": "H تا H"},
+			"Hm":      {"H": "H:mm تا H:mm", "m": "H:mm تا H:mm"},
+			"Hmv":     {"H": "H:mm تا H:mm v", "m": "H:mm تا H:mm v"},
+			"Hv":      {"H": "H تا H v"},
+			"M":       {"M": "M تا M"},
+			"MEd":     {"M": "E M/d تا E M/d", "d": "E M/d تا E M/d"},
+			"MMM":     {"M": "LLL تا LLL"},
+			"MMMEd":   {"M": "E d LLL تا E d LLL", "d": "E d LLL تا E d LLL"},
+			"MMMd":    {"M": "d LLL تا d LLL", "d": "d تا d LLL"},
+			"Md":      {"M": "M/d تا M/d", "d": "M/d تا M/d"},
+			"d":       {"d": "d تا d"},
+			"h":       {"a": "h a تا h a", "h": "h تا h a"},
+			"hm":      {"a": "h:mm a تا h:mm a", "h": "h:mm تا h:mm a", "m": "h:mm تا h:mm a"},
+			"hmv":     {"a": "h:mm a تا h:mm a v", "h": "h:mm تا h:mm a v", "m": "h:mm تا h:mm a v"},
+			"hv":      {"a": "h a تا h a v", "h": "h تا h a v"},
+			"y":       {"y": "y تا y"},
+			"yM":      {"M": "y/M تا y/M", "y": "y/M تا y/M"},
+			"yMEd":    {"M": "E y/M/d تا E y/M/d", "d": "E y/M/d تا E y/M/d", "y": "E y/M/d تا E y/M/d"},
+			"yMMM":    {"M": "MMM\u2009تا\u2009MMM y", "y": "MMM y تا MMM y"},
+			"yMMMEd":  {"M": "E d LLL تا E d MMM y", "d": "E d LLL تا E d MMM y", "y": "E d MMM y تا E d MMM y"},
+			"yMMMM":   {"M": "MMMM تا MMMM y", "y": "MMMM y تا MMMM y"},
+			"yMMMd":   {"M": "d LLL تا d MMM y", "d": "d تا d MMM y", "y": "d MMM y تا d MMM y"},
+			"yMd":     {"M": "y/M/d تا y/M/d", "d": "y/M/d تا y/M/d", "y": "y/M/d تا y/M/d"},
+		},
+		fallback: "{0} تا {1}",
+	},
+	"ja": {
+		skeletons: map[string]string{
+			"Bh":         "BK時",
+			"Bhm":        "BK:mm",
+			"Bhms":       "BK:mm:ss",
+			"E":          "ccc",
+			"EBh":        "BK時 (E)",
+			"EBhm":       "BK:mm (E)",
+			"EBhms":      "BK:mm:ss (E)",
+			"EEEEd":      "d日EEEE",
+			"EHm":        "H:mm (E)",
+			"EHms":       "H:mm:ss (E)",
+			"Ed":         "d日(E)",
+			"Eh":         "aK時 (E)",
+			"Ehm":        "aK:mm (E)",
+			"Ehms":       "aK:mm:ss (E)",
+			"Gy":         "Gy年",
+			"GyM":        "Gy/M",
+			"GyMEd":      "Gy/M/d(E)",
+			"GyMMM":      "Gy年M月",
+			"GyMMMEEEEd": "Gy年M月d日EEEE",
+			"GyMMMEd":    "Gy年M月d日(E)",
+			"GyMMMd":     "Gy年M月d日",
+			"GyMd":       "Gy/M/d",
+			"H":          "H時",
+			"Hm":         "H:mm",
+			"Hms":        "H:mm:ss",
+			"Hmsv":       "H:mm:ss v",
+			"Hmv":        "H:mm v",
+			"Hv":         "H時 v",
+			"M":          "M月",
+			"MEEEEd":     "M/dEEEE",
+			"MEd":        "M/d(E)",
+			"MMM":        "M月",
+			"MMMEEEEd":   "M月d日EEEE",
+			"MMMEd":      "M月d日(E)",
+			"MMMMd":      "M月d日",
+			"MMMd":       "M月d日",
+			"Md":         "M/d",
+			"d":          "d日",
+			"h":          "aK時",
+			"hm":         "aK:mm",
+			"hms":        "aK:mm:ss",
+			"hmsv":       "aK:mm:ss v",
+			"hmv":        "aK:mm v",
+			"hv":         "aK時 v",
+			"ms":         "mm:ss",
+			"y":          "y年",
+			"yM":         "y/M",
+			"yMEEEEd":    "y/M/dEEEE",
+			"yMEd":       "y/M/d(E)",
+			"yMM":        "y/MM",
+			"yMMM":       "y年M月",
+			"yMMMEEEEd":  "y年M月d日EEEE",
+			"yMMMEd":     "y年M月d日(E)",
+			"yMMMM":      "y年M月",
+			"yMMMd":      "y年M月d日",
+			"yMd":        "y/M/d",
+			"yQQQ":       "y/QQQ",
+			"yQQQQ":      "y年QQQQ",
+		},
+		intervals: map[string]map[string]string{
+			"Bh":      {"B": "BK時～BK時", "h": "BK時～K時"},
+			"Bhm":     {"B": "BK:mm～BK:mm", "h": "BK:mm～K:mm", "m": "BK:mm～K:mm"},
+			"Gy":      {"G": "Gy年～Gy年", "y": "Gy年～y年"},
+			"GyM":     {"G": "Gy/MM～Gy/MM", "M": "Gy/MM～y/MM", "y": "Gy/MM～y/MM"},
+			"GyMEd":   {"G": "Gy/MM/dd(E)～Gy/MM/dd(E)", "M": "Gy/MM/dd(E)～y/MM/dd(E)", "d": "Gy/MM/dd(E)～y/MM/dd(E)", "y": "Gy/MM/dd(E)～y/MM/dd(E)"},
+			"GyMMM":   {"G": "Gy年M月～Gy年M月", "M": "Gy年M月～M月", "y": "Gy年M月～y年M月"},
+			"GyMMMEd": {"G": "Gy年M月d日(E)～Gy年M月d日(E)", "M": "Gy年M月d日(E)～M月d日(E)", "d": "Gy年M月d日(E)～d日(E)", "y": "Gy年M月d日(E)～y年M月d日(E)"},
+			"GyMMMd":  {"G": "Gy年M月d日～Gy年M月d日", "M": "Gy年M月d日～M月d日", "d": "Gy年M月d日～d日", "y": "Gy年M月d日～y年M月d日"},
+			"GyMd":    {"G": "Gy/MM/dd～Gy/MM/dd", "M": "Gy/MM/dd～y/MM/dd", "d": "Gy/MM/dd～y/MM/dd", "y": "Gy/MM/dd～y/MM/dd"},
+			"H":       {"H": "H時～H時"},
+			"Hm":      {"H": "H時mm分～H時mm分", "m": "H時mm分～H時mm分"},
+			"Hmv":     {"H": "H時mm分～H時mm分(v)", "m": "H時mm分～H時mm分(v)"},
+			"Hv":      {"H": "H時～H時(v)"},
+			"M":       {"M": "M月～M月"},
+			"MEd":     {"M": "MM/dd(E)～MM/dd(E)", "d": "MM/dd(E)～MM/dd(E)"},
+			"MMM":     {"M": "M月～M月"},
+			"MMMEd":   {"M": "M月d日(E)～M月d日(E)", "d": "M月d日(E)～d日(E)"},
+			"MMMM":    {"M": "M月～M月"},
+			"MMMd":    {"M": "M月d日～M月d日", "d": "M月d日～d日"},
+			"Md":      {"M": "MM/dd～MM/dd", "d": "MM/dd～MM/dd"},
+			"d":       {"d": "d日～d日"},
+			"h":       {"a": "aK時～aK時", "h": "aK時～K時"},
+			"hm":      {"a": "aK時mm分～aK時mm分", "h": "aK時mm分～K時mm分", "m": "aK時mm分～K時mm分"},
+			"hmv":     {"a": "aK時mm分～aK時mm分(v)", "h": "aK時mm分～K時mm分(v)", "m": "aK時mm分～K時mm分(v)"},
+			"hv":      {"a": "aK時～aK時(v)", "h": "aK時～K時(v)"},
+			"y":       {"y": "y年～y年"},
+			"yM":      {"M": "y/MM～y/MM", "y": "y/MM～y/MM"},
+			"yMEd":    {"M": "y/MM/dd(E)～y/MM/dd(E)", "d": "y/MM/dd(E)～y/MM/dd(E)", "y": "y/MM/dd(E)～y/MM/dd(E)"},
+			"yMMM":    {"M": "y年M月～M月", "y": "y年M月～y年M月"},
+			"yMMMEd":  {"M": "y年M月d日(E)～M月d日(E)", "d": "y年M月d日(E)～d日(E)", "y": "y年M月d日(E)～y年M月d日(E)"},
+			"yMMMM":   {"M": "y年M月～M月", "y": "y年M月～y年M月"},
+			"yMMMd":   {"M": "y年M月d日～M月d日", "d": "y年M月d日～d日", "y": "y年M月d日～y年M月d日"},
+			"yMd":     {"M": "y/MM/dd～y/MM/dd", "d": "y/MM/dd～y/MM/dd", "y": "y/MM/dd～y/MM/dd"},
+		},
+		fallback: "{0}～{1}",
+	},
+	"he": {
+		skeletons: map[string]string{
+			"Bh":      "h B",
+			"Bhm":     "h:mm B",
+			"Bhms":    "h:mm:ss B",
+			"E":       "ccc",
+			"EBh":     "E h B",
+			"EBhm":    "E h:mm B",
+			"EBhms":   "E h:mm:ss B",
+			"EHm":     "E H:mm",
+			"EHms":    "E H:mm:ss",
+			"Ed":      "E ה-d",
+			"Eh":      "E h\u202fa",
+			"Ehm":     "E h:mm a",
+			"Ehms":    "E h:mm:ss a",
+			"Gy":      "y G",
+			"GyM":     "G y-MM",
+			"GyMEd":   "G y-MM-dd, E",
+			"GyMMM":   "MMM y G",
+			"GyMMMEd": "E, d בMMM y G",
+			"GyMMMd":  "d בMMM y G",
+			"GyMd":    "d/M/y G",
+			"H":       "H",
+			"Hm":      "H:mm",
+			"Hms":     "H:mm:ss",
+			"Hmsv":    "HH:mm:ss v",
+			"Hmv":     "HH:mm v",
+			"Hv":      "HH'h' v",
+			"M":       "L",
+			"MEd":     "E, d.M",
+			"MMM":     "LLL",
+			"MMMEd":   "E, d בMMM",
+			"MMMMd":   "d בMMMM",
+			"MMMd":    "d בMMM",
+			"Md":      "d.M",
+			"d":       "d",
+			"h":       "\u200fh a",
+			"hm":      "h:mm a",
+			"hms":     "h:mm:ss a",
+			"hmsv":    "h:mm:ss a v",
+			"hmv":     "h:mm a v",
+			"hv":      "h\u202fa v",
+			"ms":      "mm:ss",
+			"y":       "y",
+			"yM":      "M.y",
+			"yMEd":    "E, d.M.y",
+			"yMM":     "M.y",
+			"yMMM":    "MMM y",
+			"yMMMEd":  "E, d בMMM y",
+			"yMMMM":   "MMMM y",
+			"yMMMd":   "d בMMM y",
+			"yMd":     "d.M.y",
+			"yQQQ":    "QQQ y",
+			"yQQQQ":   "QQQQ y",
+		},
+		intervals: map[string]map[string]string{
+			"Bh":      {"B": "h B\u2009–\u2009h B", "h": "h–h B"},
+			"Bhm":     {"B": "h:mm B\u2009–\u2009h:mm B", "h": "h:mm–h:mm B", "m": "h:mm–h:mm B"},
+			"Gy":      {"G": "y G – y G", "y": "y – y G"},
+			"GyM":     {"G": "M.y GGGGG – M.y GGGGG", "M": "M.y – M.y G", "y": "M.y – M.y G"},
+			"GyMEd":   {"G": "E, d.M.y G – E, d.M.y G", "M": "E, d.M.y – E, d.M.y G", "d": "E, d.M.y – E, d.M.y G", "y": "E, d.M.y – E, d.M.y GGGGG"},
+			"GyMMM":   {"G": "MMM y G – MMM y G", "M": "MMM – MMM y G", "y": "MMM y – MMM y G"},
+			"GyMMMEd": {"G": "E, d בMMM y G – E, d בMMM y G", "M": "E, d בMMM – E, d בMMM y G", "d": "E, d בMMM – E, d בMMM y G", "y": "E, d בMMM y – E, d בMMM y G"},
+			"GyMMMd":  {"G": "d בMMM y G – d בMMM y G", "M": "d בMMM – d בMMM y G", "d": "d – d בMMM y G", "y": "d בMMM y – d בMMM y G"},
+			"GyMd":    {"G": "d.M.y G – d.M.y G", "M": "d.M.y – d.M.y G", "d": "d.M.y – d.M.y G", "y": "d.M.y – d.M.y G"},
+			"H":       {"H": "H–H"},
+			"Hm":      {"H": "H:mm–H:mm", "m": "H:mm–H:mm"},
+			"Hmv":     {"H": "H:mm–H:mm v", "m": "H:mm–H:mm v"},
+			"Hv":      {"H": "H–H v"},
+			"M":       {"M": "M–M"},
+			"MEd":     {"M": "EEEE d.M – EEEE d.M", "d": "EEEE d.M–EEEE d.M"},
+			"MMM":     {"M": "MMM–MMM"},
+			"MMMEd":   {"M": "EEEE, d בMMM – EEEE, d בMMM", "d": "EEEE, d בMMM – EEEE, d בMMM"},
+			"MMMM":    {"M": "LLLL–LLLL"},
+			"MMMd":    {"M": "d בMMM–d בMMM", "d": "d–d בMMM"},
+			"Md":      {"M": "d.M–d.M", "d": "d.M–d.M"},
+			"d":       {"d": "d–d"},
+			"h":       {"a": "h\u202fa\u2009–\u2009h\u202fa", "h": "h–h\u202fa"},
+			"hm":      {"a": "h:mm a\u2009–\u2009h:mm a", "h": "h:mm–h:mm a", "m": "h:mm–h:mm a"},
+			"hmv":     {"a": "h:mm a\u2009–\u2009h:mm a v", "h": "h:mm–h:mm a v", "m": "h:mm–h:mm a v"},
+			"hv":      {"a": "h\u202fa\u2009–\u2009h\u202fa v", "h": "h–h\u202fa v"},
+			"y":       {"y": "y–y"},
+			"yM":      {"M": "M.y–M.y", "y": "M.y\u200f–M.y"},
+			"yMEd":    {"M": "EEEE d.M.y – EEEE d.M.y", "d": "EEEE d.M.y – EEEE d.M.y", "y": "EEEE d.M.y – EEEE d.M.y"},
+			"yMMM":    {"M": "MMM–MMM y", "y": "MMM y – MMM y"},
+			"yMMMEd":  {"M": "EEEE d MMM – EEEE d MMM y", "d": "EEEE d MMM – EEEE d MMM y", "y": "EEEE d MMM y – EEEE d MMM y"},
+			"yMMMM":   {"M": "MMMM–MMMM y", "y": "MMMM y–MMMM y"},
+			"yMMMd":   {"M": "d MMM – d MMM y", "d": "d–d בMMM y", "y": "d MMM y – d MMM y"},
+			"yMd":     {"M": "d.M.y – d.M.y", "d": "dd.M.y – dd.M.y", "y": "d.M.y – d.M.y"},
+		},
+		fallback: "{0} – {1}",
+	},
+	"ar": {
+		skeletons: map[string]string{
+			"Bh":      "h B",
+			"Bhm":     "h:mm B",
+			"Bhms":    "h:mm:ss B",
+			"E":       "ccc",
+			"EBh":     "E h B",
+			"EBhm":    "E h:mm B",
+			"EBhms":   "E h:mm:ss B",
+			"EHm":     "E HH:mm",
+			"EHms":    "E HH:mm:ss",
+			"Ed":      "E، d",
+			"Eh":      "E h\u202fa",
+			"Ehm":     "E h:mm a",
+			"Ehms":    "E h:mm:ss a",
+			"Gy":      "y G",
+			"GyM":     "MM، y G",
+			"GyMEd":   "E d/M/y G",
+			"GyMMM":   "MMM y G",
+			"GyMMMEd": "E، d MMM y G",
+			"GyMMMd":  "d MMM y G",
+			"GyMd":    "dd-MM-y GGGGG",
+			"H":       "HH",
+			"Hm":      "HH:mm",
+			"Hms":     "HH:mm:ss",
+			"Hmsv":    "HH:mm:ss v",
+			"Hmv":     "HH:mm v",
+			"Hv":      "HH'h' v",
+			"M":       "L",
+			"MEd":     "E، d\u200f/M",
+			"MMM":     "LLL",
+			"MMMEd":   "E، d MMM",
+			"MMMMEd":  "E، d MMMM",
+			"MMMMd":   "d MMMM",
+			"MMMd":    "d MMM",
+			"MMdd":    "dd\u200f/MM",
+			"Md":      "d\u200f/M",
+			"d":       "d",
+			"h":       "h\u202fa",
+			"hm":      "h:mm a",
+			"hms":     "h:mm:ss a",
+			"hmsv":    "h:mm:ss a v",
+			"hmv":     "h:mm a v",
+			"hv":      "h\u202fa v",
+			"ms":      "mm:ss",
+			"y":       "y",
+			"yM":      "M\u200f/y",
+			"yMEd":    "E، d\u200f/M\u200f/y",
+			"yMM":     "MM\u200f/y",
+			"yMMM":    "MMM y",
+			"yMMMEd":  "E، d MMM y",
+			"yMMMM":   "MMMM y",
+			"yMMMd":   "d MMM y",
+			"yMd":     "d\u200f/M\u200f/y",
+			"yQQQ":    "QQQ y",
+			"yQQQQ":   "QQQQ y",
+		},
+		intervals: map[string]map[string]string{
+			"Bh":      {"B": "h B\u2009–\u2009h B", "h": "h–h B"},
+			"Bhm":     {"B": "h:mm B\u2009–\u2009h:mm B", "h": "h:mm–h:mm B", "m": "h:mm–h:mm B"},
+			"Gy":      {"G": "y G – y G", "y": "y – y G"},
+			"GyM":     {"G": "MM-y GGGG – MM-y GGGG", "M": "MM-y – MM-y GGGG", "y": "MM-y – MM-y GGGG"},
+			"GyMEd":   {"G": "E, dd-MM-y GGGG – E, dd-MM-y GGGG", "M": "E, dd-MM-y – E, dd-MM-y GGGG", "d": "E, dd-MM-y – E, dd-MM-y GGGG", "y": "E, dd-MM-y – E, dd-MM-y GGGG"},
+			"GyMMM":   {"G": "MMM y G – MMM y G", "M": "MMM – MMM y G", "y": "MMM y – MMM y G"},
+			"GyMMMEd": {"G": "E, d MMM y G – E, d MMM y G", "M": "E, d MMM – E, d MMM y G", "d": "E, d MMM – E, d MMM y G", "y": "E, d MMM y – E, d MMM y G"},
+			"GyMMMd":  {"G": "d MMM y G – d MMM y G", "M": "d MMM – d MMM y G", "d": "d–d MMM y G", "y": "d MMM y – d MMM y G"},
+			"GyMd":    {"G": "dd-MM-y GGGG – dd-MM-y GGGG", "M": "dd-MM-y – dd-MM-y GGGG", "d": "d-MM-y – d-MM-y GGGG", "y": "dd-MM-y – dd-MM-y GGGG"},
+			"H":       {"H": "HH–HH"},
+			"Hm":      {"H": "HH:mm–HH:mm", "m": "HH:mm–HH:mm"},
+			"Hmv":     {"H": "HH:mm–HH:mm v", "m": "HH:mm–HH:mm v"},
+			"Hv":      {"H": "HH–HH v"},
+			"M":       {"M": "M–M"},
+			"MEd":     {"M": "E، d\u200f/M – E، d\u200f/M", "d": "E، d\u200f/M – E، d\u200f/M"},
+			"MMM":     {"M": "MMM–MMM"},
+			"MMMEd":   {"M": "E، d MMM – E، d MMM", "d": "E، d – E، d MMM"},
+			"MMMM":    {"M": "LLLL–LLLL"},
+			"MMMd":    {"M": "d MMM – d MMM", "d": "d–d MMM"},
+			"Md":      {"M": "d\u200f/M – d\u200f/M", "d": "d\u200f/M – d\u200f/M"},
+			"d":       {"d": "d–d"},
+			"h":       {"a": "h\u202fa\u2009–\u2009h\u202fa", "h": "h–h\u202fa"},
+			"hm":      {"a": "h:mm a\u2009–\u2009h:mm a", "h": "h:mm–h:mm a", "m": "h:mm–h:mm a"},
+			"hmv":     {"a": "h:mm a\u2009–\u2009h:mm a v", "h": "h:mm–h:mm a v", "m": "h:mm–h:mm a v"},
+			"hv":      {"a": "h\u202fa\u2009–\u2009h\u202fa v", "h": "h–h\u202fa v"},
+			"y":       {"y": "y–y"},
+			"yM":      {"M": "M\u200f/y – M\u200f/y", "y": "M\u200f/y – M\u200f/y"},
+			"yMEd":    {"M": "E، d\u200f/M\u200f/y – E، d\u200f/M\u200f/y", "d": "E، dd\u200f/MM\u200f/y – E، dd\u200f/MM\u200f/y", "y": "E، d\u200f/M\u200f/y – E، d\u200f/M\u200f/y"},
+			"yMMM":    {"M": "MMM\u2009–\u2009MMM y", "y": "MMM y\u2009–\u2009MMM y"},
+			"yMMMEd":  {"M": "E، d MMM – E، d MMM، y", "d": "E، d – E، d MMM، y", "y": "E، d MMM y – E، d MMM y"},
+			"yMMMM":   {"M": "MMMM – MMMM y", "y": "MMMM y – MMMM y"},
+			"yMMMd":   {"M": "d MMM – d MMM y", "d": "d–d MMM y", "y": "d MMM y – d MMM y"},
+			"yMd":     {"M": "d\u200f/M\u200f/y – d\u200f/M\u200f/y", "d": "d\u200f/M\u200f/y – d\u200f/M\u200f/y", "y": "d\u200f/M\u200f/y – d\u200f/M\u200f/y"},
+		},
+		fallback: "{0} – {1}",
+	},
+}
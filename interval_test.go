@@ -0,0 +1,83 @@
+package icu
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatDateInterval(t *testing.T) {
+	requireFullData(t)
+	day := func(y int, m time.Month, d int, h int) time.Time {
+		return time.Date(y, m, d, h, 0, 0, 0, time.UTC)
+	}
+	testCases := []struct {
+		tag      Tag
+		start    time.Time
+		end      time.Time
+		skeleton string
+		want     string
+	}{
+		{"en", day(2024, 1, 3, 0), day(2024, 1, 5, 0), "yMMMd", "Jan 3\u2009–\u20095, 2024"},
+		{"en", day(2024, 1, 3, 0), day(2024, 2, 2, 0), "MMMd", "Jan 3\u2009–\u2009Feb 2"},
+		{"en", day(2023, 12, 30, 0), day(2024, 1, 2, 0), "yMMMd", "Dec 30, 2023\u2009–\u2009Jan 2, 2024"},
+		{"en", day(2024, 1, 3, 9), day(2024, 1, 3, 17), "yMMMd", "Jan 3, 2024"},
+		{"en", day(2024, 1, 3, 0), day(2024, 1, 5, 0), "yMMM", "Jan 2024"},
+		{"en", day(2024, 1, 3, 9), day(2024, 1, 3, 11), "hm", "9:00\u2009–\u200911:00\u202fAM"},
+		{"en", day(2024, 1, 3, 9), day(2024, 1, 3, 17), "hm", "9:00\u202fAM\u2009–\u20095:00\u202fPM"},
+		{"en", day(2024, 1, 3, 9), day(2024, 1, 4, 17), "yMd", "1/3/2024\u2009–\u20091/4/2024"},
+		{"de", day(2024, 1, 3, 0), day(2024, 1, 5, 0), "yMMMd", "3.–5. Jan. 2024"},
+		{"de", day(2024, 1, 3, 9), day(2024, 1, 3, 17), "Hm", "09:00\u2009–\u200917:00"},
+		{"fr", day(2024, 1, 3, 0), day(2024, 2, 5, 0), "yMMMMd", "3 janvier\u2009–\u20095 février 2024"},
+		{"xx", day(2024, 1, 3, 0), day(2024, 1, 5, 0), "MMMd", "Jan 3\u2009–\u20095"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag)+":"+tc.want, func(t *testing.T) {
+			got, err := FormatDateInterval(tc.tag, tc.start, tc.end, tc.skeleton)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatDateIntervalUnknownSkeleton(t *testing.T) {
+	now := time.Now()
+	if _, err := FormatDateInterval("en", now, now, "QQQQ"); err == nil {
+		t.Errorf("expected an error for an unknown skeleton")
+	}
+}
+
+func TestTranslateDateInterval(t *testing.T) {
+	requireFullData(t)
+	stay := DateInterval{
+		Start: time.Date(2024, time.January, 3, 14, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, time.January, 5, 10, 0, 0, 0, time.UTC),
+	}
+	testCases := []struct {
+		name       string
+		tag        Tag
+		message    MessageFormat
+		parameters []Parameter
+		translated string
+	}{
+		{"default", "en", "Your stay: {stay, dateinterval}", []Parameter{P("stay", stay)}, "Your stay: Jan 3\u2009–\u20095, 2024"},
+		{"skeleton", "de", "Ihr Aufenthalt: {stay, dateinterval, MMMMd}", []Parameter{P("stay", stay)}, "Ihr Aufenthalt: 3.–5. Januar"},
+		{"buddhist", "th", "{stay, dateinterval, yMMMd}", []Parameter{P("stay", stay)}, "3–5 ม.ค. 2567"},
+		{"zone", "en", "{stay, dateinterval, MMMd}", []Parameter{P("stay", stay), P(timeZoneParameter, "Pacific/Auckland")}, "Jan 4\u2009–\u20095"},
+		{"no interval", "en", "{stay, dateinterval}", []Parameter{P("stay", "soon")}, "soon"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Translate(tc.tag, tc.message, tc.parameters...)
+			if err != nil {
+				t.Errorf("parse: %s", err)
+			}
+			if tc.translated != got {
+				t.Errorf("expected: '%s', got: '%s'", tc.translated, got)
+			}
+		})
+	}
+}
@@ -144,6 +144,9 @@ func lexMessage(l *lexer) stateFn {
 		}
 		return lexRightDelim
 	case eof:
+		if l.depth > 0 {
+			return l.errorf("unclosed message in %q", l.input)
+		}
 		if l.pos > l.start {
 			l.emit(tokenText)
 		}
@@ -168,6 +171,13 @@ func lexQuote(l *lexer) stateFn {
 			l.backup()
 		}
 		return lexMessage
+	case eof:
+		// An unterminated quote extends to the end of the message.
+		if l.pos > l.start {
+			l.emit(tokenQuotedText)
+		}
+		l.emit(tokenEOF)
+		return nil
 	default:
 		return lexQuote
 	}
@@ -216,6 +226,8 @@ func lexAction(l *lexer) stateFn {
 	case r == rightDelim:
 		l.backup()
 		return lexRightDelim
+	case r == eof:
+		return l.errorf("unclosed argument in %q", l.input)
 	}
 	return lexAction
 }
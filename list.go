@@ -0,0 +1,155 @@
+package icu
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ListType selects the kind of list FormatList produces.
+type ListType int
+
+const (
+	// ListConjunction is a list of items that all apply, e.g. "A, B, and C".
+	ListConjunction ListType = iota
+	// ListDisjunction is a list of alternatives, e.g. "A, B, or C".
+	ListDisjunction
+	// ListUnit is a list of amounts with units, e.g. "3 feet, 7 inches".
+	ListUnit
+)
+
+// ListStyle configures FormatList.
+type ListStyle struct {
+	Type  ListType
+	Width Width
+}
+
+func (s ListStyle) key() string {
+	switch s.Type {
+	case ListDisjunction:
+		return "or" + s.Width.suffix()
+	case ListUnit:
+		return "unit" + s.Width.suffix()
+	}
+	return "standard" + s.Width.suffix()
+}
+
+// FormatList joins items with the list patterns of the locale, e.g.
+// "Alice, Bob, and Carol" in English or "Alice, Bob und Carol" in German.
+func FormatList(tag Tag, items []string, style ListStyle) string {
+	return joinList(baseLocale(tag), items, style.key())
+}
+
+type listPatterns struct {
+	pair   string
+	start  string
+	middle string
+	end    string
+}
+
+// joinList joins items with the list patterns of the given CLDR style, e.g.
+// "unit-short".
+func joinList(tag Tag, items []string, style string) string {
+	tag, d := localeDataFor(tag, hasLists)
+	p := d.lists[style]
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return fillList(contextualListPattern(tag, p.pair, items[1]), items[0], items[1])
+	}
+	last := items[len(items)-1]
+	res := fillList(contextualListPattern(tag, p.end, last), items[len(items)-2], last)
+	for i := len(items) - 3; i > 0; i-- {
+		res = fillList(p.middle, items[i], res)
+	}
+	return fillList(p.start, items[0], res)
+}
+
+func fillList(pattern string, first string, second string) string {
+	return strings.NewReplacer("{0}", first, "{1}", second).Replace(pattern)
+}
+
+// contextualListPattern adapts a pattern to the item following the
+// conjunction: Spanish "y" becomes "e" before an "i" sound and "o" becomes
+// "u" before an "o" sound, Hebrew "ו" takes a hyphen before non-Hebrew text.
+func contextualListPattern(tag Tag, pattern string, next string) string {
+	switch tag.Locale().Language {
+	case "es":
+		lower := strings.ToLower(next)
+		switch {
+		case pattern == "{0} y {1}" && (strings.HasPrefix(lower, "i") ||
+			strings.HasPrefix(lower, "hi") && !strings.HasPrefix(lower, "hia") && !strings.HasPrefix(lower, "hie")):
+			return "{0} e {1}"
+		case pattern == "{0} o {1}" && (strings.HasPrefix(lower, "o") || strings.HasPrefix(lower, "ho") ||
+			strings.HasPrefix(lower, "8") || lower == "11" || strings.HasPrefix(lower, "11 ")):
+			return "{0} u {1}"
+		}
+	case "he":
+		if r, _ := utf8.DecodeRuneInString(next); pattern == "{0} ו{1}" && next != "" && !unicode.Is(unicode.Hebrew, r) {
+			return "{0} ו-{1}"
+		}
+	}
+	return pattern
+}
+
+// listItems returns the elements of slices and arrays other than byte
+// slices formatted as strings.
+func listItems(v interface{}) ([]string, bool) {
+	switch v := v.(type) {
+	case []string:
+		return v, true
+	case []byte:
+		return nil, false
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+	items := make([]string, rv.Len())
+	for i := range items {
+		items[i] = fmt.Sprintf("%v", rv.Index(i).Interface())
+	}
+	return items, true
+}
+
+// nodeFormatList formats slices as lists. The style consists of the
+// keywords conjunction, disjunction and unit as well as long, short and
+// narrow.
+type nodeFormatList struct {
+	key   string
+	style string
+}
+
+func (n nodeFormatList) translate(ctx *context) string {
+	v, ok := ctx.values[n.key]
+	if !ok {
+		return ""
+	}
+	items, ok := listItems(v)
+	if !ok {
+		return fmt.Sprintf("%v", v)
+	}
+	var style ListStyle
+	for _, s := range strings.Fields(n.style) {
+		switch s {
+		case "conjunction", "and":
+			style.Type = ListConjunction
+		case "disjunction", "or":
+			style.Type = ListDisjunction
+		case "unit":
+			style.Type = ListUnit
+		case styleLong:
+			style.Width = WidthLong
+		case styleShort:
+			style.Width = WidthShort
+		case "narrow":
+			style.Width = WidthNarrow
+		}
+	}
+	return FormatList(ctx.tag, items, style)
+}
@@ -0,0 +1,78 @@
+package icu
+
+import "testing"
+
+func TestFormatList(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		tag   Tag
+		items []string
+		style ListStyle
+		want  string
+	}{
+		{"en", nil, ListStyle{}, ""},
+		{"en", []string{"Alice"}, ListStyle{}, "Alice"},
+		{"en", []string{"Alice", "Bob"}, ListStyle{}, "Alice and Bob"},
+		{"en", []string{"Alice", "Bob", "Carol"}, ListStyle{}, "Alice, Bob, and Carol"},
+		{"en", []string{"Alice", "Bob", "Carol", "Dave"}, ListStyle{}, "Alice, Bob, Carol, and Dave"},
+		{"en", []string{"Alice", "Bob", "Carol"}, ListStyle{Type: ListDisjunction}, "Alice, Bob, or Carol"},
+		{"en", []string{"Alice", "Bob", "Carol"}, ListStyle{Width: WidthShort}, "Alice, Bob, & Carol"},
+		{"en", []string{"Alice", "Bob", "Carol"}, ListStyle{Width: WidthNarrow}, "Alice, Bob, Carol"},
+		{"en", []string{"3 feet", "7 inches"}, ListStyle{Type: ListUnit}, "3 feet, 7 inches"},
+		{"en", []string{"{1}", "{0}"}, ListStyle{}, "{1} and {0}"},
+		{"de-DE", []string{"Alice", "Bob", "Carol"}, ListStyle{}, "Alice, Bob und Carol"},
+		{"de", []string{"Alice", "Bob", "Carol"}, ListStyle{Type: ListDisjunction}, "Alice, Bob oder Carol"},
+		{"fr", []string{"Alice", "Bob", "Carol"}, ListStyle{}, "Alice, Bob et Carol"},
+		{"es", []string{"Alicia", "Inés"}, ListStyle{}, "Alicia e Inés"},
+		{"es", []string{"agua", "hielo"}, ListStyle{}, "agua y hielo"},
+		{"es", []string{"Ana", "Bea", "Hilda"}, ListStyle{}, "Ana, Bea e Hilda"},
+		{"es", []string{"siete", "ocho"}, ListStyle{Type: ListDisjunction}, "siete u ocho"},
+		{"es", []string{"7", "8"}, ListStyle{Type: ListDisjunction}, "7 u 8"},
+		{"es", []string{"10", "11"}, ListStyle{Type: ListDisjunction}, "10 u 11"},
+		{"es", []string{"10", "110"}, ListStyle{Type: ListDisjunction}, "10 o 110"},
+		{"he", []string{"אליס", "בוב"}, ListStyle{}, "אליס ובוב"},
+		{"he", []string{"אליס", "Bob"}, ListStyle{}, "אליס ו-Bob"},
+		{"zh", []string{"甲", "乙", "丙"}, ListStyle{}, "甲、乙和丙"},
+		{"xx", []string{"Alice", "Bob"}, ListStyle{}, "Alice and Bob"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag)+":"+tc.want, func(t *testing.T) {
+			got := FormatList(tc.tag, tc.items, tc.style)
+			if tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTranslateList(t *testing.T) {
+	requireFullData(t)
+	names := []string{"Alice", "Bob", "Carol"}
+	testCases := []struct {
+		name       string
+		tag        Tag
+		message    MessageFormat
+		parameters []Parameter
+		translated string
+	}{
+		{"placeholder", "en", "Invited: {names}", []Parameter{P("names", names)}, "Invited: Alice, Bob, and Carol"},
+		{"list", "de", "{names, list}", []Parameter{P("names", names)}, "Alice, Bob und Carol"},
+		{"disjunction", "en", "{names, list, disjunction}", []Parameter{P("names", names)}, "Alice, Bob, or Carol"},
+		{"disjunction:short", "en", "{names, list, disjunction short}", []Parameter{P("names", names)}, "Alice, Bob, or Carol"},
+		{"unit:narrow", "en", "{parts, list, unit narrow}", []Parameter{P("parts", []string{"3ft", "7in"})}, "3ft 7in"},
+		{"interfaces", "en", "{ids, list}", []Parameter{P("ids", []interface{}{1, "two", 3.5})}, "1, two, and 3.5"},
+		{"ints", "fr", "{ids, list, or}", []Parameter{P("ids", []int{1, 2})}, "1 ou 2"},
+		{"not-a-slice", "en", "{names, list}", []Parameter{P("names", "Alice")}, "Alice"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Translate(tc.tag, tc.message, tc.parameters...)
+			if err != nil {
+				t.Errorf("parse: %s", err)
+			}
+			if tc.translated != got {
+				t.Errorf("expected: '%s', got: '%s'", tc.translated, got)
+			}
+		})
+	}
+}
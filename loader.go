@@ -0,0 +1,291 @@
+package icu
+
+import (
+	gocontext "context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Catalog holds the messages of a tag, keyed like the messages passed to
+// Translate.
+type Catalog struct {
+	Tag          Tag
+	Translations map[string]MessageFormat
+}
+
+// LoadError is the failure to load the catalog of a tag.
+type LoadError struct {
+	Tag Tag
+	Err error
+}
+
+func (e *LoadError) Error() string {
+	return fmt.Sprintf("catalog %s: %v", e.Tag, e.Err)
+}
+
+func (e *LoadError) Unwrap() error {
+	return e.Err
+}
+
+// LoadErrors are the failures to load several catalogs.
+type LoadErrors []*LoadError
+
+func (es LoadErrors) Error() string {
+	msgs := make([]string, len(es))
+	for i, e := range es {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Version identifies the state of a catalog, e.g. the modification time of
+// its file. Unchanged catalogs keep their version.
+type Version string
+
+// Loader loads the catalogs of a Bundle, e.g. from files or a database.
+type Loader interface {
+	// Load returns the catalog of a tag and its version. Missing catalogs
+	// fail with an error wrapping fs.ErrNotExist. Other failures may still
+	// tell the version, so the catalog is not loaded again until it changes.
+	Load(ctx gocontext.Context, tag Tag) (Catalog, Version, error)
+	// Tags returns the tags of the catalogs.
+	Tags(ctx gocontext.Context) ([]Tag, error)
+}
+
+// VersionLoader is a Loader telling the version of a catalog without loading
+// it. Bundles reload catalogs of such loaders when their version changes,
+// those of other loaders are only loaded once.
+type VersionLoader interface {
+	Loader
+	Version(ctx gocontext.Context, tag Tag) (Version, error)
+}
+
+// TagsVersionLoader is a VersionLoader telling the version of its tags, e.g.
+// the modification time of a directory, so bundles only list the tags again
+// when it changes.
+type TagsVersionLoader interface {
+	VersionLoader
+	TagsVersion(ctx gocontext.Context) (Version, error)
+}
+
+// FSLoader loads catalogs from TOML files in the root of a file system, named
+// like their tags, e.g. "de-CH.toml". Files are versioned by their
+// modification time.
+type FSLoader struct {
+	FS fs.FS
+}
+
+// name returns the file of a tag, named after the tag in canonical case, its
+// canonical form or else like the tag, e.g. "no.toml", "nb.toml" or
+// "no_NO.toml" for "no_NO".
+func (l FSLoader) name(tag Tag) (string, fs.FileInfo, error) {
+	var name string
+	var fi fs.FileInfo
+	var err error
+	tried := map[Tag]bool{}
+	for _, t := range []Tag{tag.literal(), tag.Canonical(), tag} {
+		if tried[t] {
+			continue
+		}
+		tried[t] = true
+		name = fmt.Sprintf("%s.toml", t)
+		if fi, err = fs.Stat(l.FS, name); err == nil {
+			break
+		}
+	}
+	return name, fi, err
+}
+
+func fileVersion(fi fs.FileInfo) Version {
+	return Version(fi.ModTime().UTC().Format(time.RFC3339Nano))
+}
+
+func (l FSLoader) Load(ctx gocontext.Context, tag Tag) (Catalog, Version, error) {
+	name, fi, err := l.name(tag)
+	if err != nil {
+		return Catalog{}, "", err
+	}
+	data, err := fs.ReadFile(l.FS, name)
+	if err != nil {
+		return Catalog{}, fileVersion(fi), err
+	}
+	c := Catalog{Tag: tag.Canonical()}
+	if _, err := toml.Decode(string(data), &c); err != nil {
+		return Catalog{}, fileVersion(fi), fmt.Errorf("%s: %w", name, err)
+	}
+	c.Tag = c.Tag.Canonical()
+	return c, fileVersion(fi), nil
+}
+
+func (l FSLoader) Version(ctx gocontext.Context, tag Tag) (Version, error) {
+	_, fi, err := l.name(tag)
+	if err != nil {
+		return "", err
+	}
+	return fileVersion(fi), nil
+}
+
+// TagsVersion returns the modification time of the root directory. File
+// systems without one, like fstest.MapFS, fail.
+func (l FSLoader) TagsVersion(ctx gocontext.Context) (Version, error) {
+	fi, err := fs.Stat(l.FS, ".")
+	if err != nil {
+		return "", err
+	}
+	if fi.ModTime().IsZero() {
+		return "", fmt.Errorf("no modification time of the root directory")
+	}
+	return fileVersion(fi), nil
+}
+
+func (l FSLoader) Tags(ctx gocontext.Context) ([]Tag, error) {
+	files, err := fs.Glob(l.FS, "*.toml")
+	if err != nil {
+		return nil, err
+	}
+	var tags []Tag
+	for _, f := range files {
+		tags = append(tags, Tag(strings.TrimSuffix(f, ".toml")))
+	}
+	return tags, nil
+}
+
+// DirLoader loads catalogs from TOML files in a directory like FSLoader.
+type DirLoader struct {
+	Dir string
+}
+
+func (l DirLoader) fs() FSLoader {
+	return FSLoader{FS: os.DirFS(l.Dir)}
+}
+
+func (l DirLoader) Load(ctx gocontext.Context, tag Tag) (Catalog, Version, error) {
+	return l.fs().Load(ctx, tag)
+}
+
+func (l DirLoader) Version(ctx gocontext.Context, tag Tag) (Version, error) {
+	return l.fs().Version(ctx, tag)
+}
+
+func (l DirLoader) TagsVersion(ctx gocontext.Context) (Version, error) {
+	return l.fs().TagsVersion(ctx)
+}
+
+func (l DirLoader) Tags(ctx gocontext.Context) ([]Tag, error) {
+	return l.fs().Tags(ctx)
+}
+
+// SQLLoader loads catalogs from a database. Catalogs without rows are
+// missing. It tells no versions without loading, so bundles load each
+// catalog once and only see changes in the database while they Poll, which
+// reloads the catalogs and compares their versions.
+type SQLLoader struct {
+	DB *sql.DB
+	// Query selects the key and the message of the translations of the tag
+	// passed as argument, e.g.
+	// "SELECT key, message FROM translations WHERE tag = $1".
+	Query string
+	// TagsQuery selects the tags, e.g.
+	// "SELECT DISTINCT tag FROM translations".
+	TagsQuery string
+	// VersionQuery optionally selects the version of the catalog of the tag
+	// passed as argument, e.g.
+	// "SELECT max(updated_at) FROM translations WHERE tag = $1". Without
+	// it catalogs are versioned by their content.
+	VersionQuery string
+}
+
+// Load queries the translations of the tag in canonical case, e.g. "no-NO"
+// for "no_NO", or else of its canonical form, e.g. "nb-NO".
+func (l SQLLoader) Load(ctx gocontext.Context, tag Tag) (Catalog, Version, error) {
+	c := Catalog{Tag: tag.Canonical()}
+	for _, t := range []Tag{tag.literal(), tag.Canonical()} {
+		ts, err := l.translations(ctx, t)
+		if err != nil {
+			return Catalog{}, "", err
+		}
+		if len(ts) > 0 {
+			tag, c.Translations = t, ts
+			break
+		}
+	}
+	if len(c.Translations) == 0 {
+		return Catalog{}, "", fmt.Errorf("no translations of %s: %w", tag, fs.ErrNotExist)
+	}
+	v, err := l.version(ctx, tag)
+	if err != nil {
+		return Catalog{}, "", err
+	}
+	if v == "" {
+		v = contentVersion(c)
+	}
+	return c, v, nil
+}
+
+func (l SQLLoader) translations(ctx gocontext.Context, tag Tag) (map[string]MessageFormat, error) {
+	rows, err := l.DB.QueryContext(ctx, l.Query, string(tag))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ts := map[string]MessageFormat{}
+	for rows.Next() {
+		var key, message string
+		if err := rows.Scan(&key, &message); err != nil {
+			return nil, err
+		}
+		ts[key] = MessageFormat(message)
+	}
+	return ts, rows.Err()
+}
+
+func (l SQLLoader) version(ctx gocontext.Context, tag Tag) (Version, error) {
+	if l.VersionQuery == "" {
+		return "", nil
+	}
+	var v sql.NullString
+	if err := l.DB.QueryRowContext(ctx, l.VersionQuery, string(tag)).Scan(&v); err != nil {
+		return "", err
+	}
+	return Version(v.String), nil
+}
+
+func (l SQLLoader) Tags(ctx gocontext.Context) ([]Tag, error) {
+	rows, err := l.DB.QueryContext(ctx, l.TagsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var tags []Tag
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		tags = append(tags, Tag(tag))
+	}
+	return tags, rows.Err()
+}
+
+// contentVersion returns a hash of the translations of a catalog.
+func contentVersion(c Catalog) Version {
+	keys := make([]string, 0, len(c.Translations))
+	for k := range c.Translations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	h := sha256.New()
+	for _, k := range keys {
+		fmt.Fprintf(h, "%q=%q\n", k, c.Translations[k])
+	}
+	return Version(hex.EncodeToString(h.Sum(nil)))
+}
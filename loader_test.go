@@ -0,0 +1,314 @@
+package icu
+
+import (
+	gocontext "context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// fakeDB is an in-memory database/sql driver answering the queries of the
+// tests from a table of translations.
+type fakeDB struct {
+	mu      sync.Mutex
+	rows    [][3]string // tag, key, message
+	queries int
+	down    bool
+}
+
+var fakeDBs sync.Map
+
+func init() {
+	sql.Register("icu-fake", fakeDriver{})
+}
+
+func openFakeDB(t *testing.T, rows ...[3]string) (*sql.DB, *fakeDB) {
+	t.Helper()
+	f := &fakeDB{rows: rows}
+	fakeDBs.Store(t.Name(), f)
+	db, err := sql.Open("icu-fake", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+		fakeDBs.Delete(t.Name())
+	})
+	return db, f
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	f, ok := fakeDBs.Load(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown database %q", name)
+	}
+	return fakeConn{f.(*fakeDB)}, nil
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return fakeStmt{db: c.db, query: query}, nil
+}
+
+func (fakeConn) Close() error { return nil }
+
+func (fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("transactions not supported") }
+
+type fakeStmt struct {
+	db    *fakeDB
+	query string
+}
+
+func (fakeStmt) Close() error { return nil }
+
+func (s fakeStmt) NumInput() int { return -1 }
+
+func (fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("exec not supported")
+}
+
+func (s fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	s.db.queries++
+	if s.db.down {
+		return nil, errors.New("database is down")
+	}
+	r := &fakeRows{}
+	switch s.query {
+	case "translations":
+		r.columns = []string{"key", "message"}
+		for _, row := range s.db.rows {
+			if row[0] == args[0] {
+				r.values = append(r.values, []driver.Value{row[1], row[2]})
+			}
+		}
+	case "tags":
+		r.columns = []string{"tag"}
+		seen := map[string]bool{}
+		for _, row := range s.db.rows {
+			if !seen[row[0]] {
+				seen[row[0]] = true
+				r.values = append(r.values, []driver.Value{row[0]})
+			}
+		}
+	case "version":
+		r.columns = []string{"version"}
+		r.values = [][]driver.Value{{fmt.Sprintf("v%d", len(s.db.rows))}}
+	default:
+		return nil, fmt.Errorf("unknown query %q", s.query)
+	}
+	return r, nil
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func TestFSLoader(t *testing.T) {
+	modTime := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	l := FSLoader{FS: fstest.MapFS{
+		"de-CH.toml": {Data: []byte("[Translations]\nhello = \"Grüezi\"\n"), ModTime: modTime},
+		"en_us.toml": {Data: []byte("[Translations]\nhello = \"Howdy\"\n"), ModTime: modTime},
+		"bad.toml":   {Data: []byte("[Translations\n"), ModTime: modTime},
+	}}
+	ctx := gocontext.Background()
+	testCases := []struct {
+		tag  Tag
+		want Catalog
+	}{
+		{"de-CH", Catalog{Tag: "de-CH", Translations: map[string]MessageFormat{"hello": "Grüezi"}}},
+		{"de_ch", Catalog{Tag: "de-CH", Translations: map[string]MessageFormat{"hello": "Grüezi"}}},
+		{"en_us", Catalog{Tag: "en-US", Translations: map[string]MessageFormat{"hello": "Howdy"}}},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag), func(t *testing.T) {
+			got, v, err := l.Load(ctx, tc.tag)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want: %v, got: %v", tc.want, got)
+			}
+			if want := Version("2021-06-01T12:00:00Z"); want != v {
+				t.Errorf("want: %q, got: %q", want, v)
+			}
+		})
+	}
+	if _, _, err := l.Load(ctx, "fr"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("want: %v, got: %v", fs.ErrNotExist, err)
+	}
+	if _, _, err := l.Load(ctx, "bad"); err == nil {
+		t.Errorf("want an error for invalid TOML")
+	}
+	tags, err := l.Tags(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []Tag{"bad", "de-CH", "en_us"}; !reflect.DeepEqual(want, tags) {
+		t.Errorf("want: %v, got: %v", want, tags)
+	}
+}
+
+func TestSQLLoader(t *testing.T) {
+	db, _ := openFakeDB(t,
+		[3]string{"en", "hello", "Hello"},
+		[3]string{"en", "bye", "Bye"},
+		[3]string{"de-CH", "hello", "Grüezi"},
+	)
+	ctx := gocontext.Background()
+	l := SQLLoader{DB: db, Query: "translations", TagsQuery: "tags"}
+	c, v, err := l.Load(ctx, "de_ch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Catalog{Tag: "de-CH", Translations: map[string]MessageFormat{"hello": "Grüezi"}}); !reflect.DeepEqual(want, c) {
+		t.Errorf("want: %v, got: %v", want, c)
+	}
+	if _, v2, _ := l.Load(ctx, "de-CH"); v == "" || v != v2 {
+		t.Errorf("want equal content versions, got: %q, %q", v, v2)
+	}
+	if _, _, err := l.Load(ctx, "fr"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("want: %v, got: %v", fs.ErrNotExist, err)
+	}
+	tags, err := l.Tags(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i] < tags[j] })
+	if want := []Tag{"de-CH", "en"}; !reflect.DeepEqual(want, tags) {
+		t.Errorf("want: %v, got: %v", want, tags)
+	}
+
+	l.VersionQuery = "version"
+	if _, v, _ := l.Load(ctx, "en"); v != "v3" {
+		t.Errorf("want: %q, got: %q", "v3", v)
+	}
+}
+
+func TestBundleLoader(t *testing.T) {
+	db, f := openFakeDB(t,
+		[3]string{"en", "hello", "Hello"},
+		[3]string{"de", "hello", "Hallo"},
+	)
+	b := NewBundleLoader(SQLLoader{DB: db, Query: "translations", TagsQuery: "tags"}, "en")
+	testCases := []struct {
+		tag  Tag
+		want string
+	}{
+		{"de-AT", "Hallo"},
+		{"fr", "Hello"},
+		{"en", "Hello"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag), func(t *testing.T) {
+			if got := b.TranslatorForTag(tc.tag).Translate("hello"); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+
+	// Catalogs and misses of loaders without versions are cached.
+	f.mu.Lock()
+	queries := f.queries
+	f.mu.Unlock()
+	b.TranslatorForTag("de-AT")
+	b.TranslatorForTag("fr")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if queries != f.queries {
+		t.Errorf("want: %d queries, got: %d", queries, f.queries)
+	}
+}
+
+func TestBundleLoaderRetry(t *testing.T) {
+	db, f := openFakeDB(t,
+		[3]string{"en", "hello", "Hello"},
+		[3]string{"de", "hello", "Hallo"},
+	)
+	b := NewBundleLoader(SQLLoader{DB: db, Query: "translations", TagsQuery: "tags"}, "en")
+	b.TranslatorForTag("en")
+	f.mu.Lock()
+	f.down = true
+	f.mu.Unlock()
+	if got, want := b.TranslatorForTag("de").Translate("hello"), "Hello"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+	f.mu.Lock()
+	f.down = false
+	f.mu.Unlock()
+
+	// Failures are cached until their retry is due.
+	if got, want := b.TranslatorForTag("de").Translate("hello"), "Hello"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+	b.mu.Lock()
+	e := b.cache["de"]
+	if e.backoff != minRetry {
+		t.Errorf("want: %v, got: %v", minRetry, e.backoff)
+	}
+	e.retry = time.Now()
+	b.cache["de"] = e
+	b.mu.Unlock()
+	if got, want := b.TranslatorForTag("de").Translate("hello"), "Hallo"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+}
+
+func TestBundleLoaderTagsError(t *testing.T) {
+	db, f := openFakeDB(t,
+		[3]string{"en", "hello", "Hello"},
+		[3]string{"de", "hello", "Hallo"},
+	)
+	b := NewBundleLoader(SQLLoader{DB: db, Query: "translations", TagsQuery: "tags"}, "en")
+	var reported []error
+	b.SetErrorHandler(func(err error) { reported = append(reported, err) })
+	f.mu.Lock()
+	f.down = true
+	f.mu.Unlock()
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set(HeaderAcceptLanguage, "de")
+	if got := b.Resolve(r).Tag; got != "en" {
+		t.Errorf("want: %q, got: %q", "en", got)
+	}
+	if len(reported) != 1 || !strings.Contains(reported[0].Error(), "database is down") {
+		t.Errorf("want the failure to list the tags, got: %v", reported)
+	}
+
+	f.mu.Lock()
+	f.down = false
+	f.mu.Unlock()
+	if got := b.Resolve(r).Tag; got != "de" {
+		t.Errorf("want: %q, got: %q", "de", got)
+	}
+}
@@ -0,0 +1,113 @@
+package icu
+
+import "strings"
+
+//go:generate go run gen_localedata.go -tags !icu_subset -out localedata_tables.go
+//go:generate go run gen_localedata.go -locales en -tags icu_subset -out localedata_subset_tables.go
+
+// localeData holds the data of a locale generated from the CLDR JSON snapshot
+// in the localedata directory. Building with the icu_subset tag only includes
+// English to keep binaries small. Locales only hold the kinds of data the
+// snapshot has for them, the others are inherited from their parents.
+type localeData struct {
+	numbers       *numberSymbols
+	gregorian     *calendarSymbols
+	lists         map[string]listPatterns
+	units         map[string]unitPatterns
+	unitCompounds map[string]string
+	cardinal      map[string]string
+	ordinal       map[string]string
+}
+
+const localeRoot = "root"
+
+// canonicalLocale returns the language, script and region subtags of a tag
+// joined by hyphens, e.g. "zh-Hant-TW" for "zh_hant_tw-u-nu-hanidec".
+func canonicalLocale(tag Tag) string {
+	lang, script, region := splitTag(tag)
+	s := lang
+	if script != "" {
+		s += "-" + script
+	}
+	if region != "" {
+		s += "-" + region
+	}
+	return s
+}
+
+// parentLocale returns the parent of a locale in the CLDR inheritance chain,
+// e.g. "en-001" for "en-GB", "de" for "de-CH" and "root" for "de". The root
+// locale has none.
+func parentLocale(tag Tag) Tag {
+	s := string(tag)
+	if p, ok := parentLocales[s]; ok {
+		return Tag(p)
+	}
+	if s == localeRoot || s == "" {
+		return ""
+	}
+	if i := strings.LastIndex(s, "-"); i >= 0 {
+		return Tag(s[:i])
+	}
+	return localeRoot
+}
+
+// scriptedLocale adds the likely script to a locale with a region but no
+// script if it differs from the script of its language, e.g. "zh-Hant-TW" for
+// "zh-TW", while "de-AT" stays as it is.
+func scriptedLocale(tag Tag) Tag {
+	l := tag.Locale()
+	if l.Script != "" || l.Region == "" || l.Language == undetermined {
+		return tag
+	}
+	script := Locale{Language: l.Language, Region: l.Region}.Maximize().Script
+	if script == (Locale{Language: l.Language}).Maximize().Script {
+		return tag
+	}
+	return Locale{Language: l.Language, Script: script, Region: l.Region}.Tag()
+}
+
+// localeChain returns the locales data of a tag is looked up in, from the
+// tag itself to the root locale, e.g. "en-AU", "en-001", "en" and "root" or
+// "zh-Hant-TW", "zh-Hant" and "root" for "zh-TW".
+func localeChain(tag Tag) []Tag {
+	var chain []Tag
+	for t := scriptedLocale(Tag(canonicalLocale(tag))); t != ""; t = parentLocale(t) {
+		chain = append(chain, t)
+	}
+	return chain
+}
+
+// localeDataFor returns the first locale in the chain of a tag which has the
+// data selected by has. Without one, English is used.
+func localeDataFor(tag Tag, has func(d *localeData) bool) (Tag, *localeData) {
+	t, ok := lookupLocale(tag, func(key string) bool {
+		d, ok := localeDataTable[key]
+		return ok && has(d)
+	})
+	if !ok {
+		return TagEn, localeDataTable[TagEn]
+	}
+	return t, localeDataTable[string(t)]
+}
+
+// lookupLocale returns the first locale in the chain of a tag for which has
+// reports data. The language of the tag is tried before the root locale, so
+// "sr-Latn" finds the data of "sr" although its parent is "root".
+func lookupLocale(tag Tag, has func(key string) bool) (Tag, bool) {
+	lang := Tag(tag.Locale().Canonicalize().Language)
+	for _, t := range localeChain(tag) {
+		if t == localeRoot && lang != localeRoot && has(string(lang)) {
+			return lang, true
+		}
+		if has(string(t)) {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+func hasNumbers(d *localeData) bool   { return d.numbers != nil }
+func hasGregorian(d *localeData) bool { return d.gregorian != nil }
+func hasLists(d *localeData) bool     { return d.lists != nil }
+func hasUnits(d *localeData) bool     { return d.units != nil }
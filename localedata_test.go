@@ -0,0 +1,180 @@
+package icu
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLocaleChain(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		tag  Tag
+		want []Tag
+	}{
+		{"de", []Tag{"de", "root"}},
+		{"de_ch", []Tag{"de-CH", "de", "root"}},
+		{"en-AU", []Tag{"en-AU", "en-001", "en", "root"}},
+		{"es-MX", []Tag{"es-MX", "es-419", "es", "root"}},
+		{"pt-AO", []Tag{"pt-AO", "pt-PT", "pt", "root"}},
+		{"zh-Hant-TW", []Tag{"zh-Hant-TW", "zh-Hant", "root"}},
+		{"zh-TW", []Tag{"zh-Hant-TW", "zh-Hant", "root"}},
+		{"sr-Latn-u-nu-latn", []Tag{"sr-Latn", "root"}},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag), func(t *testing.T) {
+			got := localeChain(tc.tag)
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLocaleDataFor(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		tag  Tag
+		has  func(d *localeData) bool
+		want Tag
+	}{
+		{"de-CH", hasNumbers, "de"},
+		{"en-GB", hasLists, "en-001"},
+		{"he", hasLists, "he"},
+		{"he", hasUnits, "en"},
+		{"xx", hasGregorian, "en"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag), func(t *testing.T) {
+			got, _ := localeDataFor(tc.tag, tc.has)
+			if tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestPluralRules(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		tag     Tag
+		ordinal bool
+		digits  string
+		want    string
+	}{
+		{"en", false, "1", one},
+		{"en", false, "1.0", other},
+		{"en", true, "22", two},
+		{"en", true, "113", other},
+		{"ru", false, "21", one},
+		{"ru", false, "23", few},
+		{"ru", false, "11", many},
+		{"ru", false, "1.5", other},
+		{"ar", false, "0", zero},
+		{"ar", false, "2", two},
+		{"ar", false, "103", few},
+		{"ar", false, "111", many},
+		{"ar", false, "100", other},
+		{"cy", true, "3", few},
+		{"cy", true, "5", many},
+		{"lv", false, "0.1", one},
+		{"fr", false, "1000000", many},
+		{"is", false, "1.10", one},
+		{"pt-PT", false, "0", other},
+		{"pt", false, "0", one},
+		{"xx", false, "1", other},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag)+":"+tc.digits, func(t *testing.T) {
+			category := cardinalCategory
+			if tc.ordinal {
+				category = ordinalCategory
+			}
+			got := category(tc.tag, operands(tc.digits))
+			if tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParsePluralRules(t *testing.T) {
+	for loc, d := range localeDataTable {
+		for _, rules := range []map[string]string{d.cardinal, d.ordinal} {
+			for c, r := range rules {
+				if _, err := parsePluralRule(r); err != nil {
+					t.Errorf("%s %s: %v", loc, c, err)
+				}
+			}
+		}
+	}
+}
+
+func TestTranslatePluralRules(t *testing.T) {
+	requireFullData(t)
+	const files = "{n, plural, one {# файл} few {# файла} many {# файлов} other {# файла}}"
+	testCases := []struct {
+		name       string
+		tag        Tag
+		message    MessageFormat
+		parameters []Parameter
+		translated string
+	}{
+		{"ru:one", "ru", files, []Parameter{P("n", 21)}, "21 файл"},
+		{"ru:few", "ru", files, []Parameter{P("n", 3)}, "3 файла"},
+		{"ru:many", "ru", files, []Parameter{P("n", 12)}, "12 файлов"},
+		{"sv:ordinal", "sv", "{n, selectordinal, one {#:a} other {#:e}}", []Parameter{P("n", 2)}, "2:a"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Translate(tc.tag, tc.message, tc.parameters...)
+			if err != nil {
+				t.Errorf("parse: %s", err)
+			}
+			if tc.translated != got {
+				t.Errorf("expected: '%s', got: '%s'", tc.translated, got)
+			}
+		})
+	}
+}
+
+func TestTranslateRegionalLocales(t *testing.T) {
+	requireFullData(t)
+	d := time.Date(2024, time.January, 3, 14, 5, 0, 0, time.UTC)
+	testCases := []struct {
+		name       string
+		tag        Tag
+		message    MessageFormat
+		parameters []Parameter
+		translated string
+	}{
+		{"en-GB", "en-GB", "{d, date, short} | {d, time, short} | {l, list}", []Parameter{P("d", d), P("l", []string{"a", "b", "c"})}, "03/01/2024 | 14:05 | a, b and c"},
+		{"en-AU", "en-AU", "{l, list}", []Parameter{P("l", []string{"a", "b", "c"})}, "a, b and c"},
+		{"en-US", "en-US", "{d, date, short} | {l, list}", []Parameter{P("d", d), P("l", []string{"a", "b", "c"})}, "1/3/24 | a, b, and c"},
+		{"pt-PT", "pt-PT", "{n, plural, one {# ficheiro} other {# ficheiros}}", []Parameter{P("n", 0)}, "0 ficheiros"},
+		{"pt-BR", "pt-BR", "{n, plural, one {# arquivo} other {# arquivos}}", []Parameter{P("n", 0)}, "0 arquivo"},
+		{"fr-CA", "fr-CA", "{d, date, short} | {n, number, ::.0}", []Parameter{P("d", d), P("n", 1234.5)}, "2024-01-03 | 1 234,5"},
+		{"es-MX", "es-MX", "{n, number, ::.0}", []Parameter{P("n", 12345.5)}, "12,345.5"},
+		{"zh-TW", "zh-TW", "{d, date, full}", []Parameter{P("d", d)}, "2024年1月3日 星期三"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Translate(tc.tag, tc.message, tc.parameters...)
+			if err != nil {
+				t.Errorf("parse: %s", err)
+			}
+			if tc.translated != got {
+				t.Errorf("want: %q, got: %q", tc.translated, got)
+			}
+		})
+	}
+}
+
+// requireFullData skips tests which need locale data beyond English, which
+// builds with the icu_subset tag leave out.
+func requireFullData(t *testing.T) {
+	t.Helper()
+	if _, ok := localeDataTable["de"]; !ok {
+		t.Skip("needs the full locale data")
+	}
+}
@@ -0,0 +1,280 @@
+package icu
+
+import (
+	"strings"
+	"sync"
+)
+
+// Confidence tells how well a supported tag matches a desired one.
+type Confidence int
+
+const (
+	// No supported tag is close enough.
+	No Confidence = iota
+	// Low matches are fallback languages or other scripts, e.g. "en" for
+	// "af".
+	Low
+	// High matches are other regions or mutually intelligible languages,
+	// e.g. "en-GB" for "en-IN" and "no" for "nb".
+	High
+	// Exact matches are equal once likely subtags are added, e.g. "zh-Hant"
+	// for "zh-TW".
+	Exact
+)
+
+func (c Confidence) String() string {
+	switch c {
+	case Low:
+		return "Low"
+	case High:
+		return "High"
+	case Exact:
+		return "Exact"
+	}
+	return "No"
+}
+
+// Distances of the CLDR language matching algorithm. Matches need to be
+// closer than matchThreshold, each further desired tag is demoted by
+// matchDemotion. Distances are shifted by matchShift to tell apart tags
+// which are equal once maximized.
+const (
+	matchThreshold = 50
+	matchDemotion  = 5
+	matchHigh      = 10
+	matchShift     = 3
+)
+
+// languageMatch is a CLDR language matching rule, e.g. "en-*-$!enUS" to
+// "en-*-GB" with a distance of 3.
+type languageMatch struct {
+	desired   string
+	supported string
+	distance  int
+	oneway    bool
+}
+
+type matchRule struct {
+	desired   []string
+	supported []string
+	distance  int
+	oneway    bool
+}
+
+var (
+	matchRulesOnce sync.Once
+	matchRules     []matchRule
+	matchVariables map[string]map[string]bool
+)
+
+func compileMatchRules() {
+	for _, m := range languageMatches {
+		matchRules = append(matchRules, matchRule{
+			desired:   strings.Split(m.desired, "-"),
+			supported: strings.Split(m.supported, "-"),
+			distance:  m.distance,
+			oneway:    m.oneway,
+		})
+	}
+	matchVariables = map[string]map[string]bool{}
+	for id, regions := range matchRegions {
+		set := map[string]bool{}
+		for _, r := range strings.Fields(regions) {
+			set[r] = true
+		}
+		matchVariables[id] = set
+	}
+}
+
+// matches tells whether the rule matches subtags of the same level, e.g. the
+// language and script of the desired and the supported locale.
+func (r matchRule) matches(desired []string, supported []string) bool {
+	if len(r.desired) != len(desired) {
+		return false
+	}
+	for i := range desired {
+		if !matchSubtag(r.desired[i], desired[i]) || !matchSubtag(r.supported[i], supported[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchSubtag tells whether a subtag matches the pattern of a rule: "*", the
+// subtag itself or a variable of regions like "$enUS" or its negation
+// "$!enUS".
+func matchSubtag(pattern string, subtag string) bool {
+	switch {
+	case pattern == "*":
+		return true
+	case strings.HasPrefix(pattern, "$!"):
+		return !matchVariables["$"+pattern[2:]][subtag]
+	case strings.HasPrefix(pattern, "$"):
+		return matchVariables[pattern][subtag]
+	}
+	return pattern == subtag
+}
+
+// matchDistance returns the distance between two maximized locales as the sum
+// of the distances of their languages, scripts and regions. Differing
+// subtags take the distance of the first rule matching them.
+func matchDistance(desired Locale, supported Locale) int {
+	matchRulesOnce.Do(compileMatchRules)
+	d := []string{desired.Language, desired.Script, desired.Region}
+	s := []string{supported.Language, supported.Script, supported.Region}
+	distance := 0
+	for n := 1; n <= len(d); n++ {
+		if d[n-1] == s[n-1] {
+			continue
+		}
+		for _, r := range matchRules {
+			if r.matches(d[:n], s[:n]) || !r.oneway && r.matches(s[:n], d[:n]) {
+				distance += r.distance
+				break
+			}
+		}
+	}
+	return distance
+}
+
+// Matcher finds the best of a set of supported tags for desired tags, using
+// the language matching distances of CLDR, e.g. "de" for "de-CH" and
+// "zh-Hant" for "zh-TW".
+type Matcher struct {
+	supported []Tag
+	locales   []Locale
+	maximized []Locale
+	order     []int
+}
+
+// NewMatcher returns a matcher for the supported tags. The first one is
+// returned when nothing matches.
+func NewMatcher(supported ...Tag) *Matcher {
+	m := &Matcher{supported: supported}
+	var paradigms, others []int
+	for i, t := range supported {
+		l := t.Locale().Canonicalize()
+		m.locales = append(m.locales, l)
+		m.maximized = append(m.maximized, l.Maximize())
+		if isParadigmLocale(m.maximized[i]) {
+			paradigms = append(paradigms, i)
+		} else {
+			others = append(others, i)
+		}
+	}
+	m.order = append(paradigms, others...)
+	return m
+}
+
+func isParadigmLocale(l Locale) bool {
+	for _, t := range paradigmLocales {
+		p := t.Locale().Maximize()
+		if p.Language == l.Language && p.Script == l.Script && p.Region == l.Region {
+			return true
+		}
+	}
+	return false
+}
+
+// Match returns the supported tag best matching the desired tags, which are
+// ordered by preference, its index and the confidence in the match. Without
+// a match the first supported tag is returned with confidence No.
+func (m *Matcher) Match(desired ...Tag) (Tag, int, Confidence) {
+	return m.match(func(int) bool { return false }, desired)
+}
+
+// match is Match leaving out the supported tags whose index is skipped.
+func (m *Matcher) match(skip func(j int) bool, desired []Tag) (Tag, int, Confidence) {
+	best, bestDistance, bestConfidence := -1, matchThreshold<<matchShift, No
+	for i, t := range desired {
+		demotion := i * matchDemotion << matchShift
+		if demotion >= bestDistance {
+			break
+		}
+		l := t.Locale().Canonicalize()
+		if isUndetermined(l) {
+			for j, s := range m.locales {
+				if !skip(j) && s.String() == l.String() {
+					return m.supported[j], j, Exact
+				}
+			}
+			continue
+		}
+		max := l.Maximize()
+		for _, j := range m.order {
+			if skip(j) || isUndetermined(m.locales[j]) {
+				continue
+			}
+			d := matchDistance(max, m.maximized[j])
+			sd := d << matchShift
+			if d == 0 {
+				sd = explicitSubtags(l) ^ explicitSubtags(m.locales[j])
+			}
+			switch {
+			case sd+demotion < bestDistance:
+			case sd+demotion == bestDistance && best >= 0 && moreLikely(m.maximized[j], m.maximized[best]):
+			default:
+				continue
+			}
+			best, bestDistance = j, sd+demotion
+			switch {
+			case d == 0:
+				bestConfidence = Exact
+			case d < matchHigh:
+				bestConfidence = High
+			default:
+				bestConfidence = Low
+			}
+			if sd == 0 {
+				return m.supported[j], j, Exact
+			}
+		}
+	}
+	if best < 0 {
+		for j, t := range m.supported {
+			if !skip(j) {
+				return t, j, No
+			}
+		}
+		return "", -1, No
+	}
+	return m.supported[best], best, bestConfidence
+}
+
+// isUndetermined tells whether a locale has neither a language, a script nor
+// a region, e.g. "und" or "x-private", which only match themselves.
+func isUndetermined(l Locale) bool {
+	return l.Language == undetermined && l.Script == "" && l.Region == ""
+}
+
+// explicitSubtags returns bits for the script and the region of a locale
+// being given, which prefers "nl-NL" over "nl" for "nl-Latn-NL" although
+// all of them are equal once maximized.
+func explicitSubtags(l Locale) int {
+	bits := 0
+	if l.Script != "" {
+		bits |= 2
+	}
+	if l.Region != "" {
+		bits |= 1
+	}
+	return bits
+}
+
+// moreLikely tells whether a maximized locale has the likely script or
+// region of its language where it differs from another one of the same
+// language.
+func moreLikely(l Locale, other Locale) bool {
+	if l.Language != other.Language {
+		return false
+	}
+	likely := Locale{Language: l.Language}
+	if l.Script == other.Script {
+		likely.Script = l.Script
+	}
+	likely = likely.Maximize()
+	if l.Script != other.Script {
+		return l.Script == likely.Script
+	}
+	return l.Region != other.Region && l.Region == likely.Region
+}
@@ -0,0 +1,124 @@
+package icu
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMatcher(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		supported  []Tag
+		desired    []Tag
+		want       Tag
+		confidence Confidence
+	}{
+		{[]Tag{"en", "de"}, []Tag{"de-CH"}, "de", High},
+		{[]Tag{"en", "zh-Hans", "zh-Hant"}, []Tag{"zh-TW"}, "zh-Hant", Exact},
+		{[]Tag{"en", "zh-Hant"}, []Tag{"zh-CN"}, "en", No},
+		{[]Tag{"fr", "en"}, []Tag{"af"}, "en", Low},
+		{[]Tag{"en", "no"}, []Tag{"nb"}, "no", Exact},
+		{[]Tag{"en", "nb"}, []Tag{"no-NO"}, "nb", Exact},
+		{[]Tag{"en-US", "en-GB"}, []Tag{"en-IN"}, "en-GB", High},
+		{[]Tag{"en", "en-GB"}, []Tag{"en-AU"}, "en-GB", High},
+		{[]Tag{"en-US", "en-GB"}, []Tag{"en-CA"}, "en-US", High},
+		{[]Tag{"es", "es-419"}, []Tag{"es-AR"}, "es-419", High},
+		{[]Tag{"pt-BR", "pt-PT"}, []Tag{"pt-AO"}, "pt-PT", High},
+		{[]Tag{"fr", "hr"}, []Tag{"bs"}, "hr", High},
+		{[]Tag{"fr", "de"}, []Tag{"gsw"}, "de", High},
+		{[]Tag{"gsw", "fr"}, []Tag{"de"}, "gsw", No},
+		{[]Tag{"nl", "nl-NL", "nl-BE"}, []Tag{"nl-Latn-NL"}, "nl-NL", Exact},
+		{[]Tag{"fr", "en-GB", "en"}, []Tag{"ja"}, "fr", No},
+		{[]Tag{"fr", "de", "it"}, []Tag{"ja", "it", "de"}, "it", Exact},
+		{[]Tag{"de", "fr-BE"}, []Tag{"fr", "de"}, "fr-BE", High},
+		{[]Tag{"it", "und"}, []Tag{"en"}, "it", No},
+		{[]Tag{"en", "fr"}, nil, "en", No},
+	}
+	for _, tc := range testCases {
+		name := strings.Join(tagStrings(tc.desired), ",") + " in " + strings.Join(tagStrings(tc.supported), ",")
+		t.Run(name, func(t *testing.T) {
+			got, i, c := NewMatcher(tc.supported...).Match(tc.desired...)
+			if tc.want != got || tc.confidence != c {
+				t.Errorf("want: %s (%s), got: %s (%s)", tc.want, tc.confidence, got, c)
+			}
+			if tc.supported[i] != got {
+				t.Errorf("want index of %s, got: %d", got, i)
+			}
+		})
+	}
+}
+
+func tagStrings(tags []Tag) []string {
+	var ss []string
+	for _, t := range tags {
+		ss = append(ss, string(t))
+	}
+	return ss
+}
+
+func TestMatchDistance(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		desired   Tag
+		supported Tag
+		want      int
+	}{
+		{"en", "en-US", 0},
+		{"en-IN", "en-GB", 3},
+		{"en-IN", "en-AU", 4},
+		{"en-IN", "en-US", 5},
+		{"es-MX", "es-419", 4},
+		{"es-MX", "es-ES", 5},
+		{"zh-HK", "zh-MO", 4},
+		{"zh-HK", "zh-TW", 5},
+		{"zh-Hans", "zh-Hant", 54},
+		{"af", "en", 24},
+		{"en", "af", 84},
+		{"sr-Latn", "sr-Cyrl", 5},
+		{"da", "nb", 12},
+		{"de", "fr", 84},
+		{"en", "ja", 134},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.desired)+" "+string(tc.supported), func(t *testing.T) {
+			if got := matchDistance(tc.desired.Locale().Maximize(), tc.supported.Locale().Maximize()); tc.want != got {
+				t.Errorf("want: %d, got: %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTranslatorForRequestMatching(t *testing.T) {
+	dir := t.TempDir()
+	for tag, hello := range map[string]string{"de": "Hallo", "zh-Hant": "你好", "en-GB": "Hello", "en-US": "Howdy", "no": "Hei"} {
+		content := "[Translations]\nhello = \"" + hello + "\"\n"
+		if err := os.WriteFile(filepath.Join(dir, tag+".toml"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	b := NewBundle(dir, "en-US")
+	testCases := []struct {
+		in   string
+		want string
+	}{
+		{"de-CH", "Hallo"},
+		{"zh-TW", "你好"},
+		{"nb-NO", "Hei"},
+		{"en-IN", "Hello"},
+		{"en-CA", "Howdy"},
+		{"ja", "Howdy"},
+		{"ja, de-AT;q=0.5", "Hallo"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.in, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.Header.Set(HeaderAcceptLanguage, tc.in)
+			if got := b.TranslatorForRequest(r).Translate("hello"); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
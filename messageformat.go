@@ -52,9 +52,23 @@ type Parameter struct {
 }
 
 func Translate(tag Tag, msg MessageFormat, ps ...Parameter) (string, error) {
+	return translate(tag, msg, nil, ps...)
+}
+
+// translate parses and translates a message with the formatters of a
+// translator. With the Strict parameter, arguments of unknown types are an
+// error.
+func translate(tag Tag, msg MessageFormat, fs *formatterRegistry, ps ...Parameter) (string, error) {
 	node, err := parse(string(msg))
 	if err != nil {
 		return "", err
 	}
-	return node.translate(newContext(tag, ps...)), nil
+	ctx := newContext(tag, ps...)
+	ctx.formatters = fs
+	if strict, _ := ctx.values[strictParameter].(bool); strict {
+		if err := checkTypes(node, ctx); err != nil {
+			return "", err
+		}
+	}
+	return node.translate(ctx), nil
 }
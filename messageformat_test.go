@@ -38,6 +38,7 @@ func TestTranslate(t *testing.T) {
 		{"quoted:quote:0", "This '{isn''t}' obvious.", nil, "This {isn't} obvious."},
 		{"quoted:quote:1", "We'll show that this '{isn''t}' obvious.", nil, "We'll show that this {isn't} obvious."},
 		{"quoted:quote:2", "'", nil, "'"},
+		{"quoted:unterminated", "Use '{foo} as a variable.", nil, "Use {foo} as a variable."},
 		{"quoted:quote:3", "''''", nil, "'"},
 		{"#:0", "Use # as a text.", nil, "Use # as a text."},
 		{"#:1", "Value # as a text.", []Parameter{P("value", 1)}, "Value 1 as a text."},
@@ -170,3 +171,29 @@ func TestParametersFrom(t *testing.T) {
 		})
 	}
 }
+
+func TestTranslateError(t *testing.T) {
+	testCases := []MessageFormat{
+		"Hello {name",
+		"{count, plural, one {One}",
+		"{a, plural, one",
+		"{a, select, other",
+		"{a, selectordinal, one",
+		"{a, plural, offset",
+		"{a, plural, offset:x {b}}",
+		"{a, select, x {y}",
+		"}",
+		"a}b",
+		"'{'}",
+		"{a, select, x {y}}}",
+		"{{a}}",
+		"{a, select, {x}}",
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc), func(t *testing.T) {
+			if got, err := tc.Translate(TagEn); err == nil {
+				t.Errorf("want an error, got: %q", got)
+			}
+		})
+	}
+}
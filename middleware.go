@@ -0,0 +1,56 @@
+package icu
+
+import (
+	gocontext "context"
+	"net/http"
+)
+
+const (
+	HeaderContentLanguage = "Content-Language"
+	HeaderVary            = "Vary"
+)
+
+type translatorKey struct{}
+
+// NewContext returns a copy of ctx carrying a translator.
+func NewContext(ctx gocontext.Context, t Translator) gocontext.Context {
+	return gocontext.WithValue(ctx, translatorKey{}, t)
+}
+
+// FromContext returns the translator carried by ctx, or a translator
+// returning the keys.
+func FromContext(ctx gocontext.Context) Translator {
+	if t, ok := ctx.Value(translatorKey{}).(Translator); ok {
+		return t
+	}
+	return nilTranslator
+}
+
+// Middleware resolves the translator of each request once and passes it to
+// next in the context of the request, see FromContext. The response tells
+// the language in the Content-Language header and varies by Accept-Language
+// and, with a CookieResolver, by Cookie.
+func (b *Bundle) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if b == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		h := w.Header()
+		h.Add(HeaderVary, HeaderAcceptLanguage)
+		b.mu.RLock()
+		for _, res := range b.resolvers {
+			if _, ok := res.(CookieResolver); ok {
+				h.Add(HeaderVary, "Cookie")
+				break
+			}
+		}
+		b.mu.RUnlock()
+		var tr Translator = nilTranslator
+		if t, err := b.translatorForRequest(r); err == nil {
+			h.Set(HeaderContentLanguage, string(t.Tag))
+			tr = t
+		}
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), tr)))
+	})
+}
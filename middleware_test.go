@@ -0,0 +1,70 @@
+package icu
+
+import (
+	gocontext "context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	dir := writeBundle(t, map[string]string{
+		"en.toml": "[Translations]\nhello = \"Hello\"\n",
+		"de.toml": "[Translations]\nhello = \"Hallo\"\n",
+	})
+	b := NewBundle(dir, "en")
+	h := b.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(FromContext(r.Context()).Translate("hello")))
+	}))
+	testCases := []struct {
+		header string
+		want   string
+		lang   string
+	}{
+		{"de-CH, en;q=0.5", "Hallo", "de"},
+		{"ja", "Hello", "en"},
+		{"", "Hello", "en"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.header, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.Header.Set(HeaderAcceptLanguage, tc.header)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			if got := w.Body.String(); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+			if got := w.Header().Get(HeaderContentLanguage); tc.lang != got {
+				t.Errorf("want: %q, got: %q", tc.lang, got)
+			}
+			if got, want := w.Header().Values(HeaderVary), []string{HeaderAcceptLanguage}; !reflect.DeepEqual(want, got) {
+				t.Errorf("want: %q, got: %q", want, got)
+			}
+		})
+	}
+}
+
+func TestMiddlewareVaryCookie(t *testing.T) {
+	b := NewBundle(t.TempDir(), "en")
+	b.SetResolvers(CookieResolver{Name: "lang"}, AcceptLanguageResolver{})
+	h := b.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+	if got, want := w.Header().Values(HeaderVary), []string{HeaderAcceptLanguage, "Cookie"}; !reflect.DeepEqual(want, got) {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+	if got := w.Header().Get(HeaderContentLanguage); got != "" {
+		t.Errorf("want no Content-Language, got: %q", got)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	if got := FromContext(gocontext.Background()).Translate("hello"); got != "hello" {
+		t.Errorf("want: %q, got: %q", "hello", got)
+	}
+	tr := TranslatorFunc(func(key string, ps ...Parameter) string { return "translated " + key })
+	if got := FromContext(NewContext(gocontext.Background(), tr)).Translate("hello"); got != "translated hello" {
+		t.Errorf("want: %q, got: %q", "translated hello", got)
+	}
+}
@@ -0,0 +1,119 @@
+package icu
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+type numberSymbols struct {
+	decimal         string
+	group           string
+	minus           string
+	minimumGrouping int
+}
+
+func numberSymbolsFor(tag Tag) *numberSymbols {
+	_, d := localeDataFor(tag, hasNumbers)
+	return d.numbers
+}
+
+// formatInteger formats n using the minus sign and grouping separator of the
+// locale, e.g. "1.234" in German.
+func formatInteger(tag Tag, n int) string {
+	sym := numberSymbolsFor(tag)
+	if n < 0 {
+		return sym.minus + sym.groupDigits(strconv.FormatUint(uint64(-int64(n)), 10))
+	}
+	return sym.groupDigits(strconv.Itoa(n))
+}
+
+// groupDigits inserts grouping separators into a string of digits. Numbers
+// with less than minimumGrouping digits in the highest group are not grouped.
+func (s *numberSymbols) groupDigits(digits string) string {
+	if len(digits) < 3+s.minimumGrouping {
+		return digits
+	}
+	first := len(digits) % 3
+	if first == 0 {
+		first = 3
+	}
+	res := digits[:first]
+	for i := first; i < len(digits); i += 3 {
+		res += s.group + digits[i:i+3]
+	}
+	return res
+}
+
+// decimalDigits rounds x to the fraction digits of a simple decimal pattern
+// like "#,##0.0#" and returns the absolute value with ASCII digits, e.g.
+// "1234.5". The pattern "0.0#" allows one or two fraction digits.
+func decimalDigits(x float64, pattern string) string {
+	fracPattern := ""
+	if i := strings.Index(pattern, "."); i >= 0 {
+		fracPattern = pattern[i+1:]
+	}
+	minFrac := strings.Count(fracPattern, "0")
+	s := strconv.FormatFloat(math.Abs(x), 'f', len(fracPattern), 64)
+	if i := strings.Index(s, "."); i >= 0 {
+		end := len(s)
+		for end > i+1+minFrac && s[end-1] == '0' {
+			end--
+		}
+		if end == i+1 {
+			end = i
+		}
+		s = s[:end]
+	}
+	return s
+}
+
+// localizeDigits replaces the decimal point of digits by the one of the
+// locale and groups the integer digits if grouping is set.
+func localizeDigits(tag Tag, digits string, negative bool, grouping bool) string {
+	return numberSymbolsFor(tag).localize(digits, negative, grouping)
+}
+
+func (s *numberSymbols) localize(digits string, negative bool, grouping bool) string {
+	integer, frac := digits, ""
+	if i := strings.Index(digits, "."); i >= 0 {
+		integer, frac = digits[:i], digits[i+1:]
+	}
+	if grouping {
+		integer = s.groupDigits(integer)
+	}
+	if frac != "" {
+		integer += s.decimal + frac
+	}
+	if negative {
+		return s.minus + integer
+	}
+	return integer
+}
+
+// formatDecimalPattern formats x with a simple decimal pattern like "#,##0.#"
+// or "0.0", which determines grouping and the number of fraction digits.
+func formatDecimalPattern(tag Tag, x float64, pattern string) string {
+	digits := decimalDigits(x, pattern)
+	return localizeDigits(tag, digits, x < 0 && strings.Trim(digits, "0.") != "", patternGrouping(pattern))
+}
+
+// patternGrouping reports whether a decimal pattern groups integer digits.
+func patternGrouping(pattern string) bool {
+	if i := strings.Index(pattern, "."); i >= 0 {
+		pattern = pattern[:i]
+	}
+	return strings.Contains(pattern, ",")
+}
+
+// toFloat converts numeric values to float64.
+func toFloat(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	i, ok := toInt(v)
+	return float64(i), ok
+}
@@ -0,0 +1,170 @@
+package icu
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+const numberingLatn = "latn"
+
+// numberingSystem has either the ten digits of a decimal numbering system or
+// the rule set of an algorithmic one like "%roman-upper".
+type numberingSystem struct {
+	digits  string
+	ruleSet string
+}
+
+var numberingFormats = &rbnfCache{rules: numberingRules, fallback: "root", m: map[string]*RuleBasedNumberFormat{}}
+
+// NumberingSystem returns the numbering system of a tag, which is either
+// given by a "-u-nu-" extension like in "en-u-nu-thai" or is the default of
+// the locale, e.g. "arab" for "ar-EG" and "latn" for "en".
+func NumberingSystem(tag Tag) string {
+	if nu := unicodeExtension(tag, "nu"); nu != "" {
+		if _, ok := numberingSystemData[nu]; ok {
+			return nu
+		}
+	}
+	lang, script, region := splitTag(tag)
+	for _, k := range []string{lang + "-" + region, lang + "-" + script, lang} {
+		if nu, ok := defaultNumberingSystems[k]; ok {
+			return nu
+		}
+	}
+	return numberingLatn
+}
+
+// FormatDigits replaces the ASCII digits in s by the ones of a numbering
+// system, e.g. "١٢٣" for "123" in "arab". Algorithmic numbering systems like
+// "roman" replace each run of digits, e.g. "XII" for "12".
+func FormatDigits(s string, numbering string) string {
+	ns, ok := numberingSystemData[numbering]
+	if !ok || numbering == numberingLatn {
+		return s
+	}
+	if ns.ruleSet != "" {
+		return replaceDigitRuns(s, func(digits string) string {
+			if r, ok := formatAlgorithmic(ns.ruleSet, digits); ok {
+				return r
+			}
+			return digits
+		})
+	}
+	digits := []rune(ns.digits)
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return digits[r-'0']
+		}
+		return r
+	}, s)
+}
+
+// FormatNumber formats v with up to three fraction digits in the numbering
+// system of the tag, e.g. "1,234.5" for "en", "1.234,5" for "de" and
+// "١٬٢٣٤٫٥" for "ar-EG".
+func FormatNumber(tag Tag, v float64) string {
+	return formatNumber(baseLocale(tag), NumberingSystem(tag), v, "#,##0.###")
+}
+
+// formatNumber formats v with a simple decimal pattern like "#,##0.#" in a
+// numbering system.
+func formatNumber(tag Tag, numbering string, v float64, pattern string) string {
+	digits := decimalDigits(v, pattern)
+	return localizeNumber(tag, numbering, digits, v < 0 && strings.Trim(digits, "0.") != "", patternGrouping(pattern))
+}
+
+// localizeNumber is localizeDigits in a numbering system. Algorithmic
+// numbering systems only format integers, others use latn digits.
+func localizeNumber(tag Tag, numbering string, digits string, negative bool, grouping bool) string {
+	ns := numberingSystemData[numbering]
+	if ns.ruleSet != "" {
+		d := digits
+		if negative {
+			d = "-" + d
+		}
+		if s, ok := formatAlgorithmic(ns.ruleSet, d); ok {
+			return s
+		}
+		return localizeDigits(tag, digits, negative, grouping)
+	}
+	sym := *numberSymbolsFor(tag)
+	if s, ok := numberingSymbolData[numbering]; ok {
+		sym.decimal, sym.group, sym.minus = s.decimal, s.group, s.minus
+	}
+	return FormatDigits(sym.localize(digits, negative, grouping), numbering)
+}
+
+// formatNumeral formats x like %v in a numbering system with the symbols of
+// the locale, e.g. "١٢٫٥" for 12.5 in "arab".
+func formatNumeral(tag Tag, numbering string, x float64) string {
+	return localizeNumber(tag, numbering, strconv.FormatFloat(math.Abs(x), 'f', -1, 64), x < 0, false)
+}
+
+// localizeNumerals formats the numbers in s, e.g. written by fmt.Sprintf,
+// in a numbering system with the symbols of the locale, e.g. "-١٢٫٥٠" for
+// "-12.50" in "arab". A minus sign is only taken as such at the start of s
+// or after a space.
+func localizeNumerals(tag Tag, numbering string, s string) string {
+	if numbering == numberingLatn {
+		return s
+	}
+	isDigit := func(i int) bool { return i < len(s) && s[i] >= '0' && s[i] <= '9' }
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		negative := s[i] == '-' && isDigit(i+1) && (i == 0 || s[i-1] == ' ')
+		start := i
+		if negative {
+			start++
+		}
+		if !isDigit(start) {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+		j := start
+		for isDigit(j) {
+			j++
+		}
+		if j < len(s) && s[j] == '.' && isDigit(j+1) {
+			for j++; isDigit(j); j++ {
+			}
+		}
+		b.WriteString(localizeNumber(tag, numbering, s[start:j], negative, false))
+		i = j
+	}
+	return b.String()
+}
+
+// formatAlgorithmic formats an integer given by its digits with a rule set
+// of the root numbering system rules.
+func formatAlgorithmic(ruleSet string, digits string) (string, bool) {
+	n, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return "", false
+	}
+	f, err := numberingFormats.get("root")
+	if err != nil {
+		return "", false
+	}
+	s, err := f.FormatInt(n, ruleSet)
+	return s, err == nil
+}
+
+func replaceDigitRuns(s string, f func(digits string) string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		j := i
+		for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+			j++
+		}
+		if j > i {
+			b.WriteString(f(s[i:j]))
+			i = j
+			continue
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+	return b.String()
+}
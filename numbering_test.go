@@ -0,0 +1,116 @@
+package icu
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNumberingSystem(t *testing.T) {
+	testCases := []struct {
+		tag  Tag
+		want string
+	}{
+		{"en", "latn"},
+		{"ar", "latn"},
+		{"ar-EG", "arab"},
+		{"ar_SA", "arab"},
+		{"ar-EG-u-nu-latn", "latn"},
+		{"fa", "arabext"},
+		{"bn", "beng"},
+		{"en-u-ca-gregory-nu-thai", "thai"},
+		{"en-u-nu-roman", "roman"},
+		{"en-u-nu-unknown", "latn"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag), func(t *testing.T) {
+			if got := NumberingSystem(tc.tag); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		tag   Tag
+		value float64
+		want  string
+	}{
+		{"en", 1234.5, "1,234.5"},
+		{"de", 1234.5, "1.234,5"},
+		{"ar-EG", 1234.5, "١٬٢٣٤٫٥"},
+		{"ar-EG", -42, "؜-٤٢"},
+		{"fa", 1234.5, "۱٬۲۳۴٫۵"},
+		{"de-u-nu-deva", 1234.5, "१.२३४,५"},
+		{"en-u-nu-beng", 1234.5, "১,২৩৪.৫"},
+		{"th-u-nu-thai", 1234.5, "๑,๒๓๔.๕"},
+		{"zh-u-nu-hanidec", 2024, "二,〇二四"},
+		{"ja-u-nu-fullwide", 1234.5, "１,２３４.５"},
+		{"en-u-nu-roman", 2024, "MMXXIV"},
+		{"en-u-nu-roman", 1234.5, "1,234.5"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag)+":"+tc.want, func(t *testing.T) {
+			if got := FormatNumber(tc.tag, tc.value); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatDigits(t *testing.T) {
+	testCases := []struct {
+		s         string
+		numbering string
+		want      string
+	}{
+		{"2024-03-05", "latn", "2024-03-05"},
+		{"2024-03-05", "arab", "٢٠٢٤-٠٣-٠٥"},
+		{"2024-03-05", "roman", "MMXXIV-III-V"},
+		{"12", "romanlow", "xii"},
+		{"12", "unknown", "12"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.numbering+":"+tc.want, func(t *testing.T) {
+			if got := FormatDigits(tc.s, tc.numbering); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTranslateNumberingSystem(t *testing.T) {
+	requireFullData(t)
+	date := time.Date(2024, time.March, 5, 14, 7, 0, 0, time.UTC)
+	testCases := []struct {
+		name       string
+		tag        Tag
+		message    MessageFormat
+		parameters []Parameter
+		translated string
+	}{
+		{"plural", "ar-EG", "{n, plural, other {# items}}", []Parameter{P("n", 12)}, "١٢ items"},
+		{"number", "en-u-nu-arab", "{n, number}", []Parameter{P("n", 1234.5)}, "١٢٣٤٫٥"},
+		{"number:format", "ar-EG", "{n, number, %.2f}", []Parameter{P("n", 12.5)}, "١٢٫٥٠"},
+		{"number:format:negative", "ar-EG", "{n, number, %.1f}", []Parameter{P("n", -2.5)}, "\u061c-٢٫٥"},
+		{"number:text", "ar-EG", "{n, number, %v}", []Parameter{P("n", "A12")}, "A12"},
+		{"hash:text", "ar-EG", "{s, select, other {Code #}}", []Parameter{P("s", "A12")}, "Code A12"},
+		{"skeleton", "de-u-nu-deva", "{n, number, ::.00}", []Parameter{P("n", 1234.5)}, "१.२३४,५०"},
+		{"unit", "ar-EG", "{n, number, ::unit/kilometer}", []Parameter{P("n", 2.5)}, "٢٫٥ km"},
+		{"date", "en-u-nu-thai", "{d, date, short}", []Parameter{P("d", date)}, "๓/๕/๒๔"},
+		{"time", "en-u-nu-roman", "{d, time, HH:mm}", []Parameter{P("d", date)}, "XIV:VII"},
+		{"latn", "en", "{n, number} {d, date, short}", []Parameter{P("n", 1234.5), P("d", date)}, "1234.5 3/5/24"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Translate(tc.tag, tc.message, tc.parameters...)
+			if err != nil {
+				t.Errorf("parse: %s", err)
+			}
+			if tc.translated != got {
+				t.Errorf("expected: '%s', got: '%s'", tc.translated, got)
+			}
+		})
+	}
+}
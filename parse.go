@@ -17,6 +17,9 @@ func (s *stack) push(t node) {
 }
 
 func (s *stack) pop() node {
+	if len(s.nodes) == 0 {
+		return nil
+	}
 	x := s.nodes[len(s.nodes)-1]
 	s.nodes = s.nodes[:len(s.nodes)-1]
 	return x
@@ -42,24 +45,75 @@ type node interface {
 }
 
 func newContext(tag Tag, ps ...Parameter) *context {
-	idx := strings.Index(string(tag), "-")
-	t := tag
-	if idx > 0 {
-		t = tag[:idx]
-	}
 	ctx := &context{
-		tag:    t,
-		values: map[string]interface{}{},
+		tag:       baseLocale(tag),
+		region:    regionOf(tag),
+		numbering: NumberingSystem(tag),
+		calendar:  Calendar(tag),
+		direction: tag.Direction(),
+		values:    map[string]interface{}{},
 	}
 	for _, p := range ps {
 		ctx.values[p.Name] = p.Value
 	}
+	ctx.location = locationFrom(ctx.values[timeZoneParameter])
+	ctx.isolate, _ = ctx.values[bidiIsolationParameter].(bool)
 	return ctx
 }
 
+// baseLocale returns the canonical language, script and region subtags of a
+// tag without extensions, e.g. "he-IL" for "iw-il-u-nu-hebr".
+func baseLocale(tag Tag) Tag {
+	return Tag(canonicalLocale(tag))
+}
+
+// regionOf returns the region subtag of a tag, e.g. "CH" for "de-CH", or the
+// likely region of its language, e.g. "US" for "en". Languages without likely
+// subtags are in the world, "001".
+func regionOf(tag Tag) string {
+	l := tag.Locale().Canonicalize()
+	if l.Region != "" {
+		return l.Region
+	}
+	if l.Language != undetermined {
+		if lsr, ok := likelyLookup(l.Language, l.Script, ""); ok {
+			return likelyLocale(lsr).Region
+		}
+	}
+	return "001"
+}
+
+// splitTag returns the canonical language, script and region subtags of a
+// tag, e.g. "zh", "Hant" and "TW" for "zh-hant-tw".
+func splitTag(tag Tag) (lang string, script string, region string) {
+	l := tag.Locale().Canonicalize()
+	return l.Language, l.Script, l.Region
+}
+
+// unicodeExtension returns the type of a key in the "-u-" extension of a tag,
+// e.g. "arab" for the key "nu" of "ar-u-ca-islamic-nu-arab".
+func unicodeExtension(tag Tag, key string) string {
+	return tag.Locale().Keyword(key)
+}
+
 type context struct {
-	tag    Tag
-	values map[string]interface{}
+	tag        Tag
+	region     string
+	numbering  string
+	calendar   string
+	direction  Direction
+	isolate    bool
+	location   *time.Location
+	values     map[string]interface{}
+	formatters *formatterRegistry
+}
+
+// inZone converts t to the time zone of the context, if there is one.
+func (ctx *context) inZone(t time.Time) time.Time {
+	if ctx.location == nil {
+		return t
+	}
+	return t.In(ctx.location)
 }
 
 type nodeSelector string
@@ -85,7 +139,11 @@ type nodeMessage []node
 func (n nodeMessage) translate(ctx *context) string {
 	buf := bytes.Buffer{}
 	for _, c := range n {
-		buf.WriteString(c.translate(ctx))
+		s := c.translate(ctx)
+		if ctx.isolate && isArgument(c) {
+			s = isolate(s, ctx.direction)
+		}
+		buf.WriteString(s)
 	}
 	return buf.String()
 }
@@ -99,14 +157,17 @@ func (n nodeText) translate(ctx *context) string {
 type nodeHash struct{}
 
 func (n nodeHash) translate(ctx *context) string {
-	var vals []string
+	var vals []interface{}
 	for k, v := range ctx.values {
 		if !strings.HasPrefix(k, "$") {
-			vals = append(vals, fmt.Sprint(v))
+			vals = append(vals, v)
 		}
 	}
 	if len(vals) == 1 {
-		return vals[0]
+		if x, ok := toFloat(vals[0]); ok && ctx.numbering != numberingLatn {
+			return formatNumeral(ctx.tag, ctx.numbering, x)
+		}
+		return fmt.Sprint(vals[0])
 	}
 	return "#"
 }
@@ -126,6 +187,9 @@ func (n nodeFormatPlaceholder) translate(ctx *context) string {
 	if !ok {
 		return ""
 	}
+	if items, ok := listItems(v); ok {
+		return FormatList(ctx.tag, items, ListStyle{})
+	}
 	return fmt.Sprintf("%v", v)
 }
 
@@ -139,10 +203,27 @@ func (n nodeFormatNumber) translate(ctx *context) string {
 	if !ok {
 		return ""
 	}
+	if strings.HasPrefix(n.style, "::") {
+		x, ok := toFloat(v)
+		if !ok {
+			return fmt.Sprintf("%v", v)
+		}
+		s, err := formatNumberSkeleton(ctx.tag, ctx.region, ctx.numbering, x, n.style[2:])
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return s
+	}
 	if n.style == "" {
+		if x, ok := toFloat(v); ok && ctx.numbering != numberingLatn {
+			return formatNumeral(ctx.tag, ctx.numbering, x)
+		}
 		n.style = "%v"
 	}
-	return fmt.Sprintf(n.style, v)
+	if _, ok := toFloat(v); !ok {
+		return fmt.Sprintf(n.style, v)
+	}
+	return localizeNumerals(ctx.tag, ctx.numbering, fmt.Sprintf(n.style, v))
 }
 
 type nodeFormatDate struct {
@@ -155,12 +236,19 @@ func (n nodeFormatDate) translate(ctx *context) string {
 	if !ok {
 		return ""
 	}
-
+	date, ok := v.(time.Time)
+	if !ok {
+		return fmt.Sprintf("%v", v)
+	}
+	date = ctx.inZone(date)
+	// A layout passed as $date-format takes precedence over the style.
 	format, ok := ctx.values["$date-format"]
 	if !ok {
+		if n.style != "" {
+			return formatDateTime(ctx.tag, ctx.calendar, ctx.numbering, date, datePattern(ctx.tag, ctx.calendar, n.style))
+		}
 		format = time.RFC3339
 	}
-	date := v.(time.Time)
 	return fmt.Sprintf("%v", date.Format(format.(string)))
 }
 
@@ -174,46 +262,15 @@ func (n nodeFormatTime) translate(ctx *context) string {
 	if !ok {
 		return ""
 	}
-	return fmt.Sprintf("%v", v)
-}
-
-type nodeFormatOrdinal struct {
-	key   string
-	style string
-}
-
-func (n nodeFormatOrdinal) translate(ctx *context) string {
-	v, ok := ctx.values[n.key]
+	t, ok := v.(time.Time)
 	if !ok {
-		return ""
+		return fmt.Sprintf("%v", v)
 	}
-	return fmt.Sprintf("%v", v)
-}
-
-type nodeFormatDuration struct {
-	key   string
-	style string
-}
-
-func (n nodeFormatDuration) translate(ctx *context) string {
-	v, ok := ctx.values[n.key]
-	if !ok {
-		return ""
-	}
-	return fmt.Sprintf("%v", v)
-}
-
-type nodeFormatSpellout struct {
-	key   string
-	style string
-}
-
-func (n nodeFormatSpellout) translate(ctx *context) string {
-	v, ok := ctx.values[n.key]
-	if !ok {
-		return ""
+	t = ctx.inZone(t)
+	if n.style != "" {
+		return formatDateTime(ctx.tag, ctx.calendar, ctx.numbering, t, timePattern(ctx.tag, ctx.calendar, n.style))
 	}
-	return fmt.Sprintf("%v", v)
+	return fmt.Sprintf("%v", t)
 }
 
 const (
@@ -225,50 +282,6 @@ const (
 	other = "other"
 )
 
-func cardinalToCategory(tag Tag, n int) string {
-	result := ""
-	switch tag {
-	case "de", "es", "bg", "pt", "en", "it":
-		if n == 1 {
-			result = one
-		} else {
-			result = other
-		}
-	case "zh":
-		result = other
-	}
-
-	return result
-}
-
-func ordinalToCategory(tag Tag, n int) string {
-	result := ""
-	switch tag {
-	case "es", "bg", "pt", "zh", "de":
-		result = other
-	case "it":
-		if n == 8 || n == 11 || n == 80 || n == 800 {
-			result = many
-		} else {
-			result = other
-		}
-	case "en":
-		t1 := n % 10
-		t2 := n % 100
-		if t1 == 1 && t2 != 11 {
-			result = one
-		} else if t1 == 2 && t2 != 12 {
-			result = two
-		} else if t1 == 3 && t2 != 13 {
-			result = few
-		} else {
-			result = other
-		}
-	}
-
-	return result
-}
-
 type nodeFormatPlural struct {
 	key    string
 	offset int
@@ -367,15 +380,42 @@ func (n nodeFormatCustom) translate(ctx *context) string {
 	if !ok {
 		return ""
 	}
-	return fmt.Sprintf("%s(%v,%v)", n.custom, v, n.args)
+	f, ok := ctx.formatter(n.custom)
+	if !ok {
+		return fmt.Sprintf("%v", v)
+	}
+	s, err := f(ctx.tag, v, n.args)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return s
+}
+
+// appendStyle joins the identifiers making up the style of an argument.
+// Identifiers that were separated by spaces are joined by a single space.
+func appendStyle(style string, val string, spaced bool) string {
+	if style != "" && spaced {
+		return style + " " + val
+	}
+	return style + val
 }
 
 func parse(input string) (nodeMessage, error) {
 	stack := &stack{}
 	lex := newLexer(input)
+	// fail stops the lexer, which may be blocked on the next token.
+	fail := func(format string, args ...interface{}) (nodeMessage, error) {
+		lex.drain()
+		return nil, fmt.Errorf(format, args...)
+	}
+	var prev token
 	for {
 		t := lex.nextToken()
+		spaced := prev.cat == tokenSpace || prev.cat == tokenDelim
+		prev = t
 		switch t.cat {
+		case tokenError:
+			return nil, fmt.Errorf("%s", t.val)
 		case tokenEOF:
 			msg := nodeMessage{}
 			for !stack.empty() {
@@ -414,6 +454,10 @@ func parse(input string) (nodeMessage, error) {
 					stack.push(nodeFormatTime{
 						key: last.key,
 					})
+				case "dateinterval":
+					stack.push(nodeFormatDateInterval{
+						key: last.key,
+					})
 				case "ordinal":
 					stack.push(nodeFormatOrdinal{
 						key: last.key,
@@ -426,6 +470,14 @@ func parse(input string) (nodeMessage, error) {
 					stack.push(nodeFormatSpellout{
 						key: last.key,
 					})
+				case "relativetime":
+					stack.push(nodeFormatRelativeTime{
+						key: last.key,
+					})
+				case "list":
+					stack.push(nodeFormatList{
+						key: last.key,
+					})
 				case "plural":
 					stack.push(nodeFormatPlural{
 						key:    last.key,
@@ -451,15 +503,19 @@ func parse(input string) (nodeMessage, error) {
 				}
 			case nodeFormatNumber:
 				stack.pop()
-				last.style = t.val
+				last.style = appendStyle(last.style, t.val, spaced)
 				stack.push(last)
 			case nodeFormatDate:
 				stack.pop()
-				last.style = t.val
+				last.style = appendStyle(last.style, t.val, spaced)
 				stack.push(last)
 			case nodeFormatTime:
 				stack.pop()
-				last.style = t.val
+				last.style = appendStyle(last.style, t.val, spaced)
+				stack.push(last)
+			case nodeFormatDateInterval:
+				stack.pop()
+				last.style = appendStyle(last.style, t.val, spaced)
 				stack.push(last)
 			case nodeFormatOrdinal:
 				stack.pop()
@@ -467,51 +523,55 @@ func parse(input string) (nodeMessage, error) {
 				stack.push(last)
 			case nodeFormatDuration:
 				stack.pop()
-				last.style = t.val
+				last.style = appendStyle(last.style, t.val, spaced)
 				stack.push(last)
 			case nodeFormatSpellout:
 				stack.pop()
 				last.style = t.val
 				stack.push(last)
+			case nodeFormatRelativeTime:
+				stack.pop()
+				last.style = appendStyle(last.style, t.val, spaced)
+				stack.push(last)
+			case nodeFormatList:
+				stack.pop()
+				last.style = appendStyle(last.style, t.val, spaced)
+				stack.push(last)
 			case nodeFormatSelectOrdinal:
 				stack.pop()
 				// NOTE: Not sure if this is a thing
 				if t.val == "offset" {
-					n := lex.nextToken()
-					last.offset, _ = strconv.Atoi(n.val[1:])
+					offset, err := parseOffset(lex, input)
+					if err != nil {
+						return fail("%v", err)
+					}
+					last.offset = offset
 					stack.push(last)
 				} else {
 					last.cases[t.val] = nodeMessage{}
 					stack.push(last)
 					stack.push(nodeSelector(t.val))
 					stack.push(nodeStartMessage{})
-
-				consume00:
-					for {
-						n := lex.nextToken()
-						if n.cat == tokenStartMessage {
-							break consume00
-						}
+					if err := startMessage(lex, input, t.val); err != nil {
+						return fail("%v", err)
 					}
 				}
 			case nodeFormatPlural:
 				stack.pop()
 				if t.val == "offset" {
-					n := lex.nextToken()
-					last.offset, _ = strconv.Atoi(n.val[1:])
+					offset, err := parseOffset(lex, input)
+					if err != nil {
+						return fail("%v", err)
+					}
+					last.offset = offset
 					stack.push(last)
 				} else {
 					last.cases[t.val] = nodeMessage{}
 					stack.push(last)
 					stack.push(nodeSelector(t.val))
 					stack.push(nodeStartMessage{})
-
-				consume0:
-					for {
-						n := lex.nextToken()
-						if n.cat == tokenStartMessage {
-							break consume0
-						}
+					if err := startMessage(lex, input, t.val); err != nil {
+						return fail("%v", err)
 					}
 				}
 			case nodeFormatSelect:
@@ -520,12 +580,8 @@ func parse(input string) (nodeMessage, error) {
 				stack.push(last)
 				stack.push(nodeSelector(t.val))
 				stack.push(nodeStartMessage{})
-			consume:
-				for {
-					n := lex.nextToken()
-					if n.cat == tokenStartMessage {
-						break consume
-					}
+				if err := startMessage(lex, input, t.val); err != nil {
+					return fail("%v", err)
 				}
 			case nodeFormatCustom:
 				stack.pop()
@@ -537,8 +593,13 @@ func parse(input string) (nodeMessage, error) {
 		pop1:
 			for {
 				switch n := stack.pop().(type) {
+				case nil:
+					return fail("unexpected } in %q", input)
 				case nodeStartMessage:
-					sel := stack.pop().(nodeSelector)
+					sel, ok := stack.pop().(nodeSelector)
+					if !ok {
+						return fail("message without selector in %q", input)
+					}
 					switch comp := stack.pop().(type) {
 					case nodeFormatPlural:
 						comp.cases[string(sel)] = msg
@@ -549,6 +610,8 @@ func parse(input string) (nodeMessage, error) {
 					case nodeFormatSelect:
 						comp.cases[string(sel)] = msg
 						stack.push(comp)
+					default:
+						return fail("message without selector in %q", input)
 					}
 					break pop1
 				default:
@@ -560,6 +623,8 @@ func parse(input string) (nodeMessage, error) {
 		pop2:
 			for {
 				switch n := stack.pop().(type) {
+				case nil:
+					return fail("unexpected } in %q", input)
 				case nodeStartAction:
 					stack.push(msg)
 					break pop2
@@ -572,3 +637,34 @@ func parse(input string) (nodeMessage, error) {
 		}
 	}
 }
+
+// parseOffset parses the offset of a plural, e.g. ":1" in "offset:1".
+func parseOffset(lex *lexer, input string) (int, error) {
+	n := lex.nextToken()
+	if n.cat != tokenIdentifier || !strings.HasPrefix(n.val, ":") {
+		return 0, fmt.Errorf("missing offset in %q", input)
+	}
+	offset, err := strconv.Atoi(n.val[1:])
+	if err != nil {
+		return 0, fmt.Errorf("invalid offset %q in %q", n.val[1:], input)
+	}
+	return offset, nil
+}
+
+// startMessage skips to the start of the message of a case.
+func startMessage(lex *lexer, input string, selector string) error {
+	for {
+		switch n := lex.nextToken(); n.cat {
+		case tokenStartMessage:
+			return nil
+		case tokenSpace:
+		case tokenError:
+			if n.val != "" {
+				return fmt.Errorf("%s", n.val)
+			}
+			return fmt.Errorf("missing message of case %q in %q", selector, input)
+		default:
+			return fmt.Errorf("missing message of case %q in %q", selector, input)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package icu
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// pluralOperands are the operands of CLDR plural rules: the absolute value n,
+// its integer digits i, the number of visible fraction digits v with and w
+// without trailing zeros and the visible fraction digits f with and t without
+// trailing zeros. The compact exponents c and e are always 0.
+type pluralOperands struct {
+	n float64
+	i int64
+	v int
+	w int
+	f int64
+	t int64
+}
+
+// operands returns the plural operands of a number formatted with ASCII
+// digits, e.g. "1.50".
+func operands(digits string) pluralOperands {
+	digits = strings.TrimPrefix(digits, "-")
+	var op pluralOperands
+	op.n, _ = strconv.ParseFloat(digits, 64)
+	integer, fraction := digits, ""
+	if i := strings.Index(digits, "."); i >= 0 {
+		integer, fraction = digits[:i], digits[i+1:]
+	}
+	op.i, _ = strconv.ParseInt(integer, 10, 64)
+	op.v = len(fraction)
+	if fraction != "" {
+		op.f, _ = strconv.ParseInt(fraction, 10, 64)
+	}
+	trimmed := strings.TrimRight(fraction, "0")
+	op.w = len(trimmed)
+	if trimmed != "" {
+		op.t, _ = strconv.ParseInt(trimmed, 10, 64)
+	}
+	return op
+}
+
+func integerOperands(n int) pluralOperands {
+	if n < 0 {
+		n = -n
+	}
+	return pluralOperands{n: float64(n), i: int64(n)}
+}
+
+func cardinalToCategory(tag Tag, n int) string {
+	return cardinalCategory(tag, integerOperands(n))
+}
+
+func ordinalToCategory(tag Tag, n int) string {
+	return ordinalCategory(tag, integerOperands(n))
+}
+
+// cardinalCategory returns the plural category of a number with the given
+// operands.
+func cardinalCategory(tag Tag, op pluralOperands) string {
+	_, d := localeDataFor(tag, func(d *localeData) bool { return d.cardinal != nil })
+	return pluralCategory(d.cardinal, op)
+}
+
+// ordinalCategory returns the plural category of the ordinal of a number,
+// e.g. "two" for the 2 of "2nd".
+func ordinalCategory(tag Tag, op pluralOperands) string {
+	_, d := localeDataFor(tag, func(d *localeData) bool { return d.ordinal != nil })
+	return pluralCategory(d.ordinal, op)
+}
+
+var pluralCategories = []string{zero, one, two, few, many}
+
+// pluralCategory returns the first category whose rule matches, or other.
+func pluralCategory(rules map[string]string, op pluralOperands) string {
+	for _, c := range pluralCategories {
+		if r, ok := rules[c]; ok && compiledPluralRule(r).match(op) {
+			return c
+		}
+	}
+	return other
+}
+
+// pluralRule is a CLDR plural rule like "v = 0 and i % 10 = 2..4", which
+// matches if all relations of one of its alternatives do.
+type pluralRule [][]pluralRelation
+
+// pluralRelation compares an operand, optionally modulo a value, with a list
+// of values and ranges.
+type pluralRelation struct {
+	operand byte
+	modulo  int64
+	negated bool
+	ranges  [][2]int64
+}
+
+var pluralRules = struct {
+	sync.Mutex
+	m map[string]pluralRule
+}{m: map[string]pluralRule{}}
+
+// compiledPluralRule parses a rule of the locale data once. Rules which do not
+// parse never match.
+func compiledPluralRule(s string) pluralRule {
+	pluralRules.Lock()
+	defer pluralRules.Unlock()
+	r, ok := pluralRules.m[s]
+	if !ok {
+		r, _ = parsePluralRule(s)
+		pluralRules.m[s] = r
+	}
+	return r
+}
+
+// parsePluralRule parses the condition of a rule without its samples.
+func parsePluralRule(s string) (pluralRule, error) {
+	var rule pluralRule
+	for _, or := range strings.Split(s, " or ") {
+		var relations []pluralRelation
+		for _, and := range strings.Split(or, " and ") {
+			r, err := parsePluralRelation(strings.TrimSpace(and))
+			if err != nil {
+				return nil, err
+			}
+			relations = append(relations, r)
+		}
+		rule = append(rule, relations)
+	}
+	return rule, nil
+}
+
+func parsePluralRelation(s string) (pluralRelation, error) {
+	var r pluralRelation
+	i := strings.Index(s, "=")
+	if i < 0 {
+		return r, fmt.Errorf("missing operator in plural relation %q", s)
+	}
+	expr, list := s[:i], strings.TrimSpace(s[i+1:])
+	if strings.HasSuffix(expr, "!") {
+		r.negated = true
+		expr = expr[:len(expr)-1]
+	}
+	fields := strings.Fields(expr)
+	switch {
+	case len(fields) == 1:
+	case len(fields) == 3 && fields[1] == "%":
+		m, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil || m == 0 {
+			return r, fmt.Errorf("invalid modulo in plural relation %q", s)
+		}
+		r.modulo = m
+	default:
+		return r, fmt.Errorf("invalid expression in plural relation %q", s)
+	}
+	if len(fields[0]) != 1 || !strings.Contains("nivwftce", fields[0]) {
+		return r, fmt.Errorf("unknown operand in plural relation %q", s)
+	}
+	r.operand = fields[0][0]
+	for _, item := range strings.Split(list, ",") {
+		from, to := item, item
+		if i := strings.Index(item, ".."); i >= 0 {
+			from, to = item[:i], item[i+2:]
+		}
+		a, err := strconv.ParseInt(strings.TrimSpace(from), 10, 64)
+		if err != nil {
+			return r, fmt.Errorf("invalid value in plural relation %q", s)
+		}
+		b, err := strconv.ParseInt(strings.TrimSpace(to), 10, 64)
+		if err != nil {
+			return r, fmt.Errorf("invalid value in plural relation %q", s)
+		}
+		r.ranges = append(r.ranges, [2]int64{a, b})
+	}
+	return r, nil
+}
+
+func (r pluralRule) match(op pluralOperands) bool {
+	for _, relations := range r {
+		ok := true
+		for _, rel := range relations {
+			if !rel.match(op) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// match compares the value of the operand with the ranges. Values with a
+// fraction, which only n may have, are in no range.
+func (r pluralRelation) match(op pluralOperands) bool {
+	var x float64
+	switch r.operand {
+	case 'n':
+		x = op.n
+	case 'i':
+		x = float64(op.i)
+	case 'v':
+		x = float64(op.v)
+	case 'w':
+		x = float64(op.w)
+	case 'f':
+		x = float64(op.f)
+	case 't':
+		x = float64(op.t)
+	}
+	if r.modulo != 0 {
+		x = math.Mod(x, float64(r.modulo))
+	}
+	in := false
+	if x == math.Trunc(x) {
+		for _, rg := range r.ranges {
+			if float64(rg[0]) <= x && x <= float64(rg[1]) {
+				in = true
+				break
+			}
+		}
+	}
+	return in != r.negated
+}
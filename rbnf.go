@@ -0,0 +1,740 @@
+package icu
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// RuleBasedNumberFormat formats numbers according to the rules of a
+// rule-based number format as defined by ICU and CLDR, e.g. to spell them out.
+type RuleBasedNumberFormat struct {
+	tag      Tag
+	ruleSets map[string]*rbnfRuleSet
+	public   []string
+	def      string
+}
+
+type rbnfRuleSet struct {
+	name     string
+	rules    []*rbnfRule
+	negative *rbnfRule
+	infinity *rbnfRule
+	nan      *rbnfRule
+	// improper and proper fraction rules by decimal separator.
+	improper map[rune]*rbnfRule
+	proper   map[rune]*rbnfRule
+}
+
+type rbnfRule struct {
+	base     int64
+	radix    int64
+	exponent int
+	parts    []rbnfPart
+	// predecessor is the rule preceding this one, used by ">>>".
+	predecessor *rbnfRule
+}
+
+// rbnfPart is either literal text, a substitution or a plural pattern.
+type rbnfPart struct {
+	text string
+	// sub is '<', '>' or '=' for substitutions.
+	sub         rune
+	ruleSet     string
+	pattern     string
+	direct      bool
+	pluralType  string
+	pluralCases map[string]string
+}
+
+func (r *rbnfRule) divisor() int64 {
+	d := int64(1)
+	for i := 0; i < r.exponent; i++ {
+		d *= r.radix
+	}
+	return d
+}
+
+// shouldRollBack reports whether the rule preceding r is to be used for n.
+// A rule like "100: << hundred[ >>];" is split into rules for 100 and 101,
+// and the latter must not be used for 200.
+func (r *rbnfRule) shouldRollBack(n int64) bool {
+	for _, p := range r.parts {
+		if p.sub == '>' {
+			d := r.divisor()
+			return n%d == 0 && r.base%d != 0
+		}
+	}
+	return false
+}
+
+// NewRuleBasedNumberFormat parses a rule-based number format description,
+// which consists of rule sets like "%name:" followed by rules like
+// "20: twenty[->>];". The tag selects plural rules and number symbols.
+func NewRuleBasedNumberFormat(tag Tag, rules string) (*RuleBasedNumberFormat, error) {
+	f := &RuleBasedNumberFormat{
+		tag:      baseLocale(tag),
+		ruleSets: map[string]*rbnfRuleSet{},
+	}
+	var rs *rbnfRuleSet
+	for _, chunk := range strings.Split(rules, ";") {
+		chunk = strings.TrimLeft(chunk, " \t\r\n")
+		if strings.HasPrefix(chunk, "%") {
+			i := strings.Index(chunk, ":")
+			if i < 0 {
+				return nil, fmt.Errorf("rbnf: invalid rule set name %q", chunk)
+			}
+			rs = &rbnfRuleSet{
+				name:     chunk[:i],
+				improper: map[rune]*rbnfRule{},
+				proper:   map[rune]*rbnfRule{},
+			}
+			if _, ok := f.ruleSets[rs.name]; ok {
+				return nil, fmt.Errorf("rbnf: duplicate rule set %q", rs.name)
+			}
+			f.ruleSets[rs.name] = rs
+			if !strings.HasPrefix(rs.name, "%%") {
+				f.public = append(f.public, rs.name)
+			}
+			chunk = strings.TrimLeft(chunk[i+1:], " \t\r\n")
+		}
+		if chunk == "" {
+			continue
+		}
+		if rs == nil {
+			return nil, fmt.Errorf("rbnf: rule %q outside of a rule set", chunk)
+		}
+		if err := rs.addRule(chunk); err != nil {
+			return nil, err
+		}
+	}
+	for _, rs := range f.ruleSets {
+		for i, r := range rs.rules {
+			if i > 0 && r.base <= rs.rules[i-1].base {
+				return nil, fmt.Errorf("rbnf: rules of %s are out of order at %d", rs.name, r.base)
+			}
+		}
+		for _, r := range rs.allRules() {
+			for _, p := range r.parts {
+				if p.ruleSet != "" && f.ruleSets[p.ruleSet] == nil {
+					return nil, fmt.Errorf("rbnf: unknown rule set %q in %s", p.ruleSet, rs.name)
+				}
+			}
+		}
+	}
+	if len(f.public) == 0 {
+		return nil, fmt.Errorf("rbnf: no public rule set")
+	}
+	f.def = f.public[len(f.public)-1]
+	for _, name := range []string{"%spellout-numbering", "%digits-ordinal", "%duration"} {
+		if f.ruleSets[name] != nil {
+			f.def = name
+			break
+		}
+	}
+	return f, nil
+}
+
+func (rs *rbnfRuleSet) allRules() []*rbnfRule {
+	rules := append([]*rbnfRule{}, rs.rules...)
+	for _, r := range []*rbnfRule{rs.negative, rs.infinity, rs.nan} {
+		if r != nil {
+			rules = append(rules, r)
+		}
+	}
+	for _, r := range rs.improper {
+		rules = append(rules, r)
+	}
+	for _, r := range rs.proper {
+		rules = append(rules, r)
+	}
+	return rules
+}
+
+// addRule parses a rule and adds it to the rule set. Text in brackets is
+// omitted if the number is an even multiple of the divisor, which is
+// expressed by two rules as in ICU.
+func (rs *rbnfRuleSet) addRule(s string) error {
+	desc, body := "", s
+	if i := strings.Index(s, ":"); i >= 0 && isRuleDescriptor(s[:i]) {
+		desc, body = s[:i], strings.TrimLeft(s[i+1:], " ")
+	}
+	body = strings.TrimPrefix(body, "'")
+
+	with, without, bracketed := body, "", false
+	if b1 := strings.Index(body, "["); b1 >= 0 {
+		if b2 := strings.Index(body[b1:], "]"); b2 >= 0 {
+			b2 += b1
+			inner, alt := body[b1+1:b2], ""
+			if i := strings.Index(inner, "|"); i >= 0 {
+				inner, alt = inner[:i], inner[i+1:]
+			}
+			with = body[:b1] + inner + body[b2+1:]
+			without = body[:b1] + alt + body[b2+1:]
+			bracketed = true
+		}
+	}
+
+	r := &rbnfRule{radix: 10}
+	switch desc {
+	case "-x":
+		rs.negative = r
+		bracketed = false
+	case "Inf":
+		rs.infinity = r
+		bracketed = false
+	case "NaN":
+		rs.nan = r
+		bracketed = false
+	case "x.x", "x,x":
+		rs.improper[rune(desc[1])] = r
+		if bracketed {
+			p := &rbnfRule{radix: 10}
+			if err := p.parseParts(without); err != nil {
+				return err
+			}
+			rs.proper[rune(desc[1])] = p
+			bracketed = false
+		}
+	case "0.x", "0,x":
+		rs.proper[rune(desc[1])] = r
+		bracketed = false
+	case "x.0", "x,0":
+		// Default rules are only used for parsing.
+		return nil
+	default:
+		var pred *rbnfRule
+		base := int64(0)
+		if len(rs.rules) > 0 {
+			pred = rs.rules[len(rs.rules)-1]
+			base = pred.base + 1
+		}
+		if desc != "" {
+			d := strings.TrimRight(desc, ">")
+			if i := strings.Index(d, "/"); i >= 0 {
+				radix, err := strconv.ParseInt(d[i+1:], 10, 64)
+				if err != nil || radix < 2 {
+					return fmt.Errorf("rbnf: invalid radix in %q", s)
+				}
+				r.radix = radix
+				d = d[:i]
+			}
+			var err error
+			base, err = strconv.ParseInt(strings.Replace(d, ",", "", -1), 10, 64)
+			if err != nil {
+				return fmt.Errorf("rbnf: invalid base value in %q", s)
+			}
+			r.exponent = -(len(desc) - len(strings.TrimRight(desc, ">")))
+		}
+		r.base = base
+		for p := r.radix; base > 0 && p <= base; p *= r.radix {
+			r.exponent++
+			if p > math.MaxInt64/r.radix {
+				break
+			}
+		}
+		if r.exponent < 0 {
+			r.exponent = 0
+		}
+		if bracketed && base > 0 && base%r.divisor() == 0 {
+			// The rule without the bracketed text is used for the base
+			// value itself.
+			w := &rbnfRule{base: base, radix: r.radix, exponent: r.exponent, predecessor: pred}
+			if err := rs.appendRule(w, without); err != nil {
+				return err
+			}
+			r.base++
+		}
+		r.predecessor = pred
+		return rs.appendRule(r, with)
+	}
+	if bracketed {
+		body = with
+	}
+	return r.parseParts(body)
+}
+
+func (rs *rbnfRuleSet) appendRule(r *rbnfRule, body string) error {
+	if err := r.parseParts(body); err != nil {
+		return err
+	}
+	rs.rules = append(rs.rules, r)
+	return nil
+}
+
+func isRuleDescriptor(s string) bool {
+	switch s {
+	case "-x", "x.x", "x,x", "0.x", "0,x", "x.0", "x,0", "Inf", "NaN":
+		return true
+	}
+	s = strings.TrimRight(s, ">")
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if (c < '0' || c > '9') && c != ',' && c != '/' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseParts splits rule text into literal text, substitutions like "<<",
+// ">%name>" or "=#,##0=" and plural patterns like "$(ordinal,one{st}other{th})$".
+func (r *rbnfRule) parseParts(body string) error {
+	rs := []rune(body)
+	text := []rune{}
+	flush := func() {
+		if len(text) > 0 {
+			r.parts = append(r.parts, rbnfPart{text: string(text)})
+			text = text[:0]
+		}
+	}
+	for i := 0; i < len(rs); i++ {
+		c := rs[i]
+		if c == '$' && i+1 < len(rs) && rs[i+1] == '(' {
+			end := strings.Index(string(rs[i:]), ")$")
+			if end < 0 {
+				return fmt.Errorf("rbnf: unterminated plural pattern in %q", body)
+			}
+			p, err := parseRBNFPlural(string(rs[i:])[2:end])
+			if err != nil {
+				return err
+			}
+			flush()
+			r.parts = append(r.parts, p)
+			i += len([]rune(string(rs[i:])[:end+2])) - 1
+			continue
+		}
+		switch c {
+		case '←':
+			c = '<'
+		case '→':
+			c = '>'
+		}
+		if (c != '<' && c != '>' && c != '=') || i+1 >= len(rs) {
+			text = append(text, rs[i])
+			continue
+		}
+		open := rs[i]
+		next := rs[i+1]
+		if next != open && next != '%' && next != '#' && next != '0' {
+			text = append(text, rs[i])
+			continue
+		}
+		p := rbnfPart{sub: c}
+		if next == open {
+			i++
+			if c == '>' && i+1 < len(rs) && rs[i+1] == open {
+				p.direct = true
+				i++
+			}
+		} else {
+			j := i + 1
+			for j < len(rs) && rs[j] != open {
+				j++
+			}
+			if j == len(rs) {
+				return fmt.Errorf("rbnf: unterminated substitution in %q", body)
+			}
+			desc := string(rs[i+1 : j])
+			if strings.HasPrefix(desc, "%") {
+				p.ruleSet = desc
+			} else {
+				p.pattern = desc
+			}
+			i = j
+		}
+		flush()
+		r.parts = append(r.parts, p)
+	}
+	flush()
+	return nil
+}
+
+func parseRBNFPlural(s string) (rbnfPart, error) {
+	i := strings.Index(s, ",")
+	if i < 0 {
+		return rbnfPart{}, fmt.Errorf("rbnf: invalid plural pattern %q", s)
+	}
+	p := rbnfPart{pluralType: s[:i], pluralCases: map[string]string{}}
+	for rest := s[i+1:]; rest != ""; {
+		o := strings.Index(rest, "{")
+		c := strings.Index(rest, "}")
+		if o < 0 || c < o {
+			return rbnfPart{}, fmt.Errorf("rbnf: invalid plural pattern %q", s)
+		}
+		p.pluralCases[strings.TrimSpace(rest[:o])] = rest[o+1 : c]
+		rest = rest[c+1:]
+	}
+	return p, nil
+}
+
+// RuleSets returns the names of the public rule sets.
+func (f *RuleBasedNumberFormat) RuleSets() []string {
+	return append([]string{}, f.public...)
+}
+
+// maxRBNFDepth limits the recursion of rule sets referring to each other.
+const maxRBNFDepth = 64
+
+// Format formats n with the named rule set or the default rule set if name
+// is empty.
+func (f *RuleBasedNumberFormat) Format(n float64, name string) (string, error) {
+	rs, err := f.ruleSet(name)
+	if err != nil {
+		return "", err
+	}
+	return f.formatFloat(rs, n, 0)
+}
+
+// FormatInt formats n like Format without loss of precision.
+func (f *RuleBasedNumberFormat) FormatInt(n int64, name string) (string, error) {
+	rs, err := f.ruleSet(name)
+	if err != nil {
+		return "", err
+	}
+	return f.formatInt(rs, n, 0)
+}
+
+func (f *RuleBasedNumberFormat) ruleSet(name string) (*rbnfRuleSet, error) {
+	if name == "" {
+		name = f.def
+	}
+	rs, ok := f.ruleSets[name]
+	if !ok || strings.HasPrefix(name, "%%") {
+		return nil, fmt.Errorf("rbnf: unknown rule set %q", name)
+	}
+	return rs, nil
+}
+
+func (f *RuleBasedNumberFormat) formatInt(rs *rbnfRuleSet, n int64, depth int) (string, error) {
+	if depth > maxRBNFDepth {
+		return "", fmt.Errorf("rbnf: recursion too deep in %s", rs.name)
+	}
+	if n < 0 {
+		if rs.negative == nil || n == math.MinInt64 {
+			return f.formatFloat(rs, float64(n), depth+1)
+		}
+		return f.apply(rs, rs.negative, n, float64(n), true, depth)
+	}
+	r := rs.findRule(n)
+	if r == nil {
+		return "", fmt.Errorf("rbnf: no rule in %s for %d", rs.name, n)
+	}
+	return f.apply(rs, r, n, float64(n), true, depth)
+}
+
+// findRule returns the rule with the largest base value not above n.
+func (rs *rbnfRuleSet) findRule(n int64) *rbnfRule {
+	i := sort.Search(len(rs.rules), func(i int) bool { return rs.rules[i].base > n }) - 1
+	if i < 0 {
+		return nil
+	}
+	if i > 0 && rs.rules[i].shouldRollBack(n) {
+		return rs.rules[i-1]
+	}
+	return rs.rules[i]
+}
+
+func (f *RuleBasedNumberFormat) formatFloat(rs *rbnfRuleSet, x float64, depth int) (string, error) {
+	if depth > maxRBNFDepth {
+		return "", fmt.Errorf("rbnf: recursion too deep in %s", rs.name)
+	}
+	switch {
+	case math.IsNaN(x):
+		if rs.nan != nil {
+			return f.apply(rs, rs.nan, 0, x, false, depth)
+		}
+		return "NaN", nil
+	case x < 0:
+		if rs.negative == nil {
+			s, err := f.formatFloat(rs, -x, depth+1)
+			return numberSymbolsFor(f.tag).minus + s, err
+		}
+		return f.apply(rs, rs.negative, 0, x, false, depth)
+	case math.IsInf(x, 1):
+		if rs.infinity != nil {
+			return f.apply(rs, rs.infinity, 0, x, false, depth)
+		}
+		return "∞", nil
+	case x == math.Trunc(x) && math.Abs(x) < math.MaxInt64:
+		return f.formatInt(rs, int64(x), depth)
+	}
+	sep := []rune(numberSymbolsFor(f.tag).decimal)[0]
+	if x < 1 && x > 0 {
+		if r := fractionRule(rs.proper, sep); r != nil {
+			return f.apply(rs, r, 0, x, false, depth)
+		}
+	}
+	if r := fractionRule(rs.improper, sep); r != nil {
+		return f.apply(rs, r, 0, x, false, depth)
+	}
+	// Without fraction rules, the rule for the integral part is used and
+	// passes the number on unchanged in "==" substitutions.
+	n := int64(math.Floor(x))
+	r := rs.findRule(n)
+	if r == nil {
+		return "", fmt.Errorf("rbnf: no rule in %s for %v", rs.name, x)
+	}
+	return f.apply(rs, r, n, x, true, depth)
+}
+
+// fractionRule prefers the rule for the decimal separator of the locale.
+func fractionRule(rules map[rune]*rbnfRule, sep rune) *rbnfRule {
+	if r, ok := rules[sep]; ok {
+		return r
+	}
+	if r, ok := rules['.']; ok {
+		return r
+	}
+	return rules[',']
+}
+
+// apply formats a number with a rule. Integers are passed as n, other
+// numbers as x.
+func (f *RuleBasedNumberFormat) apply(rs *rbnfRuleSet, r *rbnfRule, n int64, x float64, integral bool, depth int) (string, error) {
+	var buf strings.Builder
+	for _, p := range r.parts {
+		if p.pluralType != "" {
+			v := n / r.divisor()
+			cat := cardinalToCategory(f.tag, int(v))
+			if p.pluralType == "ordinal" {
+				cat = ordinalToCategory(f.tag, int(v))
+			}
+			s, ok := p.pluralCases[cat]
+			if !ok {
+				s = p.pluralCases[other]
+			}
+			buf.WriteString(s)
+			continue
+		}
+		if p.sub == 0 {
+			buf.WriteString(p.text)
+			continue
+		}
+		target := rs
+		if p.ruleSet != "" {
+			target = f.ruleSets[p.ruleSet]
+		}
+		var s string
+		var err error
+		switch {
+		case r == rs.negative && p.sub != '=':
+			s, err = f.substitute(p, target, -n, -x, integral, depth)
+		case p.sub == '=':
+			s, err = f.substitute(p, target, n, x, integral && float64(n) == x, depth)
+		case !integral:
+			ip, _ := math.Modf(x)
+			if p.sub == '<' {
+				s, err = f.substitute(p, target, int64(ip), ip, true, depth)
+			} else if p.pattern != "" {
+				s = formatDecimalPattern(f.tag, x-ip, p.pattern)
+			} else {
+				s, err = f.fractionDigits(target, x, !p.direct, depth)
+			}
+		case p.sub == '<':
+			d := r.divisor()
+			s, err = f.substitute(p, target, n/d, float64(n/d), true, depth)
+		case p.direct && r.predecessor != nil:
+			d := r.divisor()
+			s, err = f.apply(rs, r.predecessor, n%d, float64(n%d), true, depth+1)
+		default:
+			d := r.divisor()
+			s, err = f.substitute(p, target, n%d, float64(n%d), true, depth)
+		}
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(s)
+	}
+	return buf.String(), nil
+}
+
+// substitute formats a value with the rule set or decimal pattern of a
+// substitution.
+func (f *RuleBasedNumberFormat) substitute(p rbnfPart, rs *rbnfRuleSet, n int64, x float64, integral bool, depth int) (string, error) {
+	if p.pattern != "" {
+		if integral {
+			return formatDecimalPattern(f.tag, float64(n), p.pattern), nil
+		}
+		return formatDecimalPattern(f.tag, x, p.pattern), nil
+	}
+	if integral {
+		return f.formatInt(rs, n, depth+1)
+	}
+	return f.formatFloat(rs, x, depth+1)
+}
+
+// fractionDigits formats the digits of the fractional part of x one by one.
+func (f *RuleBasedNumberFormat) fractionDigits(rs *rbnfRuleSet, x float64, spaced bool, depth int) (string, error) {
+	s := strconv.FormatFloat(math.Abs(x), 'f', -1, 64)
+	i := strings.Index(s, ".")
+	if i < 0 {
+		return "", nil
+	}
+	var digits []string
+	for _, c := range s[i+1:] {
+		d, err := f.formatInt(rs, int64(c-'0'), depth+1)
+		if err != nil {
+			return "", err
+		}
+		digits = append(digits, d)
+	}
+	if spaced {
+		return strings.Join(digits, " "), nil
+	}
+	return strings.Join(digits, ""), nil
+}
+
+// rbnfCache holds the rule-based number formats built from rules of
+// the tables by language.
+type rbnfCache struct {
+	sync.Mutex
+	rules    map[string][]string
+	fallback string
+	m        map[string]*RuleBasedNumberFormat
+}
+
+var (
+	spelloutFormats = &rbnfCache{rules: spelloutRules, fallback: string(TagEn), m: map[string]*RuleBasedNumberFormat{}}
+	ordinalFormats  = &rbnfCache{rules: ordinalRules, fallback: "root", m: map[string]*RuleBasedNumberFormat{}}
+)
+
+// get returns the format for a locale, which uses the rules of the fallback
+// if neither the locale nor its language have any.
+func (c *rbnfCache) get(tag Tag) (*RuleBasedNumberFormat, error) {
+	tag = baseLocale(tag)
+	t, ok := lookupLocale(tag, func(key string) bool { _, ok := c.rules[key]; return ok })
+	if !ok {
+		t = Tag(c.fallback)
+		if _, own := lookupLocale(tag, func(key string) bool {
+			d, ok := localeDataTable[key]
+			return ok && hasNumbers(d)
+		}); !own {
+			tag = t
+		}
+	}
+	rules := c.rules[string(t)]
+	c.Lock()
+	defer c.Unlock()
+	if f, ok := c.m[string(tag)]; ok {
+		return f, nil
+	}
+	f, err := NewRuleBasedNumberFormat(tag, strings.Join(rules, "\n"))
+	if err != nil {
+		return nil, err
+	}
+	c.m[string(tag)] = f
+	return f, nil
+}
+
+// spelloutRuleSet expands short names like "ordinal" or "cardinal-feminine"
+// to rule set names like "%spellout-ordinal". "year" selects
+// "%spellout-numbering-year".
+func spelloutRuleSet(name string) string {
+	switch {
+	case name == "" || strings.HasPrefix(name, "%"):
+		return name
+	case name == "year":
+		return "%spellout-numbering-year"
+	}
+	return "%spellout-" + name
+}
+
+// Spellout spells out n in words, e.g. "forty-two" in English or
+// "zweiundvierzig" in German. The rule set is given by name, such as
+// "%spellout-ordinal", or by its short form, such as "ordinal". An empty
+// name selects "%spellout-numbering".
+func Spellout(tag Tag, n float64, ruleSet string) (string, error) {
+	f, err := spelloutFormats.get(tag)
+	if err != nil {
+		return "", err
+	}
+	return f.Format(n, spelloutRuleSet(ruleSet))
+}
+
+// nodeFormatSpellout spells out numbers. The style selects the rule set,
+// e.g. "ordinal", "cardinal-feminine", "year" or "%spellout-ordinal".
+type nodeFormatSpellout struct {
+	key   string
+	style string
+}
+
+func (n nodeFormatSpellout) translate(ctx *context) string {
+	v, ok := ctx.values[n.key]
+	if !ok {
+		return ""
+	}
+	f, err := spelloutFormats.get(ctx.tag)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	var s string
+	switch x := v.(type) {
+	case float32:
+		s, err = f.Format(float64(x), spelloutRuleSet(n.style))
+	case float64:
+		s, err = f.Format(x, spelloutRuleSet(n.style))
+	default:
+		i, ok := toInt(v)
+		if !ok {
+			return fmt.Sprintf("%v", v)
+		}
+		s, err = f.FormatInt(int64(i), spelloutRuleSet(n.style))
+	}
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return s
+}
+
+// ordinalRuleSet expands short names like "feminine" to rule set names like
+// "%digits-ordinal-feminine".
+func ordinalRuleSet(name string) string {
+	switch {
+	case name == "":
+		return "%digits-ordinal"
+	case strings.HasPrefix(name, "%"):
+		return name
+	}
+	return "%digits-ordinal-" + name
+}
+
+// FormatOrdinal formats n as an ordinal number in digits, e.g. "2nd" in
+// English, "2." in German or "2ª" in Portuguese. The rule set is given by
+// name, such as "%digits-ordinal-feminine", or by its short form, such as
+// "feminine". An empty name selects "%digits-ordinal".
+func FormatOrdinal(tag Tag, n int64, ruleSet string) (string, error) {
+	f, err := ordinalFormats.get(tag)
+	if err != nil {
+		return "", err
+	}
+	return f.FormatInt(n, ordinalRuleSet(ruleSet))
+}
+
+// nodeFormatOrdinal formats numbers as ordinals in digits. The style selects
+// the rule set, e.g. "feminine", "masculine-plural" or "%digits-ordinal".
+type nodeFormatOrdinal struct {
+	key   string
+	style string
+}
+
+func (n nodeFormatOrdinal) translate(ctx *context) string {
+	v, ok := ctx.values[n.key]
+	if !ok {
+		return ""
+	}
+	i, ok := toInt(v)
+	if !ok {
+		return fmt.Sprintf("%v", v)
+	}
+	s, err := FormatOrdinal(ctx.tag, int64(i), n.style)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return s
+}
@@ -0,0 +1,2403 @@
+package icu
+
+// Spellout rules of rule-based number formats, copied by hand from CLDR 48
+// for a few languages and without soft hyphens. Other languages spell out
+// numbers in English.
+var spelloutRules = map[string][]string{
+	"en": {
+		"%%2d-year:",
+		"0: hundred;",
+		"1: oh-=%spellout-numbering=;",
+		"10: =%spellout-numbering=;",
+		"%spellout-numbering-year:",
+		"-x: minus >>;",
+		"x.x: =#,##0.#=;",
+		"0: =%spellout-numbering=;",
+		"1010/100: << >%%2d-year>;",
+		"1100/100: << >%%2d-year>;",
+		"2000: =%spellout-numbering=;",
+		"2010/100: << >%%2d-year>;",
+		"2100/100: << >%%2d-year>;",
+		"3000: =%spellout-numbering=;",
+		"3010/100: << >%%2d-year>;",
+		"3100/100: << >%%2d-year>;",
+		"4000: =%spellout-numbering=;",
+		"4010/100: << >%%2d-year>;",
+		"4100/100: << >%%2d-year>;",
+		"5000: =%spellout-numbering=;",
+		"5010/100: << >%%2d-year>;",
+		"5100/100: << >%%2d-year>;",
+		"6000: =%spellout-numbering=;",
+		"6010/100: << >%%2d-year>;",
+		"6100/100: << >%%2d-year>;",
+		"7000: =%spellout-numbering=;",
+		"7010/100: << >%%2d-year>;",
+		"7100/100: << >%%2d-year>;",
+		"8000: =%spellout-numbering=;",
+		"8010/100: << >%%2d-year>;",
+		"8100/100: << >%%2d-year>;",
+		"9000: =%spellout-numbering=;",
+		"9010/100: << >%%2d-year>;",
+		"9100/100: << >%%2d-year>;",
+		"10000: =%spellout-numbering=;",
+		"%spellout-numbering:",
+		"-x: minus >>;",
+		"Inf: infinity;",
+		"NaN: not a number;",
+		"0: =%spellout-cardinal=;",
+		"%spellout-numbering-verbose:",
+		"-x: minus >>;",
+		"Inf: infinity;",
+		"NaN: not a number;",
+		"0: =%spellout-cardinal-verbose=;",
+		"%spellout-cardinal:",
+		"-x: minus >>;",
+		"x.x: << point >>;",
+		"Inf: infinite;",
+		"NaN: not a number;",
+		"0: zero;",
+		"1: one;",
+		"2: two;",
+		"3: three;",
+		"4: four;",
+		"5: five;",
+		"6: six;",
+		"7: seven;",
+		"8: eight;",
+		"9: nine;",
+		"10: ten;",
+		"11: eleven;",
+		"12: twelve;",
+		"13: thirteen;",
+		"14: fourteen;",
+		"15: fifteen;",
+		"16: sixteen;",
+		"17: seventeen;",
+		"18: eighteen;",
+		"19: nineteen;",
+		"20: twenty[->>];",
+		"30: thirty[->>];",
+		"40: forty[->>];",
+		"50: fifty[->>];",
+		"60: sixty[->>];",
+		"70: seventy[->>];",
+		"80: eighty[->>];",
+		"90: ninety[->>];",
+		"100: << hundred[ >>];",
+		"1000: << thousand[ >>];",
+		"1000000: << million[ >>];",
+		"1000000000: << billion[ >>];",
+		"1000000000000: << trillion[ >>];",
+		"1000000000000000: << quadrillion[ >>];",
+		"1000000000000000000: =#,##0=;",
+		"%%and:",
+		"1: ' and =%spellout-cardinal-verbose=;",
+		"100: ' =%spellout-cardinal-verbose=;",
+		"%%commas:",
+		"1: ' and =%spellout-cardinal-verbose=;",
+		"100: , =%spellout-cardinal-verbose=;",
+		"1000: , <%spellout-cardinal-verbose< thousand[>%%commas>];",
+		"1000000: , =%spellout-cardinal-verbose=;",
+		"%spellout-cardinal-verbose:",
+		"-x: minus >>;",
+		"x.x: << point >>;",
+		"Inf: infinite;",
+		"NaN: not a number;",
+		"0: =%spellout-numbering=;",
+		"100: << hundred[>%%and>];",
+		"1000: << thousand[>%%and>];",
+		"100000/1000: << thousand[>%%commas>];",
+		"1000000: << million[>%%commas>];",
+		"1000000000: << billion[>%%commas>];",
+		"1000000000000: << trillion[>%%commas>];",
+		"1000000000000000: << quadrillion[>%%commas>];",
+		"1000000000000000000: =#,##0=;",
+		"%%tieth:",
+		"0: tieth;",
+		"1: ty-=%spellout-ordinal=;",
+		"%%th:",
+		"0: th;",
+		"1: ' =%spellout-ordinal=;",
+		"%spellout-ordinal:",
+		"-x: minus >>;",
+		"x.x: =#,##0.#=;",
+		"Inf: infinitieth;",
+		"0: zeroth;",
+		"1: first;",
+		"2: second;",
+		"3: third;",
+		"4: fourth;",
+		"5: fifth;",
+		"6: sixth;",
+		"7: seventh;",
+		"8: eighth;",
+		"9: ninth;",
+		"10: tenth;",
+		"11: eleventh;",
+		"12: twelfth;",
+		"13: =%spellout-numbering=th;",
+		"20: twen>%%tieth>;",
+		"30: thir>%%tieth>;",
+		"40: for>%%tieth>;",
+		"50: fif>%%tieth>;",
+		"60: six>%%tieth>;",
+		"70: seven>%%tieth>;",
+		"80: eigh>%%tieth>;",
+		"90: nine>%%tieth>;",
+		"100: <%spellout-numbering< hundred>%%th>;",
+		"1000: <%spellout-numbering< thousand>%%th>;",
+		"1000000: <%spellout-numbering< million>%%th>;",
+		"1000000000: <%spellout-numbering< billion>%%th>;",
+		"1000000000000: <%spellout-numbering< trillion>%%th>;",
+		"1000000000000000: <%spellout-numbering< quadrillion>%%th>;",
+		"1000000000000000000: =#,##0=$(ordinal,one{st}two{nd}few{rd}other{th})$;",
+		"%%and-o:",
+		"0: th;",
+		"1: ' and =%spellout-ordinal-verbose=;",
+		"100: ' =%spellout-ordinal-verbose=;",
+		"%%commas-o:",
+		"0: th;",
+		"1: ' and =%spellout-ordinal-verbose=;",
+		"100: , =%spellout-ordinal-verbose=;",
+		"1000: , <%spellout-cardinal-verbose< thousand>%%commas-o>;",
+		"1000000: , =%spellout-ordinal-verbose=;",
+		"%spellout-ordinal-verbose:",
+		"-x: minus >>;",
+		"x.x: =#,##0.#=;",
+		"Inf: infinitieth;",
+		"0: =%spellout-ordinal=;",
+		"100: <%spellout-numbering-verbose< hundred>%%and-o>;",
+		"1000: <%spellout-numbering-verbose< thousand>%%and-o>;",
+		"100000/1000: <%spellout-numbering-verbose< thousand>%%commas-o>;",
+		"1000000: <%spellout-numbering-verbose< million>%%commas-o>;",
+		"1000000000: <%spellout-numbering-verbose< billion>%%commas-o>;",
+		"1000000000000: <%spellout-numbering-verbose< trillion>%%commas-o>;",
+		"1000000000000000: <%spellout-numbering-verbose< quadrillion>%%commas-o>;",
+		"1000000000000000000: =#,##0=$(ordinal,one{st}two{nd}few{rd}other{th})$;",
+	},
+	"de": {
+		"%spellout-numbering-year:",
+		"-x: minus >>;",
+		"x.x: =0.0=;",
+		"0: =%spellout-numbering=;",
+		"1100/100: <<hundert[>>];",
+		"2000: =%spellout-numbering=;",
+		"%spellout-numbering:",
+		"-x: minus >>;",
+		"x.x: << Komma >>;",
+		"0: null;",
+		"1: eins;",
+		"2: zwei;",
+		"3: drei;",
+		"4: vier;",
+		"5: fünf;",
+		"6: sechs;",
+		"7: sieben;",
+		"8: acht;",
+		"9: neun;",
+		"10: zehn;",
+		"11: elf;",
+		"12: zwölf;",
+		"13: >>zehn;",
+		"16: sechzehn;",
+		"17: siebzehn;",
+		"18: >>zehn;",
+		"20: [>%spellout-cardinal-masculine>und]zwanzig;",
+		"30: [>%spellout-cardinal-masculine>und]dreißig;",
+		"40: [>%spellout-cardinal-masculine>und]vierzig;",
+		"50: [>%spellout-cardinal-masculine>und]fünfzig;",
+		"60: [>%spellout-cardinal-masculine>und]sechzig;",
+		"70: [>%spellout-cardinal-masculine>und]siebzig;",
+		"80: [>%spellout-cardinal-masculine>und]achtzig;",
+		"90: [>%spellout-cardinal-masculine>und]neunzig;",
+		"100: <%spellout-cardinal-masculine<hundert[>>];",
+		"1000: <%spellout-cardinal-masculine<tausend[>>];",
+		"1000000: eine Million[ >>];",
+		"2000000: <%spellout-cardinal-feminine< Millionen[ >>];",
+		"1000000000: eine Milliarde[ >>];",
+		"2000000000: <%spellout-cardinal-feminine< Milliarden[ >>];",
+		"1000000000000: eine Billion[ >>];",
+		"2000000000000: <%spellout-cardinal-feminine< Billionen[ >>];",
+		"1000000000000000: eine Billiarde[ >>];",
+		"2000000000000000: <%spellout-cardinal-feminine< Billiarden[ >>];",
+		"1000000000000000000: =#,##0=;",
+		"%spellout-cardinal-neuter:",
+		"0: =%spellout-cardinal-masculine=;",
+		"%spellout-cardinal-masculine:",
+		"-x: minus >>;",
+		"x.x: << Komma >>;",
+		"0: null;",
+		"1: ein;",
+		"2: =%spellout-numbering=;",
+		"100: <%spellout-cardinal-masculine<hundert[>>];",
+		"1000: <%spellout-cardinal-masculine<tausend[>>];",
+		"1000000: eine Million[ >>];",
+		"2000000: <%spellout-cardinal-feminine< Millionen[ >>];",
+		"1000000000: eine Milliarde[ >>];",
+		"2000000000: <%spellout-cardinal-feminine< Milliarden[ >>];",
+		"1000000000000: eine Billion[ >>];",
+		"2000000000000: <%spellout-cardinal-feminine< Billionen[ >>];",
+		"1000000000000000: eine Billiarde[ >>];",
+		"2000000000000000: <%spellout-cardinal-feminine< Billiarden[ >>];",
+		"1000000000000000000: =#,##0=;",
+		"%spellout-cardinal-feminine:",
+		"-x: minus >>;",
+		"x.x: << Komma >>;",
+		"0: null;",
+		"1: eine;",
+		"2: =%spellout-numbering=;",
+		"100: <%spellout-cardinal-masculine<hundert[>>];",
+		"1000: <%spellout-cardinal-masculine<tausend[>>];",
+		"1000000: eine Million[ >>];",
+		"2000000: <%spellout-cardinal-feminine< Millionen[ >>];",
+		"1000000000: eine Milliarde[ >>];",
+		"2000000000: <%spellout-cardinal-feminine< Milliarden[ >>];",
+		"1000000000000: eine Billion[ >>];",
+		"2000000000000: <%spellout-cardinal-feminine< Billionen[ >>];",
+		"1000000000000000: eine Billiarde[ >>];",
+		"2000000000000000: <%spellout-cardinal-feminine< Billiarden[ >>];",
+		"1000000000000000000: =#,##0=;",
+		"%spellout-cardinal-n:",
+		"-x: minus >>;",
+		"x.x: << Komma >>;",
+		"0: null;",
+		"1: einen;",
+		"2: =%spellout-numbering=;",
+		"100: <%spellout-cardinal-masculine<hundert[>>];",
+		"1000: <%spellout-cardinal-masculine<tausend[>>];",
+		"1000000: eine Million[ >>];",
+		"2000000: <%spellout-cardinal-feminine< Millionen[ >>];",
+		"1000000000: eine Milliarde[ >>];",
+		"2000000000: <%spellout-cardinal-feminine< Milliarden[ >>];",
+		"1000000000000: eine Billion[ >>];",
+		"2000000000000: <%spellout-cardinal-feminine< Billionen[ >>];",
+		"1000000000000000: eine Billiarde[ >>];",
+		"2000000000000000: <%spellout-cardinal-feminine< Billiarden[ >>];",
+		"1000000000000000000: =#,##0=;",
+		"%spellout-cardinal-r:",
+		"-x: minus >>;",
+		"x.x: << Komma >>;",
+		"0: null;",
+		"1: einer;",
+		"2: =%spellout-numbering=;",
+		"100: <%spellout-cardinal-masculine<hundert[>>];",
+		"1000: <%spellout-cardinal-masculine<tausend[>>];",
+		"1000000: eine Million[ >>];",
+		"2000000: <%spellout-cardinal-feminine< Millionen[ >>];",
+		"1000000000: eine Milliarde[ >>];",
+		"2000000000: <%spellout-cardinal-feminine< Milliarden[ >>];",
+		"1000000000000: eine Billion[ >>];",
+		"2000000000000: <%spellout-cardinal-feminine< Billionen[ >>];",
+		"1000000000000000: eine Billiarde[ >>];",
+		"2000000000000000: <%spellout-cardinal-feminine< Billiarden[ >>];",
+		"1000000000000000000: =#,##0=;",
+		"%spellout-cardinal-s:",
+		"-x: minus >>;",
+		"x.x: << Komma >>;",
+		"0: null;",
+		"1: eines;",
+		"2: =%spellout-numbering=;",
+		"100: <%spellout-cardinal-masculine<hundert[>>];",
+		"1000: <%spellout-cardinal-masculine<tausend[>>];",
+		"1000000: eine Million[ >>];",
+		"2000000: <%spellout-cardinal-feminine< Millionen[ >>];",
+		"1000000000: eine Milliarde[ >>];",
+		"2000000000: <%spellout-cardinal-feminine< Milliarden[ >>];",
+		"1000000000000: eine Billion[ >>];",
+		"2000000000000: <%spellout-cardinal-feminine< Billionen[ >>];",
+		"1000000000000000: eine Billiarde[ >>];",
+		"2000000000000000: <%spellout-cardinal-feminine< Billiarden[ >>];",
+		"1000000000000000000: =#,##0=;",
+		"%spellout-cardinal-m:",
+		"-x: minus >>;",
+		"x.x: << Komma >>;",
+		"0: null;",
+		"1: einem;",
+		"2: =%spellout-numbering=;",
+		"100: <%spellout-cardinal-masculine<hundert[>>];",
+		"1000: <%spellout-cardinal-masculine<tausend[>>];",
+		"1000000: eine Million[ >>];",
+		"2000000: <%spellout-cardinal-feminine< Millionen[ >>];",
+		"1000000000: eine Milliarde[ >>];",
+		"2000000000: <%spellout-cardinal-feminine< Milliarden[ >>];",
+		"1000000000000: eine Billion[ >>];",
+		"2000000000000: <%spellout-cardinal-feminine< Billionen[ >>];",
+		"1000000000000000: eine Billiarde[ >>];",
+		"2000000000000000: <%spellout-cardinal-feminine< Billiarden[ >>];",
+		"1000000000000000000: =#,##0=;",
+		"%%ste:",
+		"0: ste;",
+		"1: =%spellout-ordinal=;",
+		"%%ste2:",
+		"0: ste;",
+		"1: ' =%spellout-ordinal=;",
+		"%spellout-ordinal:",
+		"-x: minus >>;",
+		"x.x: =#,##0.#=;",
+		"0: nullte;",
+		"1: erste;",
+		"2: zweite;",
+		"3: dritte;",
+		"4: vierte;",
+		"5: fünfte;",
+		"6: sechste;",
+		"7: siebte;",
+		"8: achte;",
+		"9: =%spellout-numbering=te;",
+		"20: =%spellout-numbering=ste;",
+		"100: <%spellout-cardinal-masculine<hundert>%%ste>;",
+		"1000: <%spellout-cardinal-masculine<tausend>%%ste>;",
+		"1000000: eine Million>%%ste2>;",
+		"2000000: <%spellout-cardinal-feminine< Millionen>%%ste2>;",
+		"1000000000: eine Milliarde>%%ste2>;",
+		"2000000000: <%spellout-cardinal-feminine< Milliarden>%%ste2>;",
+		"1000000000000: eine Billion>%%ste>;",
+		"2000000000000: <%spellout-cardinal-feminine< Billionen>%%ste2>;",
+		"1000000000000000: eine Billiarde>%%ste2>;",
+		"2000000000000000: <%spellout-cardinal-feminine< Billiarden>%%ste2>;",
+		"1000000000000000000: =#,##0=.;",
+		"%spellout-ordinal-n:",
+		"-x: minus >>;",
+		"x.x: =#,##0.#=;",
+		"0: =%spellout-ordinal=n;",
+		"%spellout-ordinal-r:",
+		"-x: minus >>;",
+		"x.x: =#,##0.#=;",
+		"0: =%spellout-ordinal=r;",
+		"%spellout-ordinal-s:",
+		"-x: minus >>;",
+		"x.x: =#,##0.#=;",
+		"0: =%spellout-ordinal=s;",
+		"%spellout-ordinal-m:",
+		"-x: minus >>;",
+		"x.x: =#,##0.#=;",
+		"0: =%spellout-ordinal=m;",
+	},
+	"fr": {
+		"%spellout-numbering-year:",
+		"-x: moins >>;",
+		"x.x: =0.0=;",
+		"0: =%spellout-numbering=;",
+		"1100/100: <%spellout-cardinal-masculine<-cent>%%cents-m>;",
+		"2000: =%spellout-numbering=;",
+		"%spellout-numbering:",
+		"0: =%spellout-cardinal-masculine=;",
+		"%%et-un:",
+		"1: et-un;",
+		"2: =%spellout-cardinal-masculine=;",
+		"11: et-onze;",
+		"12: =%spellout-cardinal-masculine=;",
+		"%%cents-m:",
+		"0: s;",
+		"1: ' =%spellout-cardinal-masculine=;",
+		"%%subcents-m:",
+		"0: s;",
+		"1: -=%spellout-cardinal-masculine=;",
+		"%%spellout-leading:",
+		"0: =%spellout-cardinal-masculine=;",
+		"80/20: quatre-vingt[->>];",
+		"100: cent[ >>];",
+		"200: << cent[ >>];",
+		"1000: =%spellout-cardinal-masculine=;",
+		"%spellout-cardinal-masculine:",
+		"-x: moins >>;",
+		"x.x: << virgule >>;",
+		"0: zéro;",
+		"1: un;",
+		"2: deux;",
+		"3: trois;",
+		"4: quatre;",
+		"5: cinq;",
+		"6: six;",
+		"7: sept;",
+		"8: huit;",
+		"9: neuf;",
+		"10: dix;",
+		"11: onze;",
+		"12: douze;",
+		"13: treize;",
+		"14: quatorze;",
+		"15: quinze;",
+		"16: seize;",
+		"17: dix->>;",
+		"20: vingt[->%%et-un>];",
+		"30: trente[->%%et-un>];",
+		"40: quarante[->%%et-un>];",
+		"50: cinquante[->%%et-un>];",
+		"60/20: soixante[->%%et-un>];",
+		"80/20: quatre-vingt>%%subcents-m>;",
+		"100: cent[ >>];",
+		"200: << cent>%%cents-m>;",
+		"1000: mille[ >>];",
+		"2000: <%%spellout-leading< mille[ >>];",
+		"1000000: un million[ >>];",
+		"2000000: <%%spellout-leading< millions[ >>];",
+		"1000000000: un milliard[ >>];",
+		"2000000000: <%%spellout-leading< milliards[ >>];",
+		"1000000000000: un billion[ >>];",
+		"2000000000000: <%%spellout-leading< billions[ >>];",
+		"1000000000000000: un billiard[ >>];",
+		"2000000000000000: <%%spellout-leading< billiards[ >>];",
+		"1000000000000000000: =#,##0=;",
+		"%%et-une:",
+		"1: et-une;",
+		"2: =%spellout-cardinal-feminine=;",
+		"11: et-onze;",
+		"12: =%spellout-cardinal-feminine=;",
+		"%%cents-f:",
+		"0: s;",
+		"1: ' =%spellout-cardinal-feminine=;",
+		"%%subcents-f:",
+		"0: s;",
+		"1: -=%spellout-cardinal-feminine=;",
+		"%spellout-cardinal-feminine:",
+		"-x: moins >>;",
+		"x.x: << virgule >>;",
+		"0: zéro;",
+		"1: une;",
+		"2: =%spellout-cardinal-masculine=;",
+		"20: vingt[->%%et-une>];",
+		"30: trente[->%%et-une>];",
+		"40: quarante[->%%et-une>];",
+		"50: cinquante[->%%et-une>];",
+		"60/20: soixante[->%%et-une>];",
+		"80/20: quatre-vingt>%%subcents-f>;",
+		"100: cent[ >>];",
+		"200: <%spellout-cardinal-masculine< cent>%%cents-f>;",
+		"1000: mille[ >>];",
+		"2000: <%%spellout-leading< mille[ >>];",
+		"1000000: un million[ >>];",
+		"2000000: <%%spellout-leading< millions[ >>];",
+		"1000000000: un milliard[ >>];",
+		"2000000000: <%%spellout-leading< milliards[ >>];",
+		"1000000000000: un billion[ >>];",
+		"2000000000000: <%%spellout-leading< billions[ >>];",
+		"1000000000000000: un billiard[ >>];",
+		"2000000000000000: <%%spellout-leading< billiards[ >>];",
+		"1000000000000000000: =#,##0=;",
+		"%%et-unieme:",
+		"1: et-unième;",
+		"2: =%%spellout-ordinal=;",
+		"11: et-onzième;",
+		"12: =%%spellout-ordinal=;",
+		"%%cents-o:",
+		"0: ième;",
+		"1: -=%%et-unieme=;",
+		"2: ' =%%spellout-ordinal=;",
+		"11: -et-onzième;",
+		"12: ' =%%spellout-ordinal=;",
+		"%%subcents-o:",
+		"0: ième;",
+		"1: -=%%et-unieme=;",
+		"2: -=%%spellout-ordinal=;",
+		"11: -et-onzième;",
+		"12: -=%%spellout-ordinal=;",
+		"%%mille-o:",
+		"0: ième;",
+		"1: e-=%%et-unieme=;",
+		"2: e =%%spellout-ordinal=;",
+		"11: e-et-onzième;",
+		"12: e =%%spellout-ordinal=;",
+		"%%spellout-ordinal:",
+		"1: unième;",
+		"2: deuxième;",
+		"3: troisième;",
+		"4: quatrième;",
+		"5: cinquième;",
+		"6: sixième;",
+		"7: septième;",
+		"8: huitième;",
+		"9: neuvième;",
+		"10: dixième;",
+		"11: onzième;",
+		"12: douzième;",
+		"13: treizième;",
+		"14: quatorzième;",
+		"15: quinzième;",
+		"16: seizième;",
+		"17: dix->>;",
+		"20: vingtième;",
+		"21: vingt->%%et-unieme>;",
+		"30: trentième;",
+		"31: trente->%%et-unieme>;",
+		"40: quarantième;",
+		"41: quarante->%%et-unieme>;",
+		"50: cinquantième;",
+		"51: cinquante->%%et-unieme>;",
+		"60: soixantième;",
+		"61/20: soixante->%%et-unieme>;",
+		"80/20: quatre-vingt>%%subcents-o>;",
+		"100: cent>%%cents-o>;",
+		"200: <%spellout-cardinal-masculine< cent>%%cents-o>;",
+		"1000: mill>%%mille-o>;",
+		"2000: <%%spellout-leading< mill>%%mille-o>;",
+		"1000000: <%%spellout-leading< million>%%cents-o>;",
+		"1000000000: <%%spellout-leading< milliard>%%cents-o>;",
+		"1000000000000: <%%spellout-leading< billion>%%cents-o>;",
+		"1000000000000000: <%%spellout-leading< billiard>%%cents-o>;",
+		"1000000000000000000: =#,##0=;",
+		"%spellout-ordinal-masculine-plural:",
+		"0: =%spellout-ordinal-masculine=s;",
+		"%spellout-ordinal-masculine:",
+		"-x: moins >>;",
+		"x.x: =#,##0.#=;",
+		"0: zéroième;",
+		"1: premier;",
+		"2: =%%spellout-ordinal=;",
+		"%spellout-ordinal-feminine-plural:",
+		"0: =%spellout-ordinal-feminine=s;",
+		"%spellout-ordinal-feminine:",
+		"-x: moins >>;",
+		"x.x: =#,##0.#=;",
+		"0: zéroième;",
+		"1: première;",
+		"2: =%%spellout-ordinal=;",
+	},
+	"es": {
+		"%spellout-numbering-year:",
+		"x.x: =0.0=;",
+		"0: =%spellout-numbering=;",
+		"%spellout-numbering:",
+		"-x: menos >>;",
+		"x.x: << punto >>;",
+		"x,x: << coma >>;",
+		"0: cero;",
+		"1: uno;",
+		"2: dos;",
+		"3: tres;",
+		"4: cuatro;",
+		"5: cinco;",
+		"6: seis;",
+		"7: siete;",
+		"8: ocho;",
+		"9: nueve;",
+		"10: diez;",
+		"11: once;",
+		"12: doce;",
+		"13: trece;",
+		"14: catorce;",
+		"15: quince;",
+		"16: dieciséis;",
+		"17: dieci>>;",
+		"20: veinte;",
+		"21: veintiuno;",
+		"22: veintidós;",
+		"23: veintitrés;",
+		"24: veinticuatro;",
+		"25: veinticinco;",
+		"26: veintiséis;",
+		"27: veinti>>;",
+		"30: treinta[ y >>];",
+		"40: cuarenta[ y >>];",
+		"50: cincuenta[ y >>];",
+		"60: sesenta[ y >>];",
+		"70: setenta[ y >>];",
+		"80: ochenta[ y >>];",
+		"90: noventa[ y >>];",
+		"100: cien;",
+		"101: ciento >>;",
+		"200: doscientos[ >>];",
+		"300: trescientos[ >>];",
+		"400: cuatrocientos[ >>];",
+		"500: quinientos[ >>];",
+		"600: seiscientos[ >>];",
+		"700: setecientos[ >>];",
+		"800: ochocientos[ >>];",
+		"900: novecientos[ >>];",
+		"1000: mil[ >>];",
+		"2000: <%spellout-cardinal-masculine< mil[ >>];",
+		"1000000: un millón[ >>];",
+		"2000000: <%spellout-cardinal-masculine< millones[ >>];",
+		"1000000000000: un billón[ >>];",
+		"2000000000000: <%spellout-cardinal-masculine< billones[ >>];",
+		"1000000000000000000: =#,##0=;",
+		"%spellout-cardinal-masculine:",
+		"-x: menos >>;",
+		"x.x: << punto >>;",
+		"x,x: << coma >>;",
+		"0: cero;",
+		"1: un;",
+		"2: =%spellout-numbering=;",
+		"21: veintiún;",
+		"22: =%spellout-numbering=;",
+		"30: treinta[ y >>];",
+		"40: cuarenta[ y >>];",
+		"50: cincuenta[ y >>];",
+		"60: sesenta[ y >>];",
+		"70: setenta[ y >>];",
+		"80: ochenta[ y >>];",
+		"90: noventa[ y >>];",
+		"100: cien;",
+		"101: ciento >>;",
+		"200: doscientos[ >>];",
+		"300: trescientos[ >>];",
+		"400: cuatrocientos[ >>];",
+		"500: quinientos[ >>];",
+		"600: seiscientos[ >>];",
+		"700: setecientos[ >>];",
+		"800: ochocientos[ >>];",
+		"900: novecientos[ >>];",
+		"1000: mil[ >>];",
+		"2000: <%spellout-cardinal-masculine< mil[ >>];",
+		"1000000: un millón[ >>];",
+		"2000000: <%spellout-cardinal-masculine< millones[ >>];",
+		"1000000000000: un billón[ >>];",
+		"2000000000000: <%spellout-cardinal-masculine< billones[ >>];",
+		"1000000000000000000: =#,##0=;",
+		"%spellout-cardinal-feminine:",
+		"-x: menos >>;",
+		"x.x: << punto >>;",
+		"x,x: << coma >>;",
+		"0: cero;",
+		"1: una;",
+		"2: =%spellout-numbering=;",
+		"21: veintiuna;",
+		"22: =%spellout-numbering=;",
+		"30: treinta[ y >>];",
+		"40: cuarenta[ y >>];",
+		"50: cincuenta[ y >>];",
+		"60: sesenta[ y >>];",
+		"70: setenta[ y >>];",
+		"80: ochenta[ y >>];",
+		"90: noventa[ y >>];",
+		"100: cien;",
+		"101: ciento >>;",
+		"200: doscientas[ >>];",
+		"300: trescientas[ >>];",
+		"400: cuatrocientas[ >>];",
+		"500: quinientas[ >>];",
+		"600: seiscientas[ >>];",
+		"700: setecientas[ >>];",
+		"800: ochocientas[ >>];",
+		"900: novecientas[ >>];",
+		"1000: mil[ >>];",
+		"2000: <%spellout-cardinal-masculine< mil[ >>];",
+		"1000000: un millón[ >>];",
+		"2000000: <%spellout-cardinal-masculine< millones[ >>];",
+		"1000000000000: un billón[ >>];",
+		"2000000000000: <%spellout-cardinal-masculine< billones[ >>];",
+		"1000000000000000000: =#,##0=;",
+		"%spellout-ordinal-masculine-adjective:",
+		"-x: menos >>;",
+		"x.x: =#,##0.#=;",
+		"0: cero;",
+		"1: primer;",
+		"2: segundo;",
+		"3: tercer;",
+		"4: cuarto;",
+		"5: quinto;",
+		"6: sexto;",
+		"7: séptimo;",
+		"8: octavo;",
+		"9: noveno;",
+		"10: décimo;",
+		"11: undécimo;",
+		"12: duodécimo;",
+		"13: decimo>>;",
+		"18: decim>>;",
+		"19: decimo>>;",
+		"20: vigésimo[ >>];",
+		"30: trigésimo[ >>];",
+		"40: cuadragésimo[ >>];",
+		"50: quincuagésimo[ >>];",
+		"60: sexagésimo[ >>];",
+		"70: septuagésimo[ >>];",
+		"80: octogésimo[ >>];",
+		"90: nonagésimo[ >>];",
+		"100: centésimo[ >>];",
+		"200: ducentésimo[ >>];",
+		"300: tricentésimo[ >>];",
+		"400: cuadringentésimo[ >>];",
+		"500: quingentésimo[ >>];",
+		"600: sexcentésimo[ >>];",
+		"700: septingentésimo[ >>];",
+		"800: octingésimo[ >>];",
+		"900: noningentésimo[ >>];",
+		"1000: milésimo[ >>];",
+		"2000: <%spellout-cardinal-masculine< milésimo[ >>];",
+		"1000000: un millonésimo[ >>];",
+		"2000000: <%spellout-cardinal-masculine< millonésimo[ >>];",
+		"1000000000000: un billonésimo[ >>];",
+		"2000000000000: <%spellout-cardinal-masculine< billonésimo[ >>];",
+		"1000000000000000000: =#,##0=º;",
+		"%spellout-ordinal-masculine-plural:",
+		"-x: menos >>;",
+		"x.x: =#,##0.#=;",
+		"0: =%spellout-ordinal-masculine=;",
+		"1: =%spellout-ordinal-masculine=s;",
+		"1000000000000000000: =#,##0=º;",
+		"%spellout-ordinal-masculine:",
+		"-x: menos >>;",
+		"x.x: =#,##0.#=;",
+		"0: cero;",
+		"1: primero;",
+		"2: segundo;",
+		"3: tercero;",
+		"4: cuarto;",
+		"5: quinto;",
+		"6: sexto;",
+		"7: séptimo;",
+		"8: octavo;",
+		"9: noveno;",
+		"10: décimo;",
+		"11: decimo>>;",
+		"18: decim>>;",
+		"19: decimo>>;",
+		"20: vigésimo[ >>];",
+		"30: trigésimo[ >>];",
+		"40: cuadragésimo[ >>];",
+		"50: quincuagésimo[ >>];",
+		"60: sexagésimo[ >>];",
+		"70: septuagésimo[ >>];",
+		"80: octogésimo[ >>];",
+		"90: nonagésimo[ >>];",
+		"100: centésimo[ >>];",
+		"200: ducentésimo[ >>];",
+		"300: tricentésimo[ >>];",
+		"400: cuadringentésimo[ >>];",
+		"500: quingentésimo[ >>];",
+		"600: sexcentésimo[ >>];",
+		"700: septingentésimo[ >>];",
+		"800: octingésimo[ >>];",
+		"900: noningentésimo[ >>];",
+		"1000: milésimo[ >>];",
+		"2000: <%spellout-cardinal-masculine< milésimo[ >>];",
+		"1000000: un millonésimo[ >>];",
+		"2000000: <%spellout-cardinal-masculine< millonésimo[ >>];",
+		"1000000000000: un billonésimo[ >>];",
+		"2000000000000: <%spellout-cardinal-masculine< billonésimo[ >>];",
+		"1000000000000000000: =#,##0=º;",
+		"%spellout-ordinal-feminine-plural:",
+		"-x: menos >>;",
+		"x.x: =#,##0.#=;",
+		"0: =%spellout-ordinal-feminine=;",
+		"1: =%spellout-ordinal-feminine=s;",
+		"1000000000000000000: =#,##0=ª;",
+		"%spellout-ordinal-feminine:",
+		"-x: menos >>;",
+		"x.x: =#,##0.#=;",
+		"0: cero;",
+		"1: primera;",
+		"2: segunda;",
+		"3: tercera;",
+		"4: cuarta;",
+		"5: quinta;",
+		"6: sexta;",
+		"7: séptima;",
+		"8: octava;",
+		"9: novena;",
+		"10: décima;",
+		"11: decimo>>;",
+		"18: decim>>;",
+		"19: decimo>>;",
+		"20: vigésima[ >>];",
+		"30: trigésima[ >>];",
+		"40: cuadragésima[ >>];",
+		"50: quincuagésima[ >>];",
+		"60: sexagésima[ >>];",
+		"70: septuagésima[ >>];",
+		"80: octogésima[ >>];",
+		"90: nonagésima[ >>];",
+		"100: centésima[ >>];",
+		"200: ducentésima[ >>];",
+		"300: tricentésima[ >>];",
+		"400: cuadringentésima[ >>];",
+		"500: quingentésima[ >>];",
+		"600: sexcentésima[ >>];",
+		"700: septingentésima[ >>];",
+		"800: octingésima[ >>];",
+		"900: noningentésima[ >>];",
+		"1000: milésima[ >>];",
+		"2000: <%spellout-cardinal-masculine< milésima[ >>];",
+		"1000000: un millonésima[ >>];",
+		"2000000: <%spellout-cardinal-masculine< millonésima[ >>];",
+		"1000000000000: un billonésima[ >>];",
+		"2000000000000: <%spellout-cardinal-masculine< billonésima[ >>];",
+		"1000000000000000000: =#,##0=ª;",
+	},
+	"it": {
+		"%spellout-numbering-year:",
+		"x.x: =0.0=;",
+		"0: =%spellout-numbering=;",
+		"%spellout-numbering:",
+		"-x: meno >>;",
+		"x.x: << virgola >>;",
+		"0: zero;",
+		"1: uno;",
+		"2: due;",
+		"3: tre;",
+		"4: quattro;",
+		"5: cinque;",
+		"6: sei;",
+		"7: sette;",
+		"8: otto;",
+		"9: nove;",
+		"10: dieci;",
+		"11: undici;",
+		"12: dodici;",
+		"13: tredici;",
+		"14: quattordici;",
+		"15: quindici;",
+		"16: sedici;",
+		"17: diciassette;",
+		"18: diciotto;",
+		"19: diciannove;",
+		"20: vent>%%msco-with-i>;",
+		"30: trent>%%msco-with-a>;",
+		"40: quarant>%%msco-with-a>;",
+		"50: cinquant>%%msco-with-a>;",
+		"60: sessant>%%msco-with-a>;",
+		"70: settant>%%msco-with-a>;",
+		"80: ottant>%%msco-with-a>;",
+		"90: novant>%%msco-with-a>;",
+		"100: cent>%%msco-with-o>;",
+		"200: <<cent>%%msco-with-o>;",
+		"1000: mille[>>];",
+		"2000: <%%msc-no-final<mila[>>];",
+		"1000000: un milione[ >>];",
+		"2000000: <%spellout-cardinal-masculine< milioni[ >>];",
+		"1000000000: un miliardo[ >>];",
+		"2000000000: <%spellout-cardinal-masculine< miliardi[ >>];",
+		"1000000000000: un bilione[ >>];",
+		"2000000000000: <%spellout-cardinal-masculine< bilioni[ >>];",
+		"1000000000000000: un biliardo[ >>];",
+		"2000000000000000: <%spellout-cardinal-masculine< biliardi[ >>];",
+		"1000000000000000000: =#,##0=;",
+		"%%msco-with-i:",
+		"0: i;",
+		"1: uno;",
+		"2: idue;",
+		"3: itré;",
+		"4: i=%spellout-numbering=;",
+		"8: otto;",
+		"9: inove;",
+		"%%msco-with-a:",
+		"0: a;",
+		"1: uno;",
+		"2: adue;",
+		"3: atré;",
+		"4: a=%spellout-numbering=;",
+		"8: otto;",
+		"9: anove;",
+		"%%msco-with-o:",
+		"0: o;",
+		"1: ouno;",
+		"2: odue;",
+		"3: otré;",
+		"4: o=%spellout-numbering=;",
+		"8: otto;",
+		"9: o=%spellout-numbering=;",
+		"80: =%spellout-numbering=;",
+		"90: o=%spellout-numbering=;",
+		"%spellout-cardinal-masculine:",
+		"-x: meno >>;",
+		"x.x: << virgola >>;",
+		"0: zero;",
+		"1: un;",
+		"2: =%spellout-numbering=;",
+		"20: vent>%%msc-with-i>;",
+		"30: trent>%%msc-with-a>;",
+		"40: quarant>%%msc-with-a>;",
+		"50: cinquant>%%msc-with-a>;",
+		"60: sessant>%%msc-with-a>;",
+		"70: settant>%%msc-with-a>;",
+		"80: ottant>%%msc-with-a>;",
+		"90: novant>%%msc-with-a>;",
+		"100: cent>%%msc-with-o>;",
+		"200: <<cent>%%msc-with-o>;",
+		"1000: mille[>>];",
+		"2000: <%%msc-no-final<mila[>>];",
+		"1000000: un milione[ >>];",
+		"2000000: <%spellout-cardinal-masculine< milioni[ >>];",
+		"1000000000: un miliardo[ >>];",
+		"2000000000: <%spellout-cardinal-masculine< miliardi[ >>];",
+		"1000000000000: un bilione[ >>];",
+		"2000000000000: <%spellout-cardinal-masculine< bilioni[ >>];",
+		"1000000000000000: un biliardo[ >>];",
+		"2000000000000000: <%spellout-cardinal-masculine< biliardi[ >>];",
+		"1000000000000000000: =#,##0=;",
+		"%%msc-with-i:",
+		"0: i;",
+		"1: un;",
+		"2: =%%msco-with-i=;",
+		"%%msc-with-a:",
+		"0: a;",
+		"1: un;",
+		"2: =%%msco-with-a=;",
+		"%%msc-with-o:",
+		"0: o;",
+		"1: ouno;",
+		"2: odue;",
+		"3: otré;",
+		"4: o=%spellout-numbering=;",
+		"8: otto;",
+		"9: o=%spellout-numbering=;",
+		"80: =%spellout-numbering=;",
+		"90: o=%spellout-numbering=;",
+		"%%msc-no-final:",
+		"0: =%spellout-cardinal-masculine=;",
+		"20: vent>%%msc-with-i-nofinal>;",
+		"30: trent>%%msc-with-a-nofinal>;",
+		"40: quarant>%%msc-with-a-nofinal>;",
+		"50: cinquant>%%msc-with-a-nofinal>;",
+		"60: sessant>%%msc-with-a-nofinal>;",
+		"70: settant>%%msc-with-a-nofinal>;",
+		"80: ottant>%%msc-with-a-nofinal>;",
+		"90: novant>%%msc-with-a-nofinal>;",
+		"100: cent>%%msc-with-o-nofinal>;",
+		"200: <<cent>%%msc-with-o-nofinal>;",
+		"%%msc-with-i-nofinal:",
+		"0: =%%msc-with-i=;",
+		"3: itre;",
+		"4: =%%msc-with-i=;",
+		"%%msc-with-a-nofinal:",
+		"0: =%%msc-with-a=;",
+		"3: atre;",
+		"4: =%%msc-with-a=;",
+		"%%msc-with-o-nofinal:",
+		"0: =%%msc-with-o=;",
+		"3: otre;",
+		"4: =%%msc-with-o=;",
+		"%spellout-cardinal-feminine:",
+		"-x: meno >>;",
+		"x.x: << virgola >>;",
+		"0: zero;",
+		"1: una;",
+		"2: =%spellout-numbering=;",
+		"20: vent>%%fem-with-i>;",
+		"30: trent>%%fem-with-a>;",
+		"40: quarant>%%fem-with-a>;",
+		"50: cinquant>%%fem-with-a>;",
+		"60: sessant>%%fem-with-a>;",
+		"70: settant>%%fem-with-a>;",
+		"80: ottant>%%fem-with-a>;",
+		"90: novant>%%fem-with-a>;",
+		"100: cent>%%fem-with-o>;",
+		"200: <<cent>%%fem-with-o>;",
+		"1000: mille[>>];",
+		"2000: <%%msc-no-final<mila[>>];",
+		"1000000: un milione[ >>];",
+		"2000000: <%spellout-cardinal-masculine< milioni[ >>];",
+		"1000000000: un miliardo[ >>];",
+		"2000000000: <%spellout-cardinal-masculine< miliardi[ >>];",
+		"1000000000000: un bilione[ >>];",
+		"2000000000000: <%spellout-cardinal-masculine< bilioni[ >>];",
+		"1000000000000000: un biliardo[ >>];",
+		"2000000000000000: <%spellout-cardinal-masculine< biliardi[ >>];",
+		"1000000000000000000: =#,##0=;",
+		"%%fem-with-i:",
+		"0: i;",
+		"1: una;",
+		"2: =%%msco-with-i=;",
+		"%%fem-with-a:",
+		"0: a;",
+		"1: una;",
+		"2: =%%msco-with-a=;",
+		"%%fem-with-o:",
+		"0: o;",
+		"1: ouna;",
+		"2: =%%msco-with-o=;",
+		"%spellout-ordinal-masculine:",
+		"-x: meno >>;",
+		"x.x: =#,##0.#=;",
+		"0: zeresimo;",
+		"1: primo;",
+		"2: secondo;",
+		"3: terzo;",
+		"4: quarto;",
+		"5: quinto;",
+		"6: sesto;",
+		"7: settimo;",
+		"8: ottavo;",
+		"9: nono;",
+		"10: decimo;",
+		"11: undicesimo;",
+		"12: dodicesimo;",
+		"13: tredicesimo;",
+		"14: quattordicesimo;",
+		"15: quindicesimo;",
+		"16: sedicesimo;",
+		"17: diciassettesimo;",
+		"18: diciottesimo;",
+		"19: diciannovesimo;",
+		"20: vent>%%ordinal-esimo-with-i>;",
+		"30: trent>%%ordinal-esimo-with-a>;",
+		"40: quarant>%%ordinal-esimo-with-a>;",
+		"50: cinquant>%%ordinal-esimo-with-a>;",
+		"60: sessant>%%ordinal-esimo-with-a>;",
+		"70: settant>%%ordinal-esimo-with-a>;",
+		"80: ottant>%%ordinal-esimo-with-a>;",
+		"90: novant>%%ordinal-esimo-with-a>;",
+		"100: cent>%%ordinal-esimo-with-o>;",
+		"200: <%spellout-cardinal-masculine<cent>%%ordinal-esimo-with-o>;",
+		"1000: mille>%%ordinal-esimo>;",
+		"2000: <%spellout-cardinal-masculine<mille>%%ordinal-esimo>;",
+		"2001: <%spellout-cardinal-masculine<mila>%%ordinal-esimo>;",
+		"1000000: milione>%%ordinal-esimo>;",
+		"2000000: <%spellout-cardinal-masculine<milione>%%ordinal-esimo>;",
+		"1000000000: miliard>%%ordinal-esimo-with-o>;",
+		"2000000000: <%spellout-cardinal-masculine<miliard>%%ordinal-esimo-with-o>;",
+		"1000000000000: bilione>%%ordinal-esimo>;",
+		"2000000000000: <%spellout-cardinal-masculine<bilion>%%ordinal-esimo>;",
+		"1000000000000000: biliard>%%ordinal-esimo-with-o>;",
+		"2000000000000000: <%spellout-cardinal-masculine<biliard>%%ordinal-esimo-with-o>;",
+		"1000000000000000000: =#,##0=;",
+		"%%ordinal-esimo:",
+		"0: simo;",
+		"1: unesimo;",
+		"2: duesimo;",
+		"3: treesimo;",
+		"4: quattresimo;",
+		"5: cinquesimo;",
+		"6: seiesimo;",
+		"7: settesimo;",
+		"8: ottesimo;",
+		"9: novesimo;",
+		"10: =%spellout-ordinal-masculine=;",
+		"%%ordinal-esimo-with-i:",
+		"0: esimo;",
+		"1: unesimo;",
+		"2: iduesimo;",
+		"3: itreesimo;",
+		"4: iquattresimo;",
+		"5: icinquesimo;",
+		"6: iseiesimo;",
+		"7: isettesimo;",
+		"8: ottesimo;",
+		"9: inovesimo;",
+		"10: =%spellout-ordinal-masculine=;",
+		"%%ordinal-esimo-with-a:",
+		"0: esimo;",
+		"1: unesimo;",
+		"2: aduesimo;",
+		"3: atreesimo;",
+		"4: aquattresimo;",
+		"5: acinquesimo;",
+		"6: aseiesimo;",
+		"7: asettesimo;",
+		"8: ottesimo;",
+		"9: anovesimo;",
+		"10: =%spellout-ordinal-masculine=;",
+		"%%ordinal-esimo-with-o:",
+		"0: esimo;",
+		"1: unesimo;",
+		"2: oduesimo;",
+		"3: otreesimo;",
+		"4: oquattresimo;",
+		"5: ocinquesimo;",
+		"6: oseiesimo;",
+		"7: osettesimo;",
+		"8: ottesimo;",
+		"9: onovesimo;",
+		"10: o=%spellout-ordinal-masculine=;",
+		"%spellout-ordinal-feminine:",
+		"-x: meno >>;",
+		"x.x: =#,##0.#=;",
+		"0: zeresima;",
+		"1: prima;",
+		"2: seconda;",
+		"3: terza;",
+		"4: quarta;",
+		"5: quinta;",
+		"6: sesta;",
+		"7: settima;",
+		"8: ottava;",
+		"9: nona;",
+		"10: decima;",
+		"11: undicesima;",
+		"12: dodicesima;",
+		"13: tredicesima;",
+		"14: quattordicesima;",
+		"15: quindicesima;",
+		"16: sedicesima;",
+		"17: diciassettesima;",
+		"18: diciottesima;",
+		"19: diciannovesima;",
+		"20: vent>%%ordinal-esima-with-i>;",
+		"30: trent>%%ordinal-esima-with-a>;",
+		"40: quarant>%%ordinal-esima-with-a>;",
+		"50: cinquant>%%ordinal-esima-with-a>;",
+		"60: sessant>%%ordinal-esima-with-a>;",
+		"70: settant>%%ordinal-esima-with-a>;",
+		"80: ottant>%%ordinal-esima-with-a>;",
+		"90: novant>%%ordinal-esima-with-a>;",
+		"100: cent>%%ordinal-esima-with-o>;",
+		"200: <%spellout-cardinal-feminine<cent>%%ordinal-esima-with-o>;",
+		"1000: mille>%%ordinal-esima>;",
+		"2000: <%spellout-cardinal-feminine<mille>%%ordinal-esima>;",
+		"2001: <%spellout-cardinal-feminine<mila>%%ordinal-esima>;",
+		"1000000: milione>%%ordinal-esima>;",
+		"2000000: <%spellout-cardinal-feminine<milione>%%ordinal-esima>;",
+		"1000000000: miliard>%%ordinal-esima-with-o>;",
+		"2000000000: <%spellout-cardinal-feminine<miliard>%%ordinal-esima-with-o>;",
+		"1000000000000: bilione>%%ordinal-esima>;",
+		"2000000000000: <%spellout-cardinal-feminine<bilion>%%ordinal-esima>;",
+		"1000000000000000: biliard>%%ordinal-esima-with-o>;",
+		"2000000000000000: <%spellout-cardinal-feminine<biliard>%%ordinal-esima-with-o>;",
+		"1000000000000000000: =#,##0=;",
+		"%%ordinal-esima:",
+		"0: sima;",
+		"1: unesima;",
+		"2: duesima;",
+		"3: treesima;",
+		"4: quattresima;",
+		"5: cinquesima;",
+		"6: seiesima;",
+		"7: settesima;",
+		"8: ottesima;",
+		"9: novesima;",
+		"10: =%spellout-ordinal-feminine=;",
+		"%%ordinal-esima-with-i:",
+		"0: esima;",
+		"1: unesima;",
+		"2: iduesima;",
+		"3: itreesima;",
+		"4: iquattresima;",
+		"5: icinquesima;",
+		"6: iseiesima;",
+		"7: isettesima;",
+		"8: ottesima;",
+		"9: inovesima;",
+		"10: =%spellout-ordinal-feminine=;",
+		"%%ordinal-esima-with-a:",
+		"0: esima;",
+		"1: unesima;",
+		"2: aduesima;",
+		"3: atreesima;",
+		"4: aquattresima;",
+		"5: acinquesima;",
+		"6: aseiesima;",
+		"7: asettesima;",
+		"8: ottesima;",
+		"9: anovesima;",
+		"10: =%spellout-ordinal-feminine=;",
+		"%%ordinal-esima-with-o:",
+		"0: esima;",
+		"1: unesima;",
+		"2: oduesima;",
+		"3: otreesima;",
+		"4: oquattresima;",
+		"5: ocinquesima;",
+		"6: oseiesima;",
+		"7: osettesima;",
+		"8: ottesima;",
+		"9: onovesima;",
+		"10: o=%spellout-ordinal-feminine=;",
+		"%spellout-ordinal-masculine-plural:",
+		"-x: meno >>;",
+		"x.x: =#,##0.#=;",
+		"0: zeresimi;",
+		"1: primi;",
+		"2: secondi;",
+		"3: terzi;",
+		"4: quarti;",
+		"5: quinti;",
+		"6: sesti;",
+		"7: settimi;",
+		"8: ottavi;",
+		"9: noni;",
+		"10: decimi;",
+		"11: undicesimi;",
+		"12: dodicesimi;",
+		"13: tredicesimi;",
+		"14: quattordicesimi;",
+		"15: quindicesimi;",
+		"16: sedicesimi;",
+		"17: diciassettesimi;",
+		"18: diciottesimi;",
+		"19: diciannovesimi;",
+		"20: vent>%%ordinal-esimi-with-i>;",
+		"30: trent>%%ordinal-esimi-with-a>;",
+		"40: quarant>%%ordinal-esimi-with-a>;",
+		"50: cinquant>%%ordinal-esimi-with-a>;",
+		"60: sessant>%%ordinal-esimi-with-a>;",
+		"70: settant>%%ordinal-esimi-with-a>;",
+		"80: ottant>%%ordinal-esimi-with-a>;",
+		"90: novant>%%ordinal-esimi-with-a>;",
+		"100: cent>%%ordinal-esimi-with-o>;",
+		"200: <%spellout-cardinal-masculine<cent>%%ordinal-esimi-with-o>;",
+		"1000: mille>%%ordinal-esimi>;",
+		"2000: <%spellout-cardinal-masculine<mille>%%ordinal-esimi>;",
+		"2001: <%spellout-cardinal-masculine<mila>%%ordinal-esimi>;",
+		"1000000: milione>%%ordinal-esimi>;",
+		"2000000: <%spellout-cardinal-masculine<milione>%%ordinal-esimi>;",
+		"1000000000: miliard>%%ordinal-esimi-with-o>;",
+		"2000000000: <%spellout-cardinal-masculine<miliard>%%ordinal-esimi-with-o>;",
+		"1000000000000: bilione>%%ordinal-esimi>;",
+		"2000000000000: <%spellout-cardinal-masculine<bilion>%%ordinal-esimi>;",
+		"1000000000000000: biliard>%%ordinal-esimi-with-o>;",
+		"2000000000000000: <%spellout-cardinal-masculine<biliard>%%ordinal-esimi-with-o>;",
+		"1000000000000000000: =#,##0=;",
+		"%%ordinal-esimi:",
+		"0: simi;",
+		"1: unesimi;",
+		"2: duesimi;",
+		"3: treesimi;",
+		"4: quattresimi;",
+		"5: cinquesimi;",
+		"6: seiesimi;",
+		"7: settesimi;",
+		"8: ottesimi;",
+		"9: novesimi;",
+		"10: =%spellout-ordinal-masculine=;",
+		"%%ordinal-esimi-with-i:",
+		"0: esimi;",
+		"1: unesimi;",
+		"2: iduesimi;",
+		"3: itreesimi;",
+		"4: iquattresimi;",
+		"5: icinquesimi;",
+		"6: iseiesimi;",
+		"7: isettesimi;",
+		"8: ottesimi;",
+		"9: inovesimi;",
+		"10: =%spellout-ordinal-masculine=;",
+		"%%ordinal-esimi-with-a:",
+		"0: esimi;",
+		"1: unesimi;",
+		"2: aduesimi;",
+		"3: atreesimi;",
+		"4: aquattresimi;",
+		"5: acinquesimi;",
+		"6: aseiesimi;",
+		"7: asettesimi;",
+		"8: ottesimi;",
+		"9: anovesimi;",
+		"10: =%spellout-ordinal-masculine=;",
+		"%%ordinal-esimi-with-o:",
+		"0: esimi;",
+		"1: unesimi;",
+		"2: oduesimi;",
+		"3: otreesimi;",
+		"4: oquattresimi;",
+		"5: ocinquesimi;",
+		"6: oseiesimi;",
+		"7: osettesimi;",
+		"8: ottesimi;",
+		"9: onovesimi;",
+		"10: o=%spellout-ordinal-masculine=;",
+		"%spellout-ordinal-feminine-plural:",
+		"-x: meno >>;",
+		"x.x: =#,##0.#=;",
+		"0: zeresime;",
+		"1: prime;",
+		"2: seconde;",
+		"3: terze;",
+		"4: quarte;",
+		"5: quinte;",
+		"6: seste;",
+		"7: settime;",
+		"8: ottave;",
+		"9: none;",
+		"10: decime;",
+		"11: undicesime;",
+		"12: dodicesime;",
+		"13: tredicesime;",
+		"14: quattordicesime;",
+		"15: quindicesime;",
+		"16: sedicesime;",
+		"17: diciassettesime;",
+		"18: diciottesime;",
+		"19: diciannovesime;",
+		"20: vent>%%ordinal-esime-with-i>;",
+		"30: trent>%%ordinal-esime-with-a>;",
+		"40: quarant>%%ordinal-esime-with-a>;",
+		"50: cinquant>%%ordinal-esime-with-a>;",
+		"60: sessant>%%ordinal-esime-with-a>;",
+		"70: settant>%%ordinal-esime-with-a>;",
+		"80: ottant>%%ordinal-esime-with-a>;",
+		"90: novant>%%ordinal-esime-with-a>;",
+		"100: cent>%%ordinal-esime-with-o>;",
+		"200: <%spellout-cardinal-feminine<cent>%%ordinal-esime-with-o>;",
+		"1000: mille>%%ordinal-esime>;",
+		"2000: <%spellout-cardinal-feminine<mille>%%ordinal-esime>;",
+		"2001: <%spellout-cardinal-feminine<mila>%%ordinal-esime>;",
+		"1000000: milione>%%ordinal-esime>;",
+		"2000000: <%spellout-cardinal-feminine<milione>%%ordinal-esime>;",
+		"1000000000: miliard>%%ordinal-esime-with-o>;",
+		"2000000000: <%spellout-cardinal-feminine<miliard>%%ordinal-esime-with-o>;",
+		"1000000000000: bilione>%%ordinal-esime>;",
+		"2000000000000: <%spellout-cardinal-feminine<bilion>%%ordinal-esime>;",
+		"1000000000000000: biliard>%%ordinal-esime-with-o>;",
+		"2000000000000000: <%spellout-cardinal-feminine<biliard>%%ordinal-esime-with-o>;",
+		"1000000000000000000: =#,##0=;",
+		"%%ordinal-esime:",
+		"0: sime;",
+		"1: unesime;",
+		"2: duesime;",
+		"3: treesime;",
+		"4: quattresime;",
+		"5: cinquesime;",
+		"6: seiesime;",
+		"7: settesime;",
+		"8: ottesime;",
+		"9: novesime;",
+		"10: =%spellout-ordinal-feminine=;",
+		"%%ordinal-esime-with-i:",
+		"0: esime;",
+		"1: unesime;",
+		"2: iduesime;",
+		"3: itreesime;",
+		"4: iquattresime;",
+		"5: icinquesime;",
+		"6: iseiesime;",
+		"7: isettesime;",
+		"8: ottesime;",
+		"9: inovesime;",
+		"10: =%spellout-ordinal-feminine=;",
+		"%%ordinal-esime-with-a:",
+		"0: esime;",
+		"1: unesime;",
+		"2: aduesime;",
+		"3: atreesime;",
+		"4: aquattresime;",
+		"5: acinquesime;",
+		"6: aseiesime;",
+		"7: asettesime;",
+		"8: ottesime;",
+		"9: anovesime;",
+		"10: =%spellout-ordinal-feminine=;",
+		"%%ordinal-esime-with-o:",
+		"0: esime;",
+		"1: unesime;",
+		"2: oduesime;",
+		"3: otreesime;",
+		"4: oquattresime;",
+		"5: ocinquesime;",
+		"6: oseiesime;",
+		"7: osettesime;",
+		"8: ottesime;",
+		"9: onovesime;",
+		"10: o=%spellout-ordinal-feminine=;",
+	},
+	"pt": {
+		"%spellout-numbering-year:",
+		"x.x: =0.0=;",
+		"0: =%spellout-numbering=;",
+		"%spellout-numbering:",
+		"0: =%spellout-cardinal-masculine=;",
+		"%%optional-e:",
+		"0: ' e ;",
+		"1: ' ;",
+		"%%spellout-cardinal-masculine-with-e:",
+		"0: ' e =%spellout-cardinal-masculine=;",
+		"100: >%%optional-e>=%spellout-cardinal-masculine=;",
+		"%spellout-cardinal-masculine:",
+		"-x: menos >>;",
+		"x.x: << vírgula >>;",
+		"0: zero;",
+		"1: um;",
+		"2: dois;",
+		"3: três;",
+		"4: quatro;",
+		"5: cinco;",
+		"6: seis;",
+		"7: sete;",
+		"8: oito;",
+		"9: nove;",
+		"10: dez;",
+		"11: onze;",
+		"12: doze;",
+		"13: treze;",
+		"14: catorze;",
+		"15: quinze;",
+		"16: dezesseis;",
+		"17: dezessete;",
+		"18: dezoito;",
+		"19: dezenove;",
+		"20: vinte[ e >>];",
+		"30: trinta[ e >>];",
+		"40: quarenta[ e >>];",
+		"50: cinquenta[ e >>];",
+		"60: sessenta[ e >>];",
+		"70: setenta[ e >>];",
+		"80: oitenta[ e >>];",
+		"90: noventa[ e >>];",
+		"100: cem;",
+		"101: cento e >>;",
+		"200: duzentos[ e >>];",
+		"300: trezentos[ e >>];",
+		"400: quatrocentos[ e >>];",
+		"500: quinhentos[ e >>];",
+		"600: seiscentos[ e >>];",
+		"700: setecentos[ e >>];",
+		"800: oitocentos[ e >>];",
+		"900: novecentos[ e >>];",
+		"1000: mil[>%%spellout-cardinal-masculine-with-e>];",
+		"2000: << mil[>%%spellout-cardinal-masculine-with-e>];",
+		"1000000: << $(cardinal,one{milhão}other{milhões})$[>%%spellout-cardinal-masculine-with-e>];",
+		"1000000000: << $(cardinal,one{bilhão}other{bilhões})$[>%%spellout-cardinal-masculine-with-e>];",
+		"1000000000000: << $(cardinal,one{trilhão}other{trilhões})$[>%%spellout-cardinal-masculine-with-e>];",
+		"1000000000000000: << $(cardinal,one{quatrilhão}other{quatrilhões})$[>%%spellout-cardinal-masculine-with-e>];",
+		"1000000000000000000: =#,##0=;",
+		"%%spellout-cardinal-feminine-with-e:",
+		"0: ' e =%spellout-cardinal-feminine=;",
+		"100: >%%optional-e>=%spellout-cardinal-feminine=;",
+		"%spellout-cardinal-feminine:",
+		"-x: menos >>;",
+		"x.x: << vírgula >>;",
+		"0: zero;",
+		"1: uma;",
+		"2: duas;",
+		"3: =%spellout-cardinal-masculine=;",
+		"20: vinte[ e >>];",
+		"30: trinta[ e >>];",
+		"40: quarenta[ e >>];",
+		"50: cinquenta[ e >>];",
+		"60: sessenta[ e >>];",
+		"70: setenta[ e >>];",
+		"80: oitenta[ e >>];",
+		"90: noventa[ e >>];",
+		"100: cem;",
+		"101: cento e >>;",
+		"200: duzentas[ e >>];",
+		"300: trezentas[ e >>];",
+		"400: quatrocentas[ e >>];",
+		"500: quinhentas[ e >>];",
+		"600: seiscentas[ e >>];",
+		"700: setecentas[ e >>];",
+		"800: oitocentas[ e >>];",
+		"900: novecentas[ e >>];",
+		"1000: mil[>%%spellout-cardinal-feminine-with-e>];",
+		"2000: << mil[>%%spellout-cardinal-feminine-with-e>];",
+		"1000000: <%spellout-cardinal-masculine< $(cardinal,one{milhão}other{milhões})$[>%%spellout-cardinal-feminine-with-e>];",
+		"1000000000: <%spellout-cardinal-masculine< $(cardinal,one{bilhão}other{bilhões})$[>%%spellout-cardinal-feminine-with-e>];",
+		"1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{trilhão}other{trilhões})$[>%%spellout-cardinal-feminine-with-e>];",
+		"1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{quatrilhão}other{quatrilhões})$[>%%spellout-cardinal-feminine-with-e>];",
+		"1000000000000000000: =#,##0=;",
+		"%spellout-ordinal-masculine:",
+		"-x: menos >>;",
+		"x.x: =#,##0.#=;",
+		"0: zero;",
+		"1: primeiro;",
+		"2: segundo;",
+		"3: terceiro;",
+		"4: quarto;",
+		"5: quinto;",
+		"6: sexto;",
+		"7: sétimo;",
+		"8: oitavo;",
+		"9: nono;",
+		"10: décimo[ >>];",
+		"20: vigésimo[ >>];",
+		"30: trigésimo[ >>];",
+		"40: quadragésimo[ >>];",
+		"50: quinquagésimo[ >>];",
+		"60: sexagésimo[ >>];",
+		"70: septuagésimo[ >>];",
+		"80: octogésimo[ >>];",
+		"90: nonagésimo[ >>];",
+		"100: centésimo[ >>];",
+		"200: ducentésimo[ >>];",
+		"300: tricentésimo[ >>];",
+		"400: quadringentésimo[ >>];",
+		"500: quingentésimo[ >>];",
+		"600: sexcentésimo[ >>];",
+		"700: septingentésimo[ >>];",
+		"800: octingentésimo[ >>];",
+		"900: noningentésimo[ >>];",
+		"1000: milésimo[ >>];",
+		"2000: <%spellout-cardinal-masculine< milésimo[ >>];",
+		"1000000: <%spellout-cardinal-masculine< milionésimo[ >>];",
+		"1000000000: <%spellout-cardinal-masculine< bilionésimo[ >>];",
+		"1000000000000: <%spellout-cardinal-masculine< trilionésimo[ >>];",
+		"1000000000000000: <%spellout-cardinal-masculine< quadrilionésimo[ >>];",
+		"1000000000000000000: =#,##0=º;",
+		"%spellout-ordinal-feminine:",
+		"-x: menos >>;",
+		"x.x: =#,##0.#=;",
+		"0: zero;",
+		"1: primeira;",
+		"2: segunda;",
+		"3: terceira;",
+		"4: quarta;",
+		"5: quinta;",
+		"6: sexta;",
+		"7: sétima;",
+		"8: oitava;",
+		"9: nona;",
+		"10: décima[ >>];",
+		"20: vigésima[ >>];",
+		"30: trigésima[ >>];",
+		"40: quadragésima[ >>];",
+		"50: quinquagésima[ >>];",
+		"60: sexagésima[ >>];",
+		"70: septuagésima[ >>];",
+		"80: octogésima[ >>];",
+		"90: nonagésima[ >>];",
+		"100: centésima[ >>];",
+		"200: ducentésima[ >>];",
+		"300: tricentésima[ >>];",
+		"400: quadringentésima[ >>];",
+		"500: quingentésima[ >>];",
+		"600: sexcentésima[ >>];",
+		"700: septingentésima[ >>];",
+		"800: octingentésima[ >>];",
+		"900: noningentésima[ >>];",
+		"1000: milésima[ >>];",
+		"2000: <%spellout-cardinal-feminine< milésima[ >>];",
+		"1000000: <%spellout-cardinal-feminine< milionésima[ >>];",
+		"1000000000: <%spellout-cardinal-feminine< bilionésima[ >>];",
+		"1000000000000: <%spellout-cardinal-feminine< trilionésima[ >>];",
+		"1000000000000000: <%spellout-cardinal-feminine< quadrilionésima[ >>];",
+		"1000000000000000000: =#,##0=ª;",
+	},
+	"bg": {
+		"%spellout-numbering-year:",
+		"x.x: =0.0=;",
+		"0: =%spellout-numbering=;",
+		"%spellout-numbering:",
+		"0: =%spellout-cardinal-neuter=;",
+		"%spellout-cardinal-masculine:",
+		"-x: минус >>;",
+		"x.x: << цяло и >>;",
+		"0: нула;",
+		"1: един;",
+		"2: два;",
+		"3: три;",
+		"4: четири;",
+		"5: пет;",
+		"6: шест;",
+		"7: седем;",
+		"8: осем;",
+		"9: девет;",
+		"10: десет;",
+		"11: единайсет;",
+		"12: дванайсет;",
+		"13: >>найсет;",
+		"20: <<йсет[ и >>];",
+		"40: четиресет[ и >>];",
+		"50: <<десет[ и >>];",
+		"60: шейсет[ и >>];",
+		"70: <<десет[ и >>];",
+		"100: сто[ >%%spellout-cardinal-masculine-and>];",
+		"200: двеста[ >%%spellout-cardinal-masculine-and>];",
+		"300: триста[ >%%spellout-cardinal-masculine-and>];",
+		"400: <<стотин[ >%%spellout-cardinal-masculine-and>];",
+		"1000: хиляда[ >%%spellout-cardinal-masculine-and>];",
+		"2000: <%spellout-cardinal-feminine< хиляди[ >%%spellout-cardinal-masculine-and>];",
+		"1000000: <%spellout-cardinal-masculine< $(cardinal,one{милион}other{милиона})$[ >%%spellout-cardinal-masculine-and>];",
+		"1000000000: <%spellout-cardinal-masculine< $(cardinal,one{милиард}other{милиарда})$[ >%%spellout-cardinal-masculine-and>];",
+		"1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{трилион}other{трилиона})$[ >%%spellout-cardinal-masculine-and>];",
+		"1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{квадрилион}other{квадрилиона})$[ >%%spellout-cardinal-masculine-and>];",
+		"1000000000000000000: =#,##0=;",
+		"%%spellout-cardinal-masculine-and:",
+		"0: и =%spellout-cardinal-masculine=;",
+		"20: и <%spellout-cardinal-masculine<йсет;",
+		"21: <%spellout-cardinal-masculine<йсет >>;",
+		"40: и четиресет;",
+		"41: четиресет >>;",
+		"50: и <%spellout-cardinal-masculine<десет;",
+		"51: <%spellout-cardinal-masculine<десет >>;",
+		"60: и шейсет;",
+		"61: шейсет >>;",
+		"70: и <%spellout-cardinal-masculine<десет;",
+		"71: <%spellout-cardinal-masculine<десет >>;",
+		"100: =%spellout-cardinal-masculine=;",
+		"%spellout-cardinal-feminine:",
+		"-x: минус >>;",
+		"x.x: << цяло и >>;",
+		"0: нула;",
+		"1: една;",
+		"2: две;",
+		"3: =%spellout-cardinal-masculine=;",
+		"20: <%spellout-cardinal-masculine<йсет[ и >>];",
+		"40: четиресет[ и >>];",
+		"50: <%spellout-cardinal-masculine<десет[ и >>];",
+		"60: шейсет[ и >>];",
+		"70: <%spellout-cardinal-masculine<десет[ и >>];",
+		"100: сто[ >%%spellout-cardinal-feminine-and>];",
+		"200: двеста[ >%%spellout-cardinal-feminine-and>];",
+		"300: триста[ >%%spellout-cardinal-feminine-and>];",
+		"400: <<стотин[ >%%spellout-cardinal-feminine-and>];",
+		"1000: хиляда[ >%%spellout-cardinal-feminine-and>];",
+		"2000: <%spellout-cardinal-feminine< хиляди[ >%%spellout-cardinal-feminine-and>];",
+		"1000000: <%spellout-cardinal-masculine< $(cardinal,one{милион}other{милиона})$[ >%%spellout-cardinal-feminine-and>];",
+		"1000000000: <%spellout-cardinal-masculine< $(cardinal,one{милиард}other{милиарда})$[ >%%spellout-cardinal-feminine-and>];",
+		"1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{трилион}other{трилиона})$[ >%%spellout-cardinal-feminine-and>];",
+		"1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{квадрилион}other{квадрилиона})$[ >%%spellout-cardinal-feminine-and>];",
+		"1000000000000000000: =#,##0=;",
+		"%%spellout-cardinal-feminine-and:",
+		"0: и =%spellout-cardinal-feminine=;",
+		"20: и <%spellout-cardinal-masculine<йсет;",
+		"21: <%spellout-cardinal-masculine<йсет >>;",
+		"40: и четиресет;",
+		"41: четиресет >>;",
+		"50: и <%spellout-cardinal-masculine<десет;",
+		"51: <%spellout-cardinal-masculine<десет >>;",
+		"60: и шейсет;",
+		"61: шейсет >>;",
+		"70: и <%spellout-cardinal-masculine<десет;",
+		"71: <%spellout-cardinal-masculine<десет >>;",
+		"100: =%spellout-cardinal-feminine=;",
+		"%spellout-cardinal-neuter:",
+		"-x: минус >>;",
+		"x.x: << цяло и >>;",
+		"0: нула;",
+		"1: едно;",
+		"2: две;",
+		"3: =%spellout-cardinal-masculine=;",
+		"20: <%spellout-cardinal-masculine<йсет[ и >>];",
+		"40: четиресет[ и >>];",
+		"50: <%spellout-cardinal-masculine<десет[ и >>];",
+		"60: шейсет[ и >>];",
+		"70: <%spellout-cardinal-masculine<десет[ и >>];",
+		"100: сто[ >%%spellout-cardinal-neuter-and>];",
+		"200: двеста[ >%%spellout-cardinal-neuter-and>];",
+		"300: триста[ >%%spellout-cardinal-neuter-and>];",
+		"400: <<стотин[ >%%spellout-cardinal-neuter-and>];",
+		"1000: хиляда[ >%%spellout-cardinal-neuter-and>];",
+		"2000: <%spellout-cardinal-feminine< хиляди[ >%%spellout-cardinal-neuter-and>];",
+		"1000000: <%spellout-cardinal-masculine< $(cardinal,one{милион}other{милиона})$[ >%%spellout-cardinal-neuter-and>];",
+		"1000000000: <%spellout-cardinal-masculine< $(cardinal,one{милиард}other{милиарда})$[ >%%spellout-cardinal-neuter-and>];",
+		"1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{трилион}other{трилиона})$[ >%%spellout-cardinal-neuter-and>];",
+		"1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{квадрилион}other{квадрилиона})$[ >%%spellout-cardinal-neuter-and>];",
+		"1000000000000000000: =#,##0=;",
+		"%%spellout-cardinal-neuter-and:",
+		"0: и =%spellout-cardinal-neuter=;",
+		"20: и <%spellout-cardinal-masculine<йсет;",
+		"21: <%spellout-cardinal-masculine<йсет >>;",
+		"40: и четиресет;",
+		"41: четиресет >>;",
+		"50: и <%spellout-cardinal-masculine<десет;",
+		"51: <%spellout-cardinal-masculine<десет >>;",
+		"60: и шейсет;",
+		"61: шейсет >>;",
+		"70: и <%spellout-cardinal-masculine<десет;",
+		"71: <%spellout-cardinal-masculine<десет >>;",
+		"100: =%spellout-cardinal-neuter=;",
+		"%spellout-cardinal-masculine-personal:",
+		"-x: минус >>;",
+		"x.x: << цяло и >>;",
+		"0: нула;",
+		"1: един;",
+		"2: двама;",
+		"3: трима;",
+		"4: четирима;",
+		"5: петима;",
+		"6: шестима;",
+		"7: =%spellout-cardinal-masculine=;",
+		"20: <%spellout-cardinal-masculine<йсет[ и >>];",
+		"40: четиресет[ и >>];",
+		"50: <%spellout-cardinal-masculine<десет[ и >>];",
+		"60: шейсет[ и >>];",
+		"70: <%spellout-cardinal-masculine<десет[ и >>];",
+		"100: сто[ >%%spellout-cardinal-masculine-personal-and>];",
+		"200: двеста[ >%%spellout-cardinal-masculine-personal-and>];",
+		"300: триста[ >%%spellout-cardinal-masculine-personal-and>];",
+		"400: <%spellout-cardinal-masculine<стотин[ >%%spellout-cardinal-masculine-personal-and>];",
+		"1000: хиляда[ >%%spellout-cardinal-masculine-personal-and>];",
+		"2000: <%spellout-cardinal-feminine< хиляди[ >%%spellout-cardinal-masculine-personal-and>];",
+		"1000000: <%spellout-cardinal-masculine< $(cardinal,one{милион}other{милиона})$[ >%%spellout-cardinal-masculine-personal-and>];",
+		"1000000000: <%spellout-cardinal-masculine< $(cardinal,one{милиард}other{милиарда})$[ >%%spellout-cardinal-masculine-personal-and>];",
+		"1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{трилион}other{трилиона})$[ >%%spellout-cardinal-masculine-personal-and>];",
+		"1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{квадрилион}other{квадрилиона})$[ >%%spellout-cardinal-masculine-personal-and>];",
+		"1000000000000000000: =#,##0=;",
+		"%%spellout-cardinal-masculine-personal-and:",
+		"0: и =%spellout-cardinal-masculine-personal=;",
+		"20: и <%spellout-cardinal-masculine-personal<йсет;",
+		"21: <%spellout-cardinal-masculine<йсет >>;",
+		"40: и четиресет;",
+		"41: четиресет >>;",
+		"50: и <%spellout-cardinal-masculine<десет;",
+		"51: <%spellout-cardinal-masculine<десет >>;",
+		"60: и шейсет;",
+		"61: шейсет >>;",
+		"70: и <%spellout-cardinal-masculine<десет;",
+		"71: <%spellout-cardinal-masculine<десет >>;",
+		"100: =%spellout-cardinal-masculine-personal=;",
+		"%spellout-cardinal-masculine-personal-financial:",
+		"-x: минус >>;",
+		"x.x: << цяло и >>;",
+		"0: нула;",
+		"1: един;",
+		"2: двама;",
+		"3: трима;",
+		"4: четирима;",
+		"5: петима;",
+		"6: шестима;",
+		"7: =%spellout-cardinal-masculine-financial=;",
+		"20: <%spellout-cardinal-masculine<десет[ и >>];",
+		"100: сто[ >%%spellout-cardinal-masculine-personal-financial-and>];",
+		"200: двеста[ >%%spellout-cardinal-masculine-personal-financial-and>];",
+		"300: триста[ >%%spellout-cardinal-masculine-personal-financial-and>];",
+		"400: <%spellout-cardinal-masculine-financial<стотин[ >%%spellout-cardinal-masculine-personal-financial-and>];",
+		"1000: хиляда[ >%%spellout-cardinal-masculine-personal-financial-and>];",
+		"2000: <%spellout-cardinal-feminine-financial< хиляди[ >%%spellout-cardinal-masculine-personal-financial-and>];",
+		"1000000: <%spellout-cardinal-masculine-financial< $(cardinal,one{милион}other{милиона})$[ >%%spellout-cardinal-masculine-personal-financial-and>];",
+		"1000000000: <%spellout-cardinal-masculine-financial< $(cardinal,one{милиард}other{милиарда})$[ >%%spellout-cardinal-masculine-personal-financial-and>];",
+		"1000000000000: <%spellout-cardinal-masculine-financial< $(cardinal,one{трилион}other{трилиона})$[ >%%spellout-cardinal-masculine-personal-financial-and>];",
+		"1000000000000000: <%spellout-cardinal-masculine-financial< $(cardinal,one{квадрилион}other{квадрилиона})$[ >%%spellout-cardinal-masculine-personal-financial-and>];",
+		"1000000000000000000: =#,##0=;",
+		"%%spellout-cardinal-masculine-personal-financial-and:",
+		"0: и =%spellout-cardinal-masculine-personal-financial=;",
+		"20: и <%spellout-cardinal-masculine-personal-financial<десет;",
+		"21: <%spellout-cardinal-masculine-personal-financial<десет >>;",
+		"100: =%spellout-cardinal-masculine-personal-financial=;",
+		"%spellout-cardinal-masculine-financial:",
+		"-x: минус >>;",
+		"x.x: << цяло и >>;",
+		"0: нула;",
+		"1: един;",
+		"2: два;",
+		"3: три;",
+		"4: четири;",
+		"5: пет;",
+		"6: шест;",
+		"7: седем;",
+		"8: осем;",
+		"9: девет;",
+		"10: десет;",
+		"11: единадесет;",
+		"12: дванадесет;",
+		"13: >>надесет;",
+		"20: двадесет[ и >>];",
+		"30: <<десет[ и >>];",
+		"100: сто[ >%%spellout-cardinal-masculine-financial-and>];",
+		"200: двеста[ >%%spellout-cardinal-masculine-financial-and>];",
+		"300: триста[ >%%spellout-cardinal-masculine-financial-and>];",
+		"400: <<стотин[ >%%spellout-cardinal-masculine-financial-and>];",
+		"1000: хиляда[ >%%spellout-cardinal-masculine-financial-and>];",
+		"2000: <%spellout-cardinal-feminine-financial< хиляди[ >%%spellout-cardinal-masculine-financial-and>];",
+		"1000000: <%spellout-cardinal-masculine-financial< $(cardinal,one{милион}other{милиона})$[ >%%spellout-cardinal-masculine-financial-and>];",
+		"1000000000: <%spellout-cardinal-masculine-financial< $(cardinal,one{милиард}other{милиарда})$[ >%%spellout-cardinal-masculine-financial-and>];",
+		"1000000000000: <%spellout-cardinal-masculine-financial< $(cardinal,one{трилион}other{трилиона})$[ >%%spellout-cardinal-masculine-financial-and>];",
+		"1000000000000000: <%spellout-cardinal-masculine-financial< $(cardinal,one{квадрилион}other{квадрилиона})$[ >%%spellout-cardinal-masculine-financial-and>];",
+		"1000000000000000000: =#,##0=;",
+		"%%spellout-cardinal-masculine-financial-and:",
+		"0: и =%spellout-cardinal-masculine-financial=;",
+		"20: и <%spellout-cardinal-masculine-financial<десет;",
+		"21: <%spellout-cardinal-masculine-financial<десет >>;",
+		"100: =%spellout-cardinal-masculine-financial=;",
+		"%spellout-cardinal-feminine-financial:",
+		"-x: минус >>;",
+		"x.x: << цяло и >>;",
+		"0: нула;",
+		"1: една;",
+		"2: две;",
+		"3: =%spellout-cardinal-masculine-financial=;",
+		"20: двадесет[ и >>];",
+		"30: <<десет[ и >>];",
+		"100: сто[ >%%spellout-cardinal-feminine-financial-and>];",
+		"200: двеста[ >%%spellout-cardinal-feminine-financial-and>];",
+		"300: триста[ >%%spellout-cardinal-feminine-financial-and>];",
+		"400: <<стотин[ >%%spellout-cardinal-feminine-financial-and>];",
+		"1000: хиляда[ >%%spellout-cardinal-feminine-financial-and>];",
+		"2000: <%spellout-cardinal-feminine-financial< хиляди[ >%%spellout-cardinal-feminine-financial-and>];",
+		"1000000: <%spellout-cardinal-masculine-financial< $(cardinal,one{милион}other{милиона})$[ >%%spellout-cardinal-feminine-financial-and>];",
+		"1000000000: <%spellout-cardinal-masculine-financial< $(cardinal,one{милиард}other{милиарда})$[ >%%spellout-cardinal-feminine-financial-and>];",
+		"1000000000000: <%spellout-cardinal-masculine-financial< $(cardinal,one{трилион}other{трилиона})$[ >%%spellout-cardinal-feminine-financial-and>];",
+		"1000000000000000: <%spellout-cardinal-masculine-financial< $(cardinal,one{квадрилион}other{квадрилиона})$[ >%%spellout-cardinal-feminine-financial-and>];",
+		"1000000000000000000: =#,##0=;",
+		"%%spellout-cardinal-feminine-financial-and:",
+		"0: и =%spellout-cardinal-feminine-financial=;",
+		"20: и <%spellout-cardinal-masculine-financial<десет;",
+		"21: <%spellout-cardinal-masculine-financial<десет >>;",
+		"100: =%spellout-cardinal-feminine-financial=;",
+		"%spellout-cardinal-neuter-financial:",
+		"-x: минус >>;",
+		"x.x: << цяло и >>;",
+		"0: нула;",
+		"1: едно;",
+		"2: две;",
+		"3: =%spellout-cardinal-masculine-financial=;",
+		"20: двадесет[ и >>];",
+		"30: <<десет[ и >>];",
+		"100: сто[ >%%spellout-cardinal-neuter-financial-and>];",
+		"200: двеста[ >%%spellout-cardinal-neuter-financial-and>];",
+		"300: триста[ >%%spellout-cardinal-neuter-financial-and>];",
+		"400: <<стотин[ >%%spellout-cardinal-neuter-financial-and>];",
+		"1000: хиляда[ >%%spellout-cardinal-neuter-financial-and>];",
+		"2000: <%spellout-cardinal-feminine-financial< хиляди[ >%%spellout-cardinal-neuter-financial-and>];",
+		"1000000: <%spellout-cardinal-masculine-financial< $(cardinal,one{милион}other{милиона})$[ >%%spellout-cardinal-neuter-financial-and>];",
+		"1000000000: <%spellout-cardinal-masculine-financial< $(cardinal,one{милиард}other{милиарда})$[ >%%spellout-cardinal-neuter-financial-and>];",
+		"1000000000000: <%spellout-cardinal-masculine-financial< $(cardinal,one{трилион}other{трилиона})$[ >%%spellout-cardinal-neuter-financial-and>];",
+		"1000000000000000: <%spellout-cardinal-masculine-financial< $(cardinal,one{квадрилион}other{квадрилиона})$[ >%%spellout-cardinal-neuter-financial-and>];",
+		"1000000000000000000: =#,##0=;",
+		"%%spellout-cardinal-neuter-financial-and:",
+		"0: и =%spellout-cardinal-neuter-financial=;",
+		"20: и <%spellout-cardinal-masculine-financial<десет;",
+		"21: <%spellout-cardinal-masculine-financial<десет >>;",
+		"100: =%spellout-cardinal-neuter-financial=;",
+		"%spellout-ordinal-masculine:",
+		"-x: минус >>;",
+		"x.x: =#,##0.#=;",
+		"0: нула;",
+		"1: първи;",
+		"2: втори;",
+		"3: трети;",
+		"4: четвърти;",
+		"5: пети;",
+		"6: шести;",
+		"7: седми;",
+		"8: осми;",
+		"9: девети;",
+		"10: десети;",
+		"11: единайсети;",
+		"12: >%spellout-cardinal-masculine>найсети;",
+		"20: двайсет>%%spellout-ordinal-masculine-and-suffix>;",
+		"30: трийсет>%%spellout-ordinal-masculine-and-suffix>;",
+		"40: четиресет>%%spellout-ordinal-masculine-and-suffix>;",
+		"50: петдесет>%%spellout-ordinal-masculine-and-suffix>;",
+		"60: шейсет>%%spellout-ordinal-masculine-and-suffix>;",
+		"70: <%spellout-cardinal-masculine<десет>%%spellout-ordinal-masculine-and-suffix>;",
+		"100: сто>%%spellout-ordinal-masculine-hundreds-and-suffix>;",
+		"200: <%spellout-cardinal-masculine<сто>%%spellout-ordinal-masculine-hundreds-and-suffix>;",
+		"1000: хиляд>%%spellout-ordinal-masculine-thousand-and-suffix>;",
+		"2000: <%spellout-cardinal-feminine< хиляд>%%spellout-ordinal-masculine-thousands-and-suffix>;",
+		"1000000: <%spellout-cardinal-masculine< милион>%%spellout-ordinal-masculine-million-and-suffix>;",
+		"2000000: <%spellout-cardinal-masculine< милион>%%spellout-ordinal-masculine-thousand-and-suffix>;",
+		"1000000000: <%spellout-cardinal-masculine< милиард>%%spellout-ordinal-masculine-million-and-suffix>;",
+		"2000000000: <%spellout-cardinal-masculine< милиард>%%spellout-ordinal-masculine-thousand-and-suffix>;",
+		"1000000000000: <%spellout-cardinal-masculine< трилион>%%spellout-ordinal-masculine-million-and-suffix>;",
+		"2000000000000: <%spellout-cardinal-masculine< трилион>%%spellout-ordinal-masculine-thousand-and-suffix>;",
+		"1000000000000000: <%spellout-cardinal-masculine< квадрилион>%%spellout-ordinal-masculine-million-and-suffix>;",
+		"2000000000000000: <%spellout-cardinal-masculine< квадрилион>%%spellout-ordinal-masculine-thousand-and-suffix>;",
+		"1000000000000000000: =#,##0=-и;",
+		"%%spellout-ordinal-masculine-and-suffix:",
+		"0: и;",
+		"1: ' и =%spellout-ordinal-masculine=;",
+		"%%spellout-ordinal-masculine-hundreds-and-suffix:",
+		"0: тен;",
+		"1: ' и =%spellout-ordinal-masculine=;",
+		"20: ' и =%spellout-ordinal-masculine=;",
+		"21: ' =%spellout-ordinal-masculine=;",
+		"30: ' и =%spellout-ordinal-masculine=;",
+		"31: ' =%spellout-ordinal-masculine=;",
+		"40: ' и =%spellout-ordinal-masculine=;",
+		"41: ' =%spellout-ordinal-masculine=;",
+		"50: ' и =%spellout-ordinal-masculine=;",
+		"51: ' =%spellout-ordinal-masculine=;",
+		"60: ' и =%spellout-ordinal-masculine=;",
+		"61: ' =%spellout-ordinal-masculine=;",
+		"70: ' и =%spellout-ordinal-masculine=;",
+		"71: ' =%spellout-ordinal-masculine=;",
+		"80: ' и =%spellout-ordinal-masculine=;",
+		"81: ' =%spellout-ordinal-masculine=;",
+		"90: ' и =%spellout-ordinal-masculine=;",
+		"91: ' =%spellout-ordinal-masculine=;",
+		"100: ' =%spellout-ordinal-masculine=;",
+		"%%spellout-ordinal-masculine-thousand-and-suffix:",
+		"0: ен;",
+		"1: 'а и =%spellout-ordinal-masculine=;",
+		"10: 'а =%spellout-ordinal-masculine=;",
+		"%%spellout-ordinal-masculine-thousands-and-suffix:",
+		"0: ен;",
+		"1: 'и и =%spellout-ordinal-masculine=;",
+		"10: 'и =%spellout-ordinal-masculine=;",
+		"%%spellout-ordinal-masculine-million-and-suffix:",
+		"0: ен;",
+		"1: ' и =%spellout-ordinal-masculine=;",
+		"10: ' =%spellout-ordinal-masculine=;",
+		"%spellout-ordinal-feminine:",
+		"-x: минус >>;",
+		"x.x: =#,##0.#=;",
+		"0: нула;",
+		"1: първа;",
+		"2: втора;",
+		"3: трета;",
+		"4: четвърта;",
+		"5: пета;",
+		"6: шеста;",
+		"7: седма;",
+		"8: осма;",
+		"9: девета;",
+		"10: десета;",
+		"11: единайсета;",
+		"12: >%spellout-cardinal-masculine>найсета;",
+		"20: двайсет>%%spellout-ordinal-feminine-and-suffix>;",
+		"30: трийсет>%%spellout-ordinal-feminine-and-suffix>;",
+		"40: четиресет>%%spellout-ordinal-feminine-and-suffix>;",
+		"50: петдесет>%%spellout-ordinal-feminine-and-suffix>;",
+		"60: шейсет>%%spellout-ordinal-feminine-and-suffix>;",
+		"70: <%spellout-cardinal-masculine<десет>%%spellout-ordinal-feminine-and-suffix>;",
+		"100: сто>%%spellout-ordinal-feminine-hundreds-and-suffix>;",
+		"200: <%spellout-cardinal-masculine<сто>%%spellout-ordinal-feminine-hundreds-and-suffix>;",
+		"1000: хиляд>%%spellout-ordinal-feminine-thousand-and-suffix>;",
+		"2000: <%spellout-cardinal-feminine< хиляд>%%spellout-ordinal-feminine-thousands-and-suffix>;",
+		"1000000: <%spellout-cardinal-masculine< милион>%%spellout-ordinal-feminine-million-and-suffix>;",
+		"2000000: <%spellout-cardinal-masculine< милион>%%spellout-ordinal-feminine-thousand-and-suffix>;",
+		"1000000000: <%spellout-cardinal-masculine< милиард>%%spellout-ordinal-feminine-million-and-suffix>;",
+		"2000000000: <%spellout-cardinal-masculine< милиард>%%spellout-ordinal-feminine-thousand-and-suffix>;",
+		"1000000000000: <%spellout-cardinal-masculine< трилион>%%spellout-ordinal-feminine-million-and-suffix>;",
+		"2000000000000: <%spellout-cardinal-masculine< трилион>%%spellout-ordinal-feminine-thousand-and-suffix>;",
+		"1000000000000000: <%spellout-cardinal-masculine< квадрилион>%%spellout-ordinal-feminine-million-and-suffix>;",
+		"2000000000000000: <%spellout-cardinal-masculine< квадрилион>%%spellout-ordinal-feminine-thousand-and-suffix>;",
+		"1000000000000000000: =#,##0=-а;",
+		"%%spellout-ordinal-feminine-and-suffix:",
+		"0: а;",
+		"1: ' и =%spellout-ordinal-feminine=;",
+		"%%spellout-ordinal-feminine-hundreds-and-suffix:",
+		"0: тна;",
+		"1: ' и =%spellout-ordinal-feminine=;",
+		"20: ' и =%spellout-ordinal-feminine=;",
+		"21: ' =%spellout-ordinal-feminine=;",
+		"30: ' и =%spellout-ordinal-feminine=;",
+		"31: ' =%spellout-ordinal-feminine=;",
+		"40: ' и =%spellout-ordinal-feminine=;",
+		"41: ' =%spellout-ordinal-feminine=;",
+		"50: ' и =%spellout-ordinal-feminine=;",
+		"51: ' =%spellout-ordinal-feminine=;",
+		"60: ' и =%spellout-ordinal-feminine=;",
+		"61: ' =%spellout-ordinal-feminine=;",
+		"70: ' и =%spellout-ordinal-feminine=;",
+		"71: ' =%spellout-ordinal-feminine=;",
+		"80: ' и =%spellout-ordinal-feminine=;",
+		"81: ' =%spellout-ordinal-feminine=;",
+		"90: ' и =%spellout-ordinal-feminine=;",
+		"91: ' =%spellout-ordinal-feminine=;",
+		"100: ' =%spellout-ordinal-feminine=;",
+		"%%spellout-ordinal-feminine-thousand-and-suffix:",
+		"0: на;",
+		"1: 'а и =%spellout-ordinal-feminine=;",
+		"10: 'а =%spellout-ordinal-feminine=;",
+		"%%spellout-ordinal-feminine-thousands-and-suffix:",
+		"0: на;",
+		"1: 'и и =%spellout-ordinal-feminine=;",
+		"10: 'и =%spellout-ordinal-feminine=;",
+		"%%spellout-ordinal-feminine-million-and-suffix:",
+		"0: на;",
+		"1: ' и =%spellout-ordinal-feminine=;",
+		"10: ' =%spellout-ordinal-feminine=;",
+		"%spellout-ordinal-neuter:",
+		"-x: минус >>;",
+		"x.x: =#,##0.#=;",
+		"0: нула;",
+		"1: първо;",
+		"2: второ;",
+		"3: трето;",
+		"4: четвърто;",
+		"5: пето;",
+		"6: шесто;",
+		"7: седмо;",
+		"8: осмо;",
+		"9: девето;",
+		"10: десето;",
+		"11: единайсето;",
+		"12: >%spellout-cardinal-masculine>найсето;",
+		"20: двайсет>%%spellout-ordinal-neuter-and-suffix>;",
+		"30: трийсет>%%spellout-ordinal-neuter-and-suffix>;",
+		"40: четиресет>%%spellout-ordinal-neuter-and-suffix>;",
+		"50: петдесет>%%spellout-ordinal-neuter-and-suffix>;",
+		"60: шейсет>%%spellout-ordinal-neuter-and-suffix>;",
+		"70: <%spellout-cardinal-masculine<десет>%%spellout-ordinal-neuter-and-suffix>;",
+		"100: сто>%%spellout-ordinal-neuter-hundreds-and-suffix>;",
+		"200: <%spellout-cardinal-masculine<сто>%%spellout-ordinal-neuter-hundreds-and-suffix>;",
+		"1000: хиляд>%%spellout-ordinal-neuter-thousand-and-suffix>;",
+		"2000: <%spellout-cardinal-feminine< хиляд>%%spellout-ordinal-neuter-thousands-and-suffix>;",
+		"1000000: <%spellout-cardinal-masculine< милион>%%spellout-ordinal-neuter-million-and-suffix>;",
+		"2000000: <%spellout-cardinal-masculine< милион>%%spellout-ordinal-neuter-thousand-and-suffix>;",
+		"1000000000: <%spellout-cardinal-masculine< милиард>%%spellout-ordinal-neuter-million-and-suffix>;",
+		"2000000000: <%spellout-cardinal-masculine< милиард>%%spellout-ordinal-neuter-thousand-and-suffix>;",
+		"1000000000000: <%spellout-cardinal-masculine< трилион>%%spellout-ordinal-neuter-million-and-suffix>;",
+		"2000000000000: <%spellout-cardinal-masculine< трилион>%%spellout-ordinal-neuter-thousand-and-suffix>;",
+		"1000000000000000: <%spellout-cardinal-masculine< квадрилион>%%spellout-ordinal-neuter-million-and-suffix>;",
+		"2000000000000000: <%spellout-cardinal-masculine< квадрилион>%%spellout-ordinal-neuter-thousand-and-suffix>;",
+		"1000000000000000000: =#,##0=-о;",
+		"%%spellout-ordinal-neuter-and-suffix:",
+		"0: о;",
+		"1: ' и =%spellout-ordinal-neuter=;",
+		"%%spellout-ordinal-neuter-hundreds-and-suffix:",
+		"0: тно;",
+		"1: ' и =%spellout-ordinal-neuter=;",
+		"20: ' и =%spellout-ordinal-neuter=;",
+		"21: ' =%spellout-ordinal-neuter=;",
+		"30: ' и =%spellout-ordinal-neuter=;",
+		"31: ' =%spellout-ordinal-neuter=;",
+		"40: ' и =%spellout-ordinal-neuter=;",
+		"41: ' =%spellout-ordinal-neuter=;",
+		"50: ' и =%spellout-ordinal-neuter=;",
+		"51: ' =%spellout-ordinal-neuter=;",
+		"60: ' и =%spellout-ordinal-neuter=;",
+		"61: ' =%spellout-ordinal-neuter=;",
+		"70: ' и =%spellout-ordinal-neuter=;",
+		"71: ' =%spellout-ordinal-neuter=;",
+		"80: ' и =%spellout-ordinal-neuter=;",
+		"81: ' =%spellout-ordinal-neuter=;",
+		"90: ' и =%spellout-ordinal-neuter=;",
+		"91: ' =%spellout-ordinal-neuter=;",
+		"100: ' =%spellout-ordinal-neuter=;",
+		"%%spellout-ordinal-neuter-thousand-and-suffix:",
+		"0: но;",
+		"1: 'а и =%spellout-ordinal-neuter=;",
+		"10: 'а =%spellout-ordinal-neuter=;",
+		"%%spellout-ordinal-neuter-thousands-and-suffix:",
+		"0: но;",
+		"1: 'и и =%spellout-ordinal-neuter=;",
+		"10: 'и =%spellout-ordinal-neuter=;",
+		"%%spellout-ordinal-neuter-million-and-suffix:",
+		"0: но;",
+		"1: ' и =%spellout-ordinal-neuter=;",
+		"10: ' =%spellout-ordinal-neuter=;",
+	},
+	"zh": {
+		"%spellout-numbering-year:",
+		"x.x: =0.0=;",
+		"0: =%spellout-numbering=;",
+		"1000: =%%spellout-numbering-year-digits=;",
+		"10000: =%spellout-numbering=;",
+		"%%spellout-numbering-year-digits:",
+		"0: =%spellout-numbering=;",
+		"10: <<>>>;",
+		"100: <<>>>;",
+		"1000: <<>>>;",
+		"%spellout-numbering-days:",
+		"-x: 负>>;",
+		"x.x: =#,##0.#=;",
+		"0: 〇;",
+		"1: 初=%spellout-numbering=;",
+		"11: =%spellout-numbering=;",
+		"21: =%%numbering-days=;",
+		"%%numbering-days:",
+		"0: =%spellout-numbering=;",
+		"21: 廿>>;",
+		"30: <<十;",
+		"31: 丗>>;",
+		"40: <<十;",
+		"41: 卌>>;",
+		"50: =%spellout-numbering=;",
+		"%spellout-numbering:",
+		"-x: 负>>;",
+		"x.x: <<点>>>;",
+		"0: 〇;",
+		"1: 一;",
+		"2: 二;",
+		"3: 三;",
+		"4: 四;",
+		"5: 五;",
+		"6: 六;",
+		"7: 七;",
+		"8: 八;",
+		"9: 九;",
+		"10: 十[>>];",
+		"20: <<十[>>];",
+		"100: <<百[>%%number2>];",
+		"1000: <<千[>%%number3>];",
+		"10000: <<万[>%%number4>];",
+		"100000000: <<亿[>%%number5>];",
+		"1000000000000: <<兆[>%%number8>];",
+		"10000000000000000: <<京[>%%number13>];",
+		"1000000000000000000: =#,##0=;",
+		"%%number2:",
+		"1: 〇=%spellout-numbering=;",
+		"10: 一=%spellout-numbering=;",
+		"20: =%spellout-numbering=;",
+		"%%number3:",
+		"1: 〇=%spellout-numbering=;",
+		"10: 〇一=%spellout-numbering=;",
+		"20: 〇=%spellout-numbering=;",
+		"100: =%spellout-numbering=;",
+		"%%number4:",
+		"1: 〇=%spellout-numbering=;",
+		"10: 〇一=%spellout-numbering=;",
+		"20: 〇=%spellout-numbering=;",
+		"1000: =%spellout-numbering=;",
+		"%%number5:",
+		"1: 〇=%spellout-numbering=;",
+		"10: 〇一=%spellout-numbering=;",
+		"20: 〇=%spellout-numbering=;",
+		"10000: =%spellout-numbering=;",
+		"%%number8:",
+		"1: 〇=%spellout-numbering=;",
+		"10: 〇一=%spellout-numbering=;",
+		"20: 〇=%spellout-numbering=;",
+		"10000000: =%spellout-numbering=;",
+		"%%number13:",
+		"1: 〇=%spellout-numbering=;",
+		"10: 〇一=%spellout-numbering=;",
+		"20: 〇=%spellout-numbering=;",
+		"1000000000000: =%spellout-numbering=;",
+		"%spellout-cardinal-financial:",
+		"-x: 负>>;",
+		"x.x: <<点>>>;",
+		"0: 零;",
+		"1: 壹;",
+		"2: 贰;",
+		"3: 叁;",
+		"4: 肆;",
+		"5: 伍;",
+		"6: 陆;",
+		"7: 柒;",
+		"8: 捌;",
+		"9: 玖;",
+		"10: 拾[>>];",
+		"20: <<拾[>>];",
+		"100: <<佰[>%%financialnumber2>];",
+		"1000: <<仟[>%%financialnumber3>];",
+		"10000: <<万[>%%financialnumber4>];",
+		"100000000: <<亿[>%%financialnumber5>];",
+		"1000000000000: <<兆[>%%financialnumber8>];",
+		"10000000000000000: <<京[>%%financialnumber13>];",
+		"1000000000000000000: =#,##0=;",
+		"%%financialnumber2:",
+		"1: 零=%spellout-cardinal-financial=;",
+		"10: 壹=%spellout-cardinal-financial=;",
+		"20: =%spellout-cardinal-financial=;",
+		"%%financialnumber3:",
+		"1: 零=%spellout-cardinal-financial=;",
+		"10: 零壹=%spellout-cardinal-financial=;",
+		"20: 零=%spellout-cardinal-financial=;",
+		"100: =%spellout-cardinal-financial=;",
+		"%%financialnumber4:",
+		"1: 零=%spellout-cardinal-financial=;",
+		"10: 零壹=%spellout-cardinal-financial=;",
+		"20: 零=%spellout-cardinal-financial=;",
+		"1000: =%spellout-cardinal-financial=;",
+		"%%financialnumber5:",
+		"1: 零=%spellout-cardinal-financial=;",
+		"10: 零壹=%spellout-cardinal-financial=;",
+		"20: 零=%spellout-cardinal-financial=;",
+		"10000: =%spellout-cardinal-financial=;",
+		"%%financialnumber8:",
+		"1: 零=%spellout-cardinal-financial=;",
+		"10: 零壹=%spellout-cardinal-financial=;",
+		"20: 零=%spellout-cardinal-financial=;",
+		"10000000: =%spellout-cardinal-financial=;",
+		"%%financialnumber13:",
+		"1: 零=%spellout-cardinal-financial=;",
+		"10: 零壹=%spellout-cardinal-financial=;",
+		"20: 零=%spellout-cardinal-financial=;",
+		"1000000000000: =%spellout-cardinal-financial=;",
+		"%spellout-cardinal:",
+		"-x: 负>>;",
+		"x.x: <<点>>>;",
+		"0: 零;",
+		"1: 一;",
+		"2: 二;",
+		"3: 三;",
+		"4: 四;",
+		"5: 五;",
+		"6: 六;",
+		"7: 七;",
+		"8: 八;",
+		"9: 九;",
+		"10: =%spellout-numbering=;",
+		"100: <<百[>%%cardinal2>];",
+		"1000: <<千[>%%cardinal3>];",
+		"10000: <<万[>%%cardinal4>];",
+		"100000000: <<亿[>%%cardinal5>];",
+		"1000000000000: <<兆[>%%cardinal8>];",
+		"10000000000000000: <<京[>%%cardinal13>];",
+		"1000000000000000000: =#,##0=;",
+		"%%cardinal2:",
+		"1: 零=%spellout-numbering=;",
+		"10: 一=%spellout-numbering=;",
+		"20: =%spellout-numbering=;",
+		"%%cardinal3:",
+		"1: 零=%spellout-numbering=;",
+		"10: 零一=%spellout-cardinal=;",
+		"20: 零=%spellout-cardinal=;",
+		"100: =%spellout-cardinal=;",
+		"%%cardinal4:",
+		"1: 零=%spellout-numbering=;",
+		"10: 零一=%spellout-cardinal=;",
+		"20: 零=%spellout-cardinal=;",
+		"1000: =%spellout-cardinal=;",
+		"%%cardinal5:",
+		"1: 零=%spellout-numbering=;",
+		"10: 零一=%spellout-cardinal=;",
+		"20: 零=%spellout-cardinal=;",
+		"10000: =%spellout-cardinal=;",
+		"%%cardinal8:",
+		"1: 零=%spellout-numbering=;",
+		"10: 零一=%spellout-cardinal=;",
+		"20: 零=%spellout-cardinal=;",
+		"10000000: =%spellout-cardinal=;",
+		"%%cardinal13:",
+		"1: 零=%spellout-numbering=;",
+		"10: 零一=%spellout-cardinal=;",
+		"20: 零=%spellout-cardinal=;",
+		"1000000000000: =%spellout-cardinal=;",
+		"%spellout-cardinal-alternate2:",
+		"-x: 负>>;",
+		"x.x: =%spellout-cardinal=;",
+		"0: 零;",
+		"1: 一;",
+		"2: 两;",
+		"3: 三;",
+		"4: 四;",
+		"5: 五;",
+		"6: 六;",
+		"7: 七;",
+		"8: 八;",
+		"9: 九;",
+		"10: =%spellout-numbering=;",
+		"100: <<百[>%%cardinal-alternate2-2>];",
+		"1000: <<千[>%%cardinal-alternate2-3>];",
+		"10000: <<万[>%%cardinal-alternate2-4>];",
+		"100000000: <<亿[>%%cardinal-alternate2-5>];",
+		"1000000000000: <<兆[>%%cardinal-alternate2-8>];",
+		"10000000000000000: <<京[>%%cardinal-alternate2-13>];",
+		"1000000000000000000: =#,##0=;",
+		"%%cardinal-alternate2-2:",
+		"1: 零=%spellout-numbering=;",
+		"10: 一=%spellout-numbering=;",
+		"20: =%spellout-numbering=;",
+		"%%cardinal-alternate2-3:",
+		"1: 零=%spellout-numbering=;",
+		"10: 零一=%spellout-cardinal-alternate2=;",
+		"20: 零=%spellout-cardinal-alternate2=;",
+		"100: =%spellout-cardinal-alternate2=;",
+		"%%cardinal-alternate2-4:",
+		"1: 零=%spellout-numbering=;",
+		"10: 零一=%spellout-cardinal-alternate2=;",
+		"20: 零=%spellout-cardinal-alternate2=;",
+		"1000: =%spellout-cardinal-alternate2=;",
+		"%%cardinal-alternate2-5:",
+		"1: 零=%spellout-numbering=;",
+		"10: 零一=%spellout-cardinal-alternate2=;",
+		"20: 零=%spellout-cardinal-alternate2=;",
+		"10000: =%spellout-cardinal-alternate2=;",
+		"%%cardinal-alternate2-8:",
+		"1: 零=%spellout-numbering=;",
+		"10: 零一=%spellout-cardinal-alternate2=;",
+		"20: 零=%spellout-cardinal-alternate2=;",
+		"10000000: =%spellout-cardinal-alternate2=;",
+		"%%cardinal-alternate2-13:",
+		"1: 零=%spellout-numbering=;",
+		"10: 零一=%spellout-cardinal-alternate2=;",
+		"20: 零=%spellout-cardinal-alternate2=;",
+		"1000000000000: =%spellout-cardinal-alternate2=;",
+		"%spellout-ordinal:",
+		"x.x: =#,##0.#=;",
+		"0: 第=%spellout-numbering=;",
+	},
+}
+
+// Ordinal rules of rule-based number formats, copied by hand from CLDR 48.
+var ordinalRules = map[string][]string{
+	"root": {
+		"%digits-ordinal:",
+		"-x: −>>;",
+		"0: =#,##0=.;",
+	},
+	"en": {
+		"%digits-ordinal:",
+		"-x: −>>;",
+		"0: =#,##0=$(ordinal,one{st}two{nd}few{rd}other{th})$;",
+	},
+	"fr": {
+		"%digits-ordinal-masculine:",
+		"-x: −>>;",
+		"0: =#,##0=$(ordinal,one{er}other{e})$;",
+		"%digits-ordinal-feminine:",
+		"-x: −>>;",
+		"0: =#,##0=$(ordinal,one{re}other{e})$;",
+		"%digits-ordinal-masculine-plural:",
+		"-x: −>>;",
+		"0: =#,##0=$(ordinal,one{ers}other{es})$;",
+		"%digits-ordinal-feminine-plural:",
+		"-x: −>>;",
+		"0: =#,##0=$(ordinal,one{res}other{es})$;",
+		"%digits-ordinal:",
+		"0: =%digits-ordinal-masculine=;",
+	},
+	"es": {
+		"%%dord-mascabbrev:",
+		"0: º;",
+		"1: ᵉʳ;",
+		"2: º;",
+		"3: ᵉʳ;",
+		"4: º;",
+		"20: >>;",
+		"100: >>;",
+		"%digits-ordinal-masculine-adjective:",
+		"-x: −>>;",
+		"0: =#,##0=.=%%dord-mascabbrev=;",
+		"%digits-ordinal-masculine:",
+		"-x: −>>;",
+		"0: =#,##0=.º;",
+		"%digits-ordinal-feminine:",
+		"-x: −>>;",
+		"0: =#,##0=.ª;",
+		"%digits-ordinal-masculine-plural:",
+		"-x: −>>;",
+		"0: =#,##0=.ᵒˢ;",
+		"%digits-ordinal-feminine-plural:",
+		"-x: −>>;",
+		"0: =#,##0=.ᵃˢ;",
+		"%digits-ordinal:",
+		"0: =%digits-ordinal-masculine=;",
+	},
+	"it": {
+		"%%dord-mascabbrev:",
+		"0: º;",
+		"%digits-ordinal-masculine:",
+		"-x: −>>;",
+		"0: =#,##0==%%dord-mascabbrev=;",
+		"%%dord-femabbrev:",
+		"0: ª;",
+		"%digits-ordinal-feminine:",
+		"-x: −>>;",
+		"0: =#,##0==%%dord-femabbrev=;",
+		"%digits-ordinal:",
+		"0: =%digits-ordinal-masculine=;",
+	},
+	"pt": {
+		"%digits-ordinal-masculine:",
+		"-x: −>>;",
+		"0: =#,##0=º;",
+		"%digits-ordinal-feminine:",
+		"-x: −>>;",
+		"0: =#,##0=ª;",
+		"%digits-ordinal:",
+		"0: =%digits-ordinal-masculine=;",
+	},
+	"bg": {
+		"%%digits-ordinal-masculine-larger-suffix:",
+		"0: тен;",
+		"1: >%%digits-ordinal-masculine-suffix>;",
+		"100: >>;",
+		"%%digits-ordinal-masculine-suffix:",
+		"0: и;",
+		"1: ви;",
+		"2: ри;",
+		"3: ти;",
+		"5: и;",
+		"20: >>;",
+		"100: >%%digits-ordinal-masculine-larger-suffix>;",
+		"1000: >>;",
+		"%digits-ordinal-masculine:",
+		"-x: −>>;",
+		"0: =#,##0=-=%%digits-ordinal-masculine-suffix=;",
+		"%%digits-ordinal-feminine-larger-suffix:",
+		"0: тна;",
+		"1: >%%digits-ordinal-feminine-suffix>;",
+		"100: >>;",
+		"%%digits-ordinal-feminine-suffix:",
+		"0: а;",
+		"1: ва;",
+		"2: ра;",
+		"3: та;",
+		"5: а;",
+		"20: >>;",
+		"100: >%%digits-ordinal-feminine-larger-suffix>;",
+		"1000: >>;",
+		"%digits-ordinal-feminine:",
+		"-x: −>>;",
+		"0: =#,##0=-=%%digits-ordinal-feminine-suffix=;",
+		"%%digits-ordinal-neuter-larger-suffix:",
+		"0: тно;",
+		"1: >%%digits-ordinal-neuter-suffix>;",
+		"100: >>;",
+		"%%digits-ordinal-neuter-suffix:",
+		"0: o;",
+		"1: вo;",
+		"2: рo;",
+		"3: тo;",
+		"5: o;",
+		"20: >>;",
+		"100: >%%digits-ordinal-neuter-larger-suffix>;",
+		"1000: >>;",
+		"%digits-ordinal-neuter:",
+		"-x: −>>;",
+		"0: =#,##0=-=%%digits-ordinal-neuter-suffix=;",
+		"%digits-ordinal:",
+		"0: =%digits-ordinal-masculine=;",
+	},
+	"zh": {
+		"%digits-ordinal:",
+		"-x: 第−>#,##0>;",
+		"0: 第=#,##0=;",
+	},
+}
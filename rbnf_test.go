@@ -0,0 +1,231 @@
+package icu
+
+import "testing"
+
+func TestSpellout(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		tag     Tag
+		n       float64
+		ruleSet string
+		want    string
+	}{
+		{"en", 0, "", "zero"},
+		{"en", 42, "", "forty-two"},
+		{"en", 200, "", "two hundred"},
+		{"en", 1234567, "", "one million two hundred thirty-four thousand five hundred sixty-seven"},
+		{"en", -3.25, "", "minus three point two five"},
+		{"en", 21, "ordinal", "twenty-first"},
+		{"en", 100, "%spellout-ordinal", "one hundredth"},
+		{"en", 1984, "year", "nineteen eighty-four"},
+		{"en", 1905, "year", "nineteen oh-five"},
+		{"en", 2005, "year", "two thousand five"},
+		{"en", 101, "cardinal-verbose", "one hundred and one"},
+		{"en-GB", 42, "", "forty-two"},
+		{"de", 42, "", "zweiundvierzig"},
+		{"de", 1, "cardinal-feminine", "eine"},
+		{"de", 2000000, "", "zwei Millionen"},
+		{"de", 42, "ordinal", "zweiundvierzigste"},
+		{"de", 1984, "year", "neunzehnhundertvierundachtzig"},
+		{"de", 3.5, "", "drei Komma fünf"},
+		{"fr", 71, "", "soixante-et-onze"},
+		{"fr", 80, "", "quatre-vingts"},
+		{"fr", 81, "", "quatre-vingt-un"},
+		{"fr", 21, "cardinal-feminine", "vingt-et-une"},
+		{"fr", 1, "ordinal-masculine", "premier"},
+		{"es", 21, "", "veintiuno"},
+		{"es", 500, "", "quinientos"},
+		{"es", 1, "ordinal-feminine", "primera"},
+		{"es", 3.5, "", "tres coma cinco"},
+		{"it", 21, "", "ventuno"},
+		{"it", 3, "ordinal-masculine", "terzo"},
+		{"pt", 21, "", "vinte e um"},
+		{"pt", 2000000, "", "dois milhões"},
+		{"pt", 1, "cardinal-feminine", "uma"},
+		{"zh", 2023, "year", "二〇二三"},
+		{"zh", 3.14, "", "三点一四"},
+		{"xx", 42, "", "forty-two"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag)+":"+tc.want, func(t *testing.T) {
+			got, err := Spellout(tc.tag, tc.n, tc.ruleSet)
+			if err != nil {
+				t.Fatalf("spellout: %s", err)
+			}
+			if tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSpelloutUnknownRuleSet(t *testing.T) {
+	if _, err := Spellout("en", 1, "%%and"); err == nil {
+		t.Errorf("expected an error for a private rule set")
+	}
+	if _, err := Spellout("en", 1, "cardinal-feminine"); err == nil {
+		t.Errorf("expected an error for an unknown rule set")
+	}
+}
+
+func TestRuleBasedNumberFormat(t *testing.T) {
+	f, err := NewRuleBasedNumberFormat("en", `
+		%count:
+		0: no items;
+		1: one item;
+		2: =#,##0= items;
+		%%digits:
+		0: =0=;
+		%short:
+		-x: ->%%digits>;
+		0: =%%digits=;
+		1000: <<k[ >>>];
+	`)
+	if err != nil {
+		t.Fatalf("parse: %s", err)
+	}
+	if got, want := f.RuleSets(), []string{"%count", "%short"}; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+	testCases := []struct {
+		n       int64
+		ruleSet string
+		want    string
+	}{
+		{0, "%count", "no items"},
+		{1, "%count", "one item"},
+		{1234, "%count", "1,234 items"},
+		{5000, "%short", "5k"},
+		{5001, "%short", "5k 1"},
+		{-5, "%short", "-5"},
+		{7, "", "7"},
+	}
+	for _, tc := range testCases {
+		got, err := f.FormatInt(tc.n, tc.ruleSet)
+		if err != nil {
+			t.Errorf("format %d: %s", tc.n, err)
+		}
+		if tc.want != got {
+			t.Errorf("want: %q, got: %q", tc.want, got)
+		}
+	}
+
+	for _, rules := range []string{
+		"0: zero;",
+		"%a: 0: =%b=;",
+		"%a: 10: ten; 5: five;",
+	} {
+		if _, err := NewRuleBasedNumberFormat("en", rules); err == nil {
+			t.Errorf("expected an error for %q", rules)
+		}
+	}
+}
+
+func TestTranslateSpellout(t *testing.T) {
+	testCases := []struct {
+		name       string
+		tag        Tag
+		message    MessageFormat
+		parameters []Parameter
+		translated string
+	}{
+		{"en", "en", "{n, spellout}", []Parameter{P("n", 42)}, "forty-two"},
+		{"de", "de-DE", "{n, spellout}", []Parameter{P("n", 42)}, "zweiundvierzig"},
+		{"ordinal", "en", "the {n, spellout, ordinal} time", []Parameter{P("n", 3)}, "the third time"},
+		{"rule-set", "fr", "{n, spellout, %spellout-cardinal-feminine}", []Parameter{P("n", 21)}, "vingt-et-une"},
+		{"year", "en", "{n, spellout, year}", []Parameter{P("n", 1999)}, "nineteen ninety-nine"},
+		{"float", "en", "{n, spellout}", []Parameter{P("n", 1.5)}, "one point five"},
+		{"unknown-rule-set", "en", "{n, spellout, bogus}", []Parameter{P("n", 7)}, "7"},
+		{"not-a-number", "en", "{n, spellout}", []Parameter{P("n", "x")}, "x"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Translate(tc.tag, tc.message, tc.parameters...)
+			if err != nil {
+				t.Errorf("parse: %s", err)
+			}
+			if tc.translated != got {
+				t.Errorf("expected: '%s', got: '%s'", tc.translated, got)
+			}
+		})
+	}
+}
+
+func TestFormatOrdinal(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		tag     Tag
+		n       int64
+		ruleSet string
+		want    string
+	}{
+		{"en", 1, "", "1st"},
+		{"en", 2, "", "2nd"},
+		{"en", 3, "", "3rd"},
+		{"en", 4, "", "4th"},
+		{"en", 11, "", "11th"},
+		{"en", 12, "", "12th"},
+		{"en", 13, "", "13th"},
+		{"en", 21, "", "21st"},
+		{"en", 102, "", "102nd"},
+		{"en", 1001, "", "1,001st"},
+		{"en", -1, "", "−1st"},
+		{"de", 1, "", "1."},
+		{"de", 1234, "", "1.234."},
+		{"fr", 1, "", "1er"},
+		{"fr", 2, "", "2e"},
+		{"fr", 1, "feminine", "1re"},
+		{"fr", 2, "%digits-ordinal-masculine-plural", "2es"},
+		{"es", 1, "", "1.º"},
+		{"es", 1, "feminine", "1.ª"},
+		{"es", 3, "masculine-adjective", "3.ᵉʳ"},
+		{"it", 1, "", "1º"},
+		{"it", 1, "feminine", "1ª"},
+		{"pt", 1, "", "1º"},
+		{"pt", 2, "feminine", "2ª"},
+		{"bg", 2, "", "2-ри"},
+		{"bg", 100, "feminine", "100-тна"},
+		{"zh", 3, "", "第3"},
+		{"xx", 3, "", "3."},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag)+":"+tc.want, func(t *testing.T) {
+			got, err := FormatOrdinal(tc.tag, tc.n, tc.ruleSet)
+			if err != nil {
+				t.Fatalf("ordinal: %s", err)
+			}
+			if tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTranslateOrdinal(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		name       string
+		tag        Tag
+		message    MessageFormat
+		parameters []Parameter
+		translated string
+	}{
+		{"en", "en-US", "You finished {place, ordinal}!", []Parameter{P("place", 22)}, "You finished 22nd!"},
+		{"de", "de", "Du bist {place, ordinal}", []Parameter{P("place", 3)}, "Du bist 3."},
+		{"fr:feminine", "fr", "la {place, ordinal, feminine} place", []Parameter{P("place", 1)}, "la 1re place"},
+		{"pt:feminine", "pt-BR", "{place, ordinal, feminine}", []Parameter{P("place", 1)}, "1ª"},
+		{"unknown-rule-set", "en", "{place, ordinal, neuter}", []Parameter{P("place", 1)}, "1"},
+		{"not-a-number", "en", "{place, ordinal}", []Parameter{P("place", "first")}, "first"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Translate(tc.tag, tc.message, tc.parameters...)
+			if err != nil {
+				t.Errorf("parse: %s", err)
+			}
+			if tc.translated != got {
+				t.Errorf("expected: '%s', got: '%s'", tc.translated, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package icu
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Width selects the length of localized names and patterns.
+type Width int
+
+const (
+	WidthLong Width = iota
+	WidthShort
+	WidthNarrow
+)
+
+func (w Width) suffix() string {
+	switch w {
+	case WidthShort:
+		return "-short"
+	case WidthNarrow:
+		return "-narrow"
+	}
+	return ""
+}
+
+// RelativeTimeUnit is the unit in which FormatRelativeTime expresses a value.
+type RelativeTimeUnit string
+
+const (
+	RelativeYear    RelativeTimeUnit = "year"
+	RelativeQuarter RelativeTimeUnit = "quarter"
+	RelativeMonth   RelativeTimeUnit = "month"
+	RelativeWeek    RelativeTimeUnit = "week"
+	RelativeDay     RelativeTimeUnit = "day"
+	RelativeHour    RelativeTimeUnit = "hour"
+	RelativeMinute  RelativeTimeUnit = "minute"
+	RelativeSecond  RelativeTimeUnit = "second"
+)
+
+// RelativeTimeStyle configures FormatRelativeTime.
+type RelativeTimeStyle struct {
+	Width Width
+	// Auto uses phrases like "yesterday" instead of "1 day ago" where the
+	// locale has them.
+	Auto bool
+}
+
+type relativeTimePatterns struct {
+	relative map[int]string
+	future   map[string]string
+	past     map[string]string
+}
+
+// FormatRelativeTime formats value units relative to the present, e.g.
+// "3 days ago" for -3 days or "in 2 hours" for 2 hours.
+func FormatRelativeTime(tag Tag, value int, unit RelativeTimeUnit, style RelativeTimeStyle) string {
+	tag = baseLocale(tag)
+	t, ok := lookupLocale(tag, func(key string) bool { _, ok := relativeTimeData[key]; return ok })
+	if !ok {
+		tag, t = TagEn, TagEn
+	}
+	ps := relativeTimeData[string(t)]
+	p, ok := ps[string(unit)+style.Width.suffix()]
+	if !ok {
+		return formatInteger(tag, value) + " " + string(unit)
+	}
+	if style.Auto {
+		if s, ok := p.relative[value]; ok {
+			return s
+		}
+	}
+	patterns, n := p.future, value
+	if value < 0 {
+		patterns, n = p.past, -value
+	}
+	pattern, ok := patterns[cardinalToCategory(tag, n)]
+	if !ok {
+		pattern = patterns[other]
+	}
+	return strings.Replace(pattern, "{0}", formatInteger(tag, n), 1)
+}
+
+// relativeTimeUnits lists the units selected automatically, with years and
+// months of average Gregorian length.
+var relativeTimeUnits = []struct {
+	unit RelativeTimeUnit
+	size time.Duration
+}{
+	{RelativeYear, 31556952 * time.Second},
+	{RelativeMonth, 2629746 * time.Second},
+	{RelativeWeek, 7 * 24 * time.Hour},
+	{RelativeDay, 24 * time.Hour},
+	{RelativeHour, time.Hour},
+	{RelativeMinute, time.Minute},
+	{RelativeSecond, time.Second},
+}
+
+// relativeTimeValue expresses d in unit. If unit is empty, the largest unit
+// of which d amounts to at least one is selected.
+func relativeTimeValue(d time.Duration, unit RelativeTimeUnit) (int, RelativeTimeUnit) {
+	if unit == "" {
+		unit = RelativeSecond
+		abs := d
+		if abs < 0 {
+			abs = -abs
+		}
+		for _, u := range relativeTimeUnits {
+			if abs >= u.size {
+				unit = u.unit
+				break
+			}
+		}
+	}
+	size := time.Second
+	switch unit {
+	case RelativeQuarter:
+		size = 3 * 2629746 * time.Second
+	default:
+		for _, u := range relativeTimeUnits {
+			if u.unit == unit {
+				size = u.size
+			}
+		}
+	}
+	return int(math.Round(float64(d) / float64(size))), unit
+}
+
+const nowParameter = "$now"
+
+// nodeFormatRelativeTime formats time.Time values relative to the "$now"
+// parameter or the current time, time.Duration values as offsets from the
+// present and numbers either in the unit given in the style or as seconds.
+type nodeFormatRelativeTime struct {
+	key   string
+	style string
+}
+
+func (n nodeFormatRelativeTime) translate(ctx *context) string {
+	v, ok := ctx.values[n.key]
+	if !ok {
+		return ""
+	}
+	var style RelativeTimeStyle
+	var unit RelativeTimeUnit
+	for _, s := range strings.Fields(n.style) {
+		switch s {
+		case "auto":
+			style.Auto = true
+		case "numeric":
+			style.Auto = false
+		case styleLong:
+			style.Width = WidthLong
+		case styleShort:
+			style.Width = WidthShort
+		case "narrow":
+			style.Width = WidthNarrow
+		default:
+			unit = RelativeTimeUnit(s)
+		}
+	}
+	var d time.Duration
+	switch v := v.(type) {
+	case time.Time:
+		now, ok := ctx.values[nowParameter].(time.Time)
+		if !ok {
+			now = time.Now()
+		}
+		d = v.Sub(now)
+	case time.Duration:
+		d = v
+	default:
+		i, ok := toInt(v)
+		if !ok {
+			return fmt.Sprintf("%v", v)
+		}
+		if unit != "" {
+			return FormatRelativeTime(ctx.tag, i, unit, style)
+		}
+		d = time.Duration(i) * time.Second
+	}
+	value, unit := relativeTimeValue(d, unit)
+	return FormatRelativeTime(ctx.tag, value, unit, style)
+}
+
+// toInt converts numeric values to int, rounding floating point values.
+func toInt(v interface{}) (int, bool) {
+	switch v := v.(type) {
+	case int:
+		return v, true
+	case int8:
+		return int(v), true
+	case int16:
+		return int(v), true
+	case int32:
+		return int(v), true
+	case int64:
+		return int(v), true
+	case uint:
+		return int(v), true
+	case uint8:
+		return int(v), true
+	case uint16:
+		return int(v), true
+	case uint32:
+		return int(v), true
+	case uint64:
+		return int(v), true
+	case float32:
+		return int(math.Round(float64(v))), true
+	case float64:
+		return int(math.Round(v)), true
+	}
+	return 0, false
+}
@@ -0,0 +1,982 @@
+package icu
+
+// Relative time patterns, maintained by hand after CLDR 48 for the languages
+// listed here. Others use the English patterns.
+var relativeTimeData = map[string]map[string]*relativeTimePatterns{
+	"en": {
+		"year": {
+			relative: map[int]string{-1: "last year", 0: "this year", 1: "next year"},
+			future:   map[string]string{"one": "in {0} year", "other": "in {0} years"},
+			past:     map[string]string{"one": "{0} year ago", "other": "{0} years ago"},
+		},
+		"year-short": {
+			relative: map[int]string{-1: "last yr.", 0: "this yr.", 1: "next yr."},
+			future:   map[string]string{"one": "in {0} yr.", "other": "in {0} yr."},
+			past:     map[string]string{"one": "{0} yr. ago", "other": "{0} yr. ago"},
+		},
+		"year-narrow": {
+			relative: map[int]string{-1: "last yr.", 0: "this yr.", 1: "next yr."},
+			future:   map[string]string{"one": "in {0}y", "other": "in {0}y"},
+			past:     map[string]string{"one": "{0}y ago", "other": "{0}y ago"},
+		},
+		"quarter": {
+			relative: map[int]string{-1: "last quarter", 0: "this quarter", 1: "next quarter"},
+			future:   map[string]string{"one": "in {0} quarter", "other": "in {0} quarters"},
+			past:     map[string]string{"one": "{0} quarter ago", "other": "{0} quarters ago"},
+		},
+		"quarter-short": {
+			relative: map[int]string{-1: "last qtr.", 0: "this qtr.", 1: "next qtr."},
+			future:   map[string]string{"one": "in {0} qtr.", "other": "in {0} qtrs."},
+			past:     map[string]string{"one": "{0} qtr. ago", "other": "{0} qtrs. ago"},
+		},
+		"quarter-narrow": {
+			relative: map[int]string{-1: "last qtr.", 0: "this qtr.", 1: "next qtr."},
+			future:   map[string]string{"one": "in {0}q", "other": "in {0}q"},
+			past:     map[string]string{"one": "{0}q ago", "other": "{0}q ago"},
+		},
+		"month": {
+			relative: map[int]string{-1: "last month", 0: "this month", 1: "next month"},
+			future:   map[string]string{"one": "in {0} month", "other": "in {0} months"},
+			past:     map[string]string{"one": "{0} month ago", "other": "{0} months ago"},
+		},
+		"month-short": {
+			relative: map[int]string{-1: "last mo.", 0: "this mo.", 1: "next mo."},
+			future:   map[string]string{"one": "in {0} mo.", "other": "in {0} mo."},
+			past:     map[string]string{"one": "{0} mo. ago", "other": "{0} mo. ago"},
+		},
+		"month-narrow": {
+			relative: map[int]string{-1: "last mo.", 0: "this mo.", 1: "next mo."},
+			future:   map[string]string{"one": "in {0}mo", "other": "in {0}mo"},
+			past:     map[string]string{"one": "{0}mo ago", "other": "{0}mo ago"},
+		},
+		"week": {
+			relative: map[int]string{-1: "last week", 0: "this week", 1: "next week"},
+			future:   map[string]string{"one": "in {0} week", "other": "in {0} weeks"},
+			past:     map[string]string{"one": "{0} week ago", "other": "{0} weeks ago"},
+		},
+		"week-short": {
+			relative: map[int]string{-1: "last wk.", 0: "this wk.", 1: "next wk."},
+			future:   map[string]string{"one": "in {0} wk.", "other": "in {0} wk."},
+			past:     map[string]string{"one": "{0} wk. ago", "other": "{0} wk. ago"},
+		},
+		"week-narrow": {
+			relative: map[int]string{-1: "last wk.", 0: "this wk.", 1: "next wk."},
+			future:   map[string]string{"one": "in {0}w", "other": "in {0}w"},
+			past:     map[string]string{"one": "{0}w ago", "other": "{0}w ago"},
+		},
+		"day": {
+			relative: map[int]string{-1: "yesterday", 0: "today", 1: "tomorrow"},
+			future:   map[string]string{"one": "in {0} day", "other": "in {0} days"},
+			past:     map[string]string{"one": "{0} day ago", "other": "{0} days ago"},
+		},
+		"day-short": {
+			relative: map[int]string{-1: "yesterday", 0: "today", 1: "tomorrow"},
+			future:   map[string]string{"one": "in {0} day", "other": "in {0} days"},
+			past:     map[string]string{"one": "{0} day ago", "other": "{0} days ago"},
+		},
+		"day-narrow": {
+			relative: map[int]string{-1: "yesterday", 0: "today", 1: "tomorrow"},
+			future:   map[string]string{"one": "in {0}d", "other": "in {0}d"},
+			past:     map[string]string{"one": "{0}d ago", "other": "{0}d ago"},
+		},
+		"hour": {
+			relative: map[int]string{0: "this hour"},
+			future:   map[string]string{"one": "in {0} hour", "other": "in {0} hours"},
+			past:     map[string]string{"one": "{0} hour ago", "other": "{0} hours ago"},
+		},
+		"hour-short": {
+			relative: map[int]string{0: "this hour"},
+			future:   map[string]string{"one": "in {0} hr.", "other": "in {0} hr."},
+			past:     map[string]string{"one": "{0} hr. ago", "other": "{0} hr. ago"},
+		},
+		"hour-narrow": {
+			relative: map[int]string{0: "this hour"},
+			future:   map[string]string{"one": "in {0}h", "other": "in {0}h"},
+			past:     map[string]string{"one": "{0}h ago", "other": "{0}h ago"},
+		},
+		"minute": {
+			relative: map[int]string{0: "this minute"},
+			future:   map[string]string{"one": "in {0} minute", "other": "in {0} minutes"},
+			past:     map[string]string{"one": "{0} minute ago", "other": "{0} minutes ago"},
+		},
+		"minute-short": {
+			relative: map[int]string{0: "this minute"},
+			future:   map[string]string{"one": "in {0} min.", "other": "in {0} min."},
+			past:     map[string]string{"one": "{0} min. ago", "other": "{0} min. ago"},
+		},
+		"minute-narrow": {
+			relative: map[int]string{0: "this minute"},
+			future:   map[string]string{"one": "in {0}m", "other": "in {0}m"},
+			past:     map[string]string{"one": "{0}m ago", "other": "{0}m ago"},
+		},
+		"second": {
+			relative: map[int]string{0: "now"},
+			future:   map[string]string{"one": "in {0} second", "other": "in {0} seconds"},
+			past:     map[string]string{"one": "{0} second ago", "other": "{0} seconds ago"},
+		},
+		"second-short": {
+			relative: map[int]string{0: "now"},
+			future:   map[string]string{"one": "in {0} sec.", "other": "in {0} sec."},
+			past:     map[string]string{"one": "{0} sec. ago", "other": "{0} sec. ago"},
+		},
+		"second-narrow": {
+			relative: map[int]string{0: "now"},
+			future:   map[string]string{"one": "in {0}s", "other": "in {0}s"},
+			past:     map[string]string{"one": "{0}s ago", "other": "{0}s ago"},
+		},
+	},
+	"de": {
+		"year": {
+			relative: map[int]string{-1: "letztes Jahr", 0: "dieses Jahr", 1: "nächstes Jahr"},
+			future:   map[string]string{"one": "in {0} Jahr", "other": "in {0} Jahren"},
+			past:     map[string]string{"one": "vor {0} Jahr", "other": "vor {0} Jahren"},
+		},
+		"year-short": {
+			relative: map[int]string{-1: "letztes Jahr", 0: "dieses Jahr", 1: "nächstes Jahr"},
+			future:   map[string]string{"one": "in {0} Jahr", "other": "in {0} Jahren"},
+			past:     map[string]string{"one": "vor {0} Jahr", "other": "vor {0} Jahren"},
+		},
+		"year-narrow": {
+			relative: map[int]string{-1: "letztes Jahr", 0: "dieses Jahr", 1: "nächstes Jahr"},
+			future:   map[string]string{"one": "in {0} Jahr", "other": "in {0} Jahren"},
+			past:     map[string]string{"one": "vor {0} Jahr", "other": "vor {0} Jahren"},
+		},
+		"quarter": {
+			relative: map[int]string{-1: "letztes Quartal", 0: "dieses Quartal", 1: "nächstes Quartal"},
+			future:   map[string]string{"one": "in {0} Quartal", "other": "in {0} Quartalen"},
+			past:     map[string]string{"one": "vor {0} Quartal", "other": "vor {0} Quartalen"},
+		},
+		"quarter-short": {
+			relative: map[int]string{-1: "letztes Quartal", 0: "dieses Quartal", 1: "nächstes Quartal"},
+			future:   map[string]string{"one": "in {0} Quart.", "other": "in {0} Quart."},
+			past:     map[string]string{"one": "vor {0} Quart.", "other": "vor {0} Quart."},
+		},
+		"quarter-narrow": {
+			relative: map[int]string{-1: "letztes Quartal", 0: "dieses Quartal", 1: "nächstes Quartal"},
+			future:   map[string]string{"one": "in {0} Q", "other": "in {0} Q"},
+			past:     map[string]string{"one": "vor {0} Q", "other": "vor {0} Q"},
+		},
+		"month": {
+			relative: map[int]string{-1: "letzten Monat", 0: "diesen Monat", 1: "nächsten Monat"},
+			future:   map[string]string{"one": "in {0} Monat", "other": "in {0} Monaten"},
+			past:     map[string]string{"one": "vor {0} Monat", "other": "vor {0} Monaten"},
+		},
+		"month-short": {
+			relative: map[int]string{-1: "letzten Monat", 0: "diesen Monat", 1: "nächsten Monat"},
+			future:   map[string]string{"one": "in {0} Monat", "other": "in {0} Monaten"},
+			past:     map[string]string{"one": "vor {0} Monat", "other": "vor {0}\u00a0Monaten"},
+		},
+		"month-narrow": {
+			relative: map[int]string{-1: "letzten Monat", 0: "diesen Monat", 1: "nächsten Monat"},
+			future:   map[string]string{"one": "in {0} Monat", "other": "in {0} Monaten"},
+			past:     map[string]string{"one": "vor {0}\u00a0Monat", "other": "vor {0} Monaten"},
+		},
+		"week": {
+			relative: map[int]string{-1: "letzte Woche", 0: "diese Woche", 1: "nächste Woche"},
+			future:   map[string]string{"one": "in {0} Woche", "other": "in {0} Wochen"},
+			past:     map[string]string{"one": "vor {0} Woche", "other": "vor {0} Wochen"},
+		},
+		"week-short": {
+			relative: map[int]string{-1: "letzte Woche", 0: "diese Woche", 1: "nächste Woche"},
+			future:   map[string]string{"one": "in {0} Woche", "other": "in {0} Wochen"},
+			past:     map[string]string{"one": "vor {0} Woche", "other": "vor {0} Wochen"},
+		},
+		"week-narrow": {
+			relative: map[int]string{-1: "letzte Woche", 0: "diese Woche", 1: "nächste Woche"},
+			future:   map[string]string{"one": "in {0} Wo.", "other": "in {0} Wo."},
+			past:     map[string]string{"one": "vor {0} Wo.", "other": "vor {0} Wo."},
+		},
+		"day": {
+			relative: map[int]string{-2: "vorgestern", -1: "gestern", 0: "heute", 1: "morgen", 2: "übermorgen"},
+			future:   map[string]string{"one": "in {0} Tag", "other": "in {0} Tagen"},
+			past:     map[string]string{"one": "vor {0} Tag", "other": "vor {0} Tagen"},
+		},
+		"day-short": {
+			relative: map[int]string{-2: "vorgestern", -1: "gestern", 0: "heute", 1: "morgen", 2: "übermorgen"},
+			future:   map[string]string{"one": "in {0} Tag", "other": "in {0} Tagen"},
+			past:     map[string]string{"one": "vor {0} Tag", "other": "vor {0} Tagen"},
+		},
+		"day-narrow": {
+			relative: map[int]string{-2: "vorgestern", -1: "gestern", 0: "heute", 1: "morgen", 2: "übermorgen"},
+			future:   map[string]string{"one": "in {0} Tag", "other": "in {0} Tagen"},
+			past:     map[string]string{"one": "vor {0} Tag", "other": "vor {0} Tagen"},
+		},
+		"hour": {
+			relative: map[int]string{0: "in dieser Stunde"},
+			future:   map[string]string{"one": "in {0} Stunde", "other": "in {0} Stunden"},
+			past:     map[string]string{"one": "vor {0} Stunde", "other": "vor {0} Stunden"},
+		},
+		"hour-short": {
+			relative: map[int]string{0: "in dieser Stunde"},
+			future:   map[string]string{"one": "in {0} Std.", "other": "in {0} Std."},
+			past:     map[string]string{"one": "vor {0} Std.", "other": "vor {0} Std."},
+		},
+		"hour-narrow": {
+			relative: map[int]string{0: "in dieser Stunde"},
+			future:   map[string]string{"one": "in {0} Std.", "other": "in {0} Std."},
+			past:     map[string]string{"one": "vor {0} Std.", "other": "vor {0} Std."},
+		},
+		"minute": {
+			relative: map[int]string{0: "in dieser Minute"},
+			future:   map[string]string{"one": "in {0} Minute", "other": "in {0} Minuten"},
+			past:     map[string]string{"one": "vor {0} Minute", "other": "vor {0} Minuten"},
+		},
+		"minute-short": {
+			relative: map[int]string{0: "in dieser Minute"},
+			future:   map[string]string{"one": "in {0} Min.", "other": "in {0} Min."},
+			past:     map[string]string{"one": "vor {0} Min.", "other": "vor {0} Min."},
+		},
+		"minute-narrow": {
+			relative: map[int]string{0: "in dieser Minute"},
+			future:   map[string]string{"one": "in {0} m", "other": "in {0} m"},
+			past:     map[string]string{"one": "vor {0} m", "other": "vor {0} m"},
+		},
+		"second": {
+			relative: map[int]string{0: "jetzt"},
+			future:   map[string]string{"one": "in {0} Sekunde", "other": "in {0} Sekunden"},
+			past:     map[string]string{"one": "vor {0} Sekunde", "other": "vor {0} Sekunden"},
+		},
+		"second-short": {
+			relative: map[int]string{0: "jetzt"},
+			future:   map[string]string{"one": "in {0} Sek.", "other": "in {0} Sek."},
+			past:     map[string]string{"one": "vor {0} Sek.", "other": "vor {0} Sek."},
+		},
+		"second-narrow": {
+			relative: map[int]string{0: "jetzt"},
+			future:   map[string]string{"one": "in {0} s", "other": "in {0} s"},
+			past:     map[string]string{"one": "vor {0} s", "other": "vor {0} s"},
+		},
+	},
+	"fr": {
+		"year": {
+			relative: map[int]string{-1: "l’année dernière", 0: "cette année", 1: "l’année prochaine"},
+			future:   map[string]string{"one": "dans {0} an", "other": "dans {0} ans"},
+			past:     map[string]string{"one": "il y a {0} an", "other": "il y a {0} ans"},
+		},
+		"year-short": {
+			relative: map[int]string{-1: "l’an dernier", 0: "cette année", 1: "l’an prochain"},
+			future:   map[string]string{"one": "dans {0} a", "other": "dans {0} a"},
+			past:     map[string]string{"one": "il y a {0} a", "other": "il y a {0} a"},
+		},
+		"year-narrow": {
+			relative: map[int]string{-1: "l’an dernier", 0: "cette année", 1: "l’an prochain"},
+			future:   map[string]string{"one": "+{0} a", "other": "+{0} a"},
+			past:     map[string]string{"one": "-{0} a", "other": "-{0} a"},
+		},
+		"quarter": {
+			relative: map[int]string{-1: "le trimestre dernier", 0: "ce trimestre", 1: "le trimestre prochain"},
+			future:   map[string]string{"one": "dans {0} trimestre", "other": "dans {0} trimestres"},
+			past:     map[string]string{"one": "il y a {0} trimestre", "other": "il y a {0} trimestres"},
+		},
+		"quarter-short": {
+			relative: map[int]string{-1: "le trimestre dernier", 0: "ce trimestre", 1: "le trimestre prochain"},
+			future:   map[string]string{"one": "dans {0} trim.", "other": "dans {0} trim."},
+			past:     map[string]string{"one": "il y a {0} trim.", "other": "il y a {0} trim."},
+		},
+		"quarter-narrow": {
+			relative: map[int]string{-1: "le trimestre dernier", 0: "ce trimestre", 1: "le trimestre prochain"},
+			future:   map[string]string{"one": "+{0} trim.", "other": "+{0} trim."},
+			past:     map[string]string{"one": "-{0} trim.", "other": "-{0} trim."},
+		},
+		"month": {
+			relative: map[int]string{-1: "le mois dernier", 0: "ce mois-ci", 1: "le mois prochain"},
+			future:   map[string]string{"one": "dans {0} mois", "other": "dans {0} mois"},
+			past:     map[string]string{"one": "il y a {0} mois", "other": "il y a {0} mois"},
+		},
+		"month-short": {
+			relative: map[int]string{-1: "le mois dernier", 0: "ce mois-ci", 1: "le mois prochain"},
+			future:   map[string]string{"one": "dans {0} m.", "other": "dans {0} m."},
+			past:     map[string]string{"one": "il y a {0} m.", "other": "il y a {0} m."},
+		},
+		"month-narrow": {
+			relative: map[int]string{-1: "le mois dernier", 0: "ce mois-ci", 1: "le mois prochain"},
+			future:   map[string]string{"one": "+{0} m.", "other": "+{0} m."},
+			past:     map[string]string{"one": "-{0} m.", "other": "-{0} m."},
+		},
+		"week": {
+			relative: map[int]string{-1: "la semaine dernière", 0: "cette semaine", 1: "la semaine prochaine"},
+			future:   map[string]string{"one": "dans {0} semaine", "other": "dans {0} semaines"},
+			past:     map[string]string{"one": "il y a {0} semaine", "other": "il y a {0} semaines"},
+		},
+		"week-short": {
+			relative: map[int]string{-1: "la semaine dernière", 0: "cette semaine", 1: "la semaine prochaine"},
+			future:   map[string]string{"one": "dans {0} sem.", "other": "dans {0} sem."},
+			past:     map[string]string{"one": "il y a {0} sem.", "other": "il y a {0} sem."},
+		},
+		"week-narrow": {
+			relative: map[int]string{-1: "la semaine dernière", 0: "cette semaine", 1: "la semaine prochaine"},
+			future:   map[string]string{"one": "+{0} sem.", "other": "+{0} sem."},
+			past:     map[string]string{"one": "-{0} sem.", "other": "-{0} sem."},
+		},
+		"day": {
+			relative: map[int]string{-2: "avant-hier", -1: "hier", 0: "aujourd’hui", 1: "demain", 2: "après-demain"},
+			future:   map[string]string{"one": "dans {0} jour", "other": "dans {0} jours"},
+			past:     map[string]string{"one": "il y a {0} jour", "other": "il y a {0} jours"},
+		},
+		"day-short": {
+			relative: map[int]string{-2: "avant-hier", -1: "hier", 0: "aujourd’hui", 1: "demain", 2: "après-demain"},
+			future:   map[string]string{"one": "dans {0}\u00a0j", "other": "dans {0}\u00a0j"},
+			past:     map[string]string{"one": "il y a {0}\u00a0j", "other": "il y a {0}\u00a0j"},
+		},
+		"day-narrow": {
+			relative: map[int]string{-2: "avant-hier", -1: "hier", 0: "aujourd’hui", 1: "demain", 2: "après-demain"},
+			future:   map[string]string{"one": "+{0} j", "other": "+{0} j"},
+			past:     map[string]string{"one": "-{0} j", "other": "-{0} j"},
+		},
+		"hour": {
+			relative: map[int]string{0: "cette heure-ci"},
+			future:   map[string]string{"one": "dans {0} heure", "other": "dans {0} heures"},
+			past:     map[string]string{"one": "il y a {0} heure", "other": "il y a {0} heures"},
+		},
+		"hour-short": {
+			relative: map[int]string{0: "cette heure-ci"},
+			future:   map[string]string{"one": "dans {0}\u00a0h", "other": "dans {0}\u00a0h"},
+			past:     map[string]string{"one": "il y a {0}\u00a0h", "other": "il y a {0}\u00a0h"},
+		},
+		"hour-narrow": {
+			relative: map[int]string{0: "cette heure-ci"},
+			future:   map[string]string{"one": "+{0} h", "other": "+{0} h"},
+			past:     map[string]string{"one": "-{0} h", "other": "-{0} h"},
+		},
+		"minute": {
+			relative: map[int]string{0: "cette minute-ci"},
+			future:   map[string]string{"one": "dans {0} minute", "other": "dans {0} minutes"},
+			past:     map[string]string{"one": "il y a {0} minute", "other": "il y a {0} minutes"},
+		},
+		"minute-short": {
+			relative: map[int]string{0: "cette minute-ci"},
+			future:   map[string]string{"one": "dans {0}\u00a0min", "other": "dans {0}\u00a0min"},
+			past:     map[string]string{"one": "il y a {0}\u00a0min", "other": "il y a {0}\u00a0min"},
+		},
+		"minute-narrow": {
+			relative: map[int]string{0: "cette minute-ci"},
+			future:   map[string]string{"one": "+{0} min", "other": "+{0} min"},
+			past:     map[string]string{"one": "-{0} min", "other": "-{0} min"},
+		},
+		"second": {
+			relative: map[int]string{0: "maintenant"},
+			future:   map[string]string{"one": "dans {0} seconde", "other": "dans {0} secondes"},
+			past:     map[string]string{"one": "il y a {0} seconde", "other": "il y a {0} secondes"},
+		},
+		"second-short": {
+			relative: map[int]string{0: "maintenant"},
+			future:   map[string]string{"one": "dans {0}\u00a0s", "other": "dans {0}\u00a0s"},
+			past:     map[string]string{"one": "il y a {0}\u00a0s", "other": "il y a {0}\u00a0s"},
+		},
+		"second-narrow": {
+			relative: map[int]string{0: "maintenant"},
+			future:   map[string]string{"one": "+{0} s", "other": "+{0} s"},
+			past:     map[string]string{"one": "-{0} s", "other": "-{0} s"},
+		},
+	},
+	"es": {
+		"year": {
+			relative: map[int]string{-1: "el año pasado", 0: "este año", 1: "el próximo año"},
+			future:   map[string]string{"one": "dentro de {0} año", "other": "dentro de {0} años"},
+			past:     map[string]string{"one": "hace {0} año", "other": "hace {0} años"},
+		},
+		"year-short": {
+			relative: map[int]string{-1: "el año pasado", 0: "este año", 1: "el próximo año"},
+			future:   map[string]string{"one": "dentro de {0} a", "other": "dentro de {0} a"},
+			past:     map[string]string{"one": "hace {0} a", "other": "hace {0} a"},
+		},
+		"year-narrow": {
+			relative: map[int]string{-1: "el año pasado", 0: "este año", 1: "el próximo año"},
+			future:   map[string]string{"one": "dentro de {0} a", "other": "dentro de {0} a"},
+			past:     map[string]string{"one": "hace {0} a", "other": "hace {0} a"},
+		},
+		"quarter": {
+			relative: map[int]string{-1: "el trimestre pasado", 0: "este trimestre", 1: "el próximo trimestre"},
+			future:   map[string]string{"one": "dentro de {0} trimestre", "other": "dentro de {0} trimestres"},
+			past:     map[string]string{"one": "hace {0} trimestre", "other": "hace {0} trimestres"},
+		},
+		"quarter-short": {
+			relative: map[int]string{-1: "el trimestre pasado", 0: "este trimestre", 1: "el próximo trimestre"},
+			future:   map[string]string{"one": "dentro de {0} trim.", "other": "dentro de {0} trim."},
+			past:     map[string]string{"one": "hace {0} trim.", "other": "hace {0} trim."},
+		},
+		"quarter-narrow": {
+			relative: map[int]string{-1: "el trimestre pasado", 0: "este trimestre", 1: "el próximo trimestre"},
+			future:   map[string]string{"one": "dentro de {0} trim.", "other": "dentro de {0} trim."},
+			past:     map[string]string{"one": "hace {0} trim.", "other": "hace {0} trim."},
+		},
+		"month": {
+			relative: map[int]string{-1: "el mes pasado", 0: "este mes", 1: "el próximo mes"},
+			future:   map[string]string{"one": "dentro de {0} mes", "other": "dentro de {0} meses"},
+			past:     map[string]string{"one": "hace {0} mes", "other": "hace {0} meses"},
+		},
+		"month-short": {
+			relative: map[int]string{-1: "el mes pasado", 0: "este mes", 1: "el próximo mes"},
+			future:   map[string]string{"one": "dentro de {0} m", "other": "dentro de {0} m"},
+			past:     map[string]string{"one": "hace {0} m", "other": "hace {0} m"},
+		},
+		"month-narrow": {
+			relative: map[int]string{-1: "el mes pasado", 0: "este mes", 1: "el próximo mes"},
+			future:   map[string]string{"one": "dentro de {0} m", "other": "dentro de {0} m"},
+			past:     map[string]string{"one": "hace {0} m", "other": "hace {0} m"},
+		},
+		"week": {
+			relative: map[int]string{-1: "la semana pasada", 0: "esta semana", 1: "la próxima semana"},
+			future:   map[string]string{"one": "dentro de {0} semana", "other": "dentro de {0} semanas"},
+			past:     map[string]string{"one": "hace {0} semana", "other": "hace {0} semanas"},
+		},
+		"week-short": {
+			relative: map[int]string{-1: "sem. ant.", 0: "esta sem.", 1: "próx. sem."},
+			future:   map[string]string{"one": "dentro de {0} sem.", "other": "dentro de {0} sem."},
+			past:     map[string]string{"one": "hace {0} sem.", "other": "hace {0} sem."},
+		},
+		"week-narrow": {
+			relative: map[int]string{-1: "sem. ant.", 0: "esta sem.", 1: "próx. sem."},
+			future:   map[string]string{"one": "dentro de {0} sem.", "other": "dentro de {0} sem."},
+			past:     map[string]string{"one": "hace {0} sem.", "other": "hace {0} sem."},
+		},
+		"day": {
+			relative: map[int]string{-2: "anteayer", -1: "ayer", 0: "hoy", 1: "mañana", 2: "pasado mañana"},
+			future:   map[string]string{"one": "dentro de {0} día", "other": "dentro de {0} días"},
+			past:     map[string]string{"one": "hace {0} día", "other": "hace {0} días"},
+		},
+		"day-short": {
+			relative: map[int]string{-2: "anteayer", -1: "ayer", 0: "hoy", 1: "mañana", 2: "pasado mañana"},
+			future:   map[string]string{"one": "dentro de {0} d", "other": "dentro de {0} d"},
+			past:     map[string]string{"one": "hace {0} d", "other": "hace {0} d"},
+		},
+		"day-narrow": {
+			relative: map[int]string{-2: "anteayer", -1: "ayer", 0: "hoy", 1: "mañana", 2: "pasado mañana"},
+			future:   map[string]string{"one": "dentro de {0} d", "other": "dentro de {0} d"},
+			past:     map[string]string{"one": "hace {0} d", "other": "hace {0} d"},
+		},
+		"hour": {
+			relative: map[int]string{0: "esta hora"},
+			future:   map[string]string{"one": "dentro de {0} hora", "other": "dentro de {0} horas"},
+			past:     map[string]string{"one": "hace {0} hora", "other": "hace {0} horas"},
+		},
+		"hour-short": {
+			relative: map[int]string{0: "esta hora"},
+			future:   map[string]string{"one": "dentro de {0} h", "other": "dentro de {0} h"},
+			past:     map[string]string{"one": "hace {0} h", "other": "hace {0} h"},
+		},
+		"hour-narrow": {
+			relative: map[int]string{0: "esta hora"},
+			future:   map[string]string{"one": "dentro de {0} h", "other": "dentro de {0} h"},
+			past:     map[string]string{"one": "hace {0} h", "other": "hace {0} h"},
+		},
+		"minute": {
+			relative: map[int]string{0: "este minuto"},
+			future:   map[string]string{"one": "dentro de {0} minuto", "other": "dentro de {0} minutos"},
+			past:     map[string]string{"one": "hace {0} minuto", "other": "hace {0} minutos"},
+		},
+		"minute-short": {
+			relative: map[int]string{0: "este minuto"},
+			future:   map[string]string{"one": "dentro de {0} min", "other": "dentro de {0} min"},
+			past:     map[string]string{"one": "hace {0} min", "other": "hace {0} min"},
+		},
+		"minute-narrow": {
+			relative: map[int]string{0: "este minuto"},
+			future:   map[string]string{"one": "dentro de {0} min", "other": "dentro de {0} min"},
+			past:     map[string]string{"one": "hace {0} min", "other": "hace {0} min"},
+		},
+		"second": {
+			relative: map[int]string{0: "ahora"},
+			future:   map[string]string{"one": "dentro de {0} segundo", "other": "dentro de {0} segundos"},
+			past:     map[string]string{"one": "hace {0} segundo", "other": "hace {0} segundos"},
+		},
+		"second-short": {
+			relative: map[int]string{0: "ahora"},
+			future:   map[string]string{"one": "dentro de {0} s", "other": "dentro de {0} s"},
+			past:     map[string]string{"one": "hace {0} s", "other": "hace {0} s"},
+		},
+		"second-narrow": {
+			relative: map[int]string{0: "ahora"},
+			future:   map[string]string{"one": "dentro de {0} s", "other": "dentro de {0} s"},
+			past:     map[string]string{"one": "hace {0} s", "other": "hace {0} s"},
+		},
+	},
+	"it": {
+		"year": {
+			relative: map[int]string{-1: "anno scorso", 0: "quest’anno", 1: "anno prossimo"},
+			future:   map[string]string{"one": "tra {0} anno", "other": "tra {0} anni"},
+			past:     map[string]string{"one": "{0} anno fa", "other": "{0} anni fa"},
+		},
+		"year-short": {
+			relative: map[int]string{-1: "anno scorso", 0: "quest’anno", 1: "anno prossimo"},
+			future:   map[string]string{"one": "tra {0} anno", "other": "tra {0} anni"},
+			past:     map[string]string{"one": "{0} anno fa", "other": "{0} anni fa"},
+		},
+		"year-narrow": {
+			relative: map[int]string{-1: "anno scorso", 0: "quest’anno", 1: "anno prossimo"},
+			future:   map[string]string{"one": "tra {0} anno", "other": "tra {0} anni"},
+			past:     map[string]string{"one": "{0} anno fa", "other": "{0} anni fa"},
+		},
+		"quarter": {
+			relative: map[int]string{-1: "trimestre scorso", 0: "questo trimestre", 1: "trimestre prossimo"},
+			future:   map[string]string{"one": "tra {0} trimestre", "other": "tra {0} trimestri"},
+			past:     map[string]string{"one": "{0} trimestre fa", "other": "{0} trimestri fa"},
+		},
+		"quarter-short": {
+			relative: map[int]string{-1: "trim. scorso", 0: "questo trim.", 1: "trim. prossimo"},
+			future:   map[string]string{"one": "tra {0} trim.", "other": "tra {0} trim."},
+			past:     map[string]string{"one": "{0} trim. fa", "other": "{0} trim. fa"},
+		},
+		"quarter-narrow": {
+			relative: map[int]string{-1: "trim. scorso", 0: "questo trim.", 1: "trim. prossimo"},
+			future:   map[string]string{"one": "tra {0} trim.", "other": "tra {0} trim."},
+			past:     map[string]string{"one": "{0} trim. fa", "other": "{0} trim. fa"},
+		},
+		"month": {
+			relative: map[int]string{-1: "mese scorso", 0: "questo mese", 1: "mese prossimo"},
+			future:   map[string]string{"one": "tra {0} mese", "other": "tra {0} mesi"},
+			past:     map[string]string{"one": "{0} mese fa", "other": "{0} mesi fa"},
+		},
+		"month-short": {
+			relative: map[int]string{-1: "mese scorso", 0: "questo mese", 1: "mese prossimo"},
+			future:   map[string]string{"one": "tra {0} mese", "other": "tra {0} mesi"},
+			past:     map[string]string{"one": "{0} mese fa", "other": "{0} mesi fa"},
+		},
+		"month-narrow": {
+			relative: map[int]string{-1: "mese scorso", 0: "questo mese", 1: "mese prossimo"},
+			future:   map[string]string{"one": "tra {0} mese", "other": "tra {0} mesi"},
+			past:     map[string]string{"one": "{0} mese fa", "other": "{0} mesi fa"},
+		},
+		"week": {
+			relative: map[int]string{-1: "settimana scorsa", 0: "questa settimana", 1: "settimana prossima"},
+			future:   map[string]string{"one": "tra {0} settimana", "other": "tra {0} settimane"},
+			past:     map[string]string{"one": "{0} settimana fa", "other": "{0} settimane fa"},
+		},
+		"week-short": {
+			relative: map[int]string{-1: "sett. scorsa", 0: "questa sett.", 1: "sett. prossima"},
+			future:   map[string]string{"one": "tra {0} sett.", "other": "tra {0} sett."},
+			past:     map[string]string{"one": "{0} sett. fa", "other": "{0} sett. fa"},
+		},
+		"week-narrow": {
+			relative: map[int]string{-1: "sett. scorsa", 0: "questa sett.", 1: "sett. prossima"},
+			future:   map[string]string{"one": "tra {0} sett.", "other": "tra {0} sett."},
+			past:     map[string]string{"one": "{0} sett. fa", "other": "{0} sett. fa"},
+		},
+		"day": {
+			relative: map[int]string{-2: "l’altro ieri", -1: "ieri", 0: "oggi", 1: "domani", 2: "dopodomani"},
+			future:   map[string]string{"one": "tra {0} giorno", "other": "tra {0} giorni"},
+			past:     map[string]string{"one": "{0} giorno fa", "other": "{0} giorni fa"},
+		},
+		"day-short": {
+			relative: map[int]string{-2: "l’altro ieri", -1: "ieri", 0: "oggi", 1: "domani", 2: "dopodomani"},
+			future:   map[string]string{"one": "tra {0} g", "other": "tra {0} gg"},
+			past:     map[string]string{"one": "{0} g fa", "other": "{0} gg fa"},
+		},
+		"day-narrow": {
+			relative: map[int]string{-2: "l’altro ieri", -1: "ieri", 0: "oggi", 1: "domani", 2: "dopodomani"},
+			future:   map[string]string{"one": "tra {0} g", "other": "tra {0} gg"},
+			past:     map[string]string{"one": "{0} g fa", "other": "{0} gg fa"},
+		},
+		"hour": {
+			relative: map[int]string{0: "quest’ora"},
+			future:   map[string]string{"one": "tra {0} ora", "other": "tra {0} ore"},
+			past:     map[string]string{"one": "{0} ora fa", "other": "{0} ore fa"},
+		},
+		"hour-short": {
+			relative: map[int]string{0: "quest’ora"},
+			future:   map[string]string{"one": "tra {0} h", "other": "tra {0} h"},
+			past:     map[string]string{"one": "{0} h fa", "other": "{0} h fa"},
+		},
+		"hour-narrow": {
+			relative: map[int]string{0: "quest’ora"},
+			future:   map[string]string{"one": "tra {0} h", "other": "tra {0} h"},
+			past:     map[string]string{"one": "{0} h fa", "other": "{0} h fa"},
+		},
+		"minute": {
+			relative: map[int]string{0: "questo minuto"},
+			future:   map[string]string{"one": "tra {0} minuto", "other": "tra {0} minuti"},
+			past:     map[string]string{"one": "{0} minuto fa", "other": "{0} minuti fa"},
+		},
+		"minute-short": {
+			relative: map[int]string{0: "questo minuto"},
+			future:   map[string]string{"one": "tra {0} min", "other": "tra {0} min"},
+			past:     map[string]string{"one": "{0} min fa", "other": "{0} min fa"},
+		},
+		"minute-narrow": {
+			relative: map[int]string{0: "questo minuto"},
+			future:   map[string]string{"one": "tra {0} min", "other": "tra {0} min"},
+			past:     map[string]string{"one": "{0} min fa", "other": "{0} min fa"},
+		},
+		"second": {
+			relative: map[int]string{0: "ora"},
+			future:   map[string]string{"one": "tra {0} secondo", "other": "tra {0} secondi"},
+			past:     map[string]string{"one": "{0} secondo fa", "other": "{0} secondi fa"},
+		},
+		"second-short": {
+			relative: map[int]string{0: "ora"},
+			future:   map[string]string{"one": "tra {0} sec.", "other": "tra {0} sec."},
+			past:     map[string]string{"one": "{0} sec. fa", "other": "{0} sec. fa"},
+		},
+		"second-narrow": {
+			relative: map[int]string{0: "ora"},
+			future:   map[string]string{"one": "tra {0} s", "other": "tra {0} s"},
+			past:     map[string]string{"one": "{0} s fa", "other": "{0} s fa"},
+		},
+	},
+	"pt": {
+		"year": {
+			relative: map[int]string{-1: "ano passado", 0: "este ano", 1: "próximo ano"},
+			future:   map[string]string{"one": "em {0} ano", "other": "em {0} anos"},
+			past:     map[string]string{"one": "há {0} ano", "other": "há {0} anos"},
+		},
+		"year-short": {
+			relative: map[int]string{-1: "ano passado", 0: "este ano", 1: "próximo ano"},
+			future:   map[string]string{"one": "em {0} ano", "other": "em {0} anos"},
+			past:     map[string]string{"one": "há {0} ano", "other": "há {0} anos"},
+		},
+		"year-narrow": {
+			relative: map[int]string{-1: "ano passado", 0: "este ano", 1: "próximo ano"},
+			future:   map[string]string{"one": "em {0} ano", "other": "em {0} anos"},
+			past:     map[string]string{"one": "há {0} ano", "other": "há {0} anos"},
+		},
+		"quarter": {
+			relative: map[int]string{-1: "último trimestre", 0: "este trimestre", 1: "próximo trimestre"},
+			future:   map[string]string{"one": "em {0} trimestre", "other": "em {0} trimestres"},
+			past:     map[string]string{"one": "há {0} trimestre", "other": "há {0} trimestres"},
+		},
+		"quarter-short": {
+			relative: map[int]string{-1: "último trimestre", 0: "este trimestre", 1: "próximo trimestre"},
+			future:   map[string]string{"one": "em {0} trim.", "other": "em {0} trim."},
+			past:     map[string]string{"one": "há {0} trim.", "other": "há {0} trim."},
+		},
+		"quarter-narrow": {
+			relative: map[int]string{-1: "último trimestre", 0: "este trimestre", 1: "próximo trimestre"},
+			future:   map[string]string{"one": "em {0} trim.", "other": "em {0} trim."},
+			past:     map[string]string{"one": "há {0} trim.", "other": "há {0} trim."},
+		},
+		"month": {
+			relative: map[int]string{-1: "mês passado", 0: "este mês", 1: "próximo mês"},
+			future:   map[string]string{"one": "em {0} mês", "other": "em {0} meses"},
+			past:     map[string]string{"one": "há {0} mês", "other": "há {0} meses"},
+		},
+		"month-short": {
+			relative: map[int]string{-1: "mês passado", 0: "este mês", 1: "próximo mês"},
+			future:   map[string]string{"one": "em {0} mês", "other": "em {0} meses"},
+			past:     map[string]string{"one": "há {0} mês", "other": "há {0} meses"},
+		},
+		"month-narrow": {
+			relative: map[int]string{-1: "mês passado", 0: "este mês", 1: "próximo mês"},
+			future:   map[string]string{"one": "em {0} mês", "other": "em {0} meses"},
+			past:     map[string]string{"one": "há {0} mês", "other": "há {0} meses"},
+		},
+		"week": {
+			relative: map[int]string{-1: "semana passada", 0: "esta semana", 1: "próxima semana"},
+			future:   map[string]string{"one": "em {0} semana", "other": "em {0} semanas"},
+			past:     map[string]string{"one": "há {0} semana", "other": "há {0} semanas"},
+		},
+		"week-short": {
+			relative: map[int]string{-1: "semana passada", 0: "esta semana", 1: "próxima semana"},
+			future:   map[string]string{"one": "em {0} sem.", "other": "em {0} sem."},
+			past:     map[string]string{"one": "há {0} sem.", "other": "há {0} sem."},
+		},
+		"week-narrow": {
+			relative: map[int]string{-1: "semana passada", 0: "esta semana", 1: "próxima semana"},
+			future:   map[string]string{"one": "em {0} sem.", "other": "em {0} sem."},
+			past:     map[string]string{"one": "há {0} sem.", "other": "há {0} sem."},
+		},
+		"day": {
+			relative: map[int]string{-2: "anteontem", -1: "ontem", 0: "hoje", 1: "amanhã", 2: "depois de amanhã"},
+			future:   map[string]string{"one": "em {0} dia", "other": "em {0} dias"},
+			past:     map[string]string{"one": "há {0} dia", "other": "há {0} dias"},
+		},
+		"day-short": {
+			relative: map[int]string{-2: "anteontem", -1: "ontem", 0: "hoje", 1: "amanhã", 2: "depois de amanhã"},
+			future:   map[string]string{"one": "em {0} dia", "other": "em {0} dias"},
+			past:     map[string]string{"one": "há {0} dia", "other": "há {0} dias"},
+		},
+		"day-narrow": {
+			relative: map[int]string{-2: "anteontem", -1: "ontem", 0: "hoje", 1: "amanhã", 2: "depois de amanhã"},
+			future:   map[string]string{"one": "em {0} dia", "other": "em {0} dias"},
+			past:     map[string]string{"one": "há {0} dia", "other": "há {0} dias"},
+		},
+		"hour": {
+			relative: map[int]string{0: "esta hora"},
+			future:   map[string]string{"one": "em {0} hora", "other": "em {0} horas"},
+			past:     map[string]string{"one": "há {0} hora", "other": "há {0} horas"},
+		},
+		"hour-short": {
+			relative: map[int]string{0: "esta hora"},
+			future:   map[string]string{"one": "em {0} h", "other": "em {0} h"},
+			past:     map[string]string{"one": "há {0} h", "other": "há {0} h"},
+		},
+		"hour-narrow": {
+			relative: map[int]string{0: "esta hora"},
+			future:   map[string]string{"one": "em {0} h", "other": "em {0} h"},
+			past:     map[string]string{"one": "há {0} h", "other": "há {0} h"},
+		},
+		"minute": {
+			relative: map[int]string{0: "este minuto"},
+			future:   map[string]string{"one": "em {0} minuto", "other": "em {0} minutos"},
+			past:     map[string]string{"one": "há {0} minuto", "other": "há {0} minutos"},
+		},
+		"minute-short": {
+			relative: map[int]string{0: "este minuto"},
+			future:   map[string]string{"one": "em {0} min.", "other": "em {0} min."},
+			past:     map[string]string{"one": "há {0} min.", "other": "há {0} min."},
+		},
+		"minute-narrow": {
+			relative: map[int]string{0: "este minuto"},
+			future:   map[string]string{"one": "em {0} min.", "other": "em {0} min."},
+			past:     map[string]string{"one": "há {0} min.", "other": "há {0} min."},
+		},
+		"second": {
+			relative: map[int]string{0: "agora"},
+			future:   map[string]string{"one": "em {0} segundo", "other": "em {0} segundos"},
+			past:     map[string]string{"one": "há {0} segundo", "other": "há {0} segundos"},
+		},
+		"second-short": {
+			relative: map[int]string{0: "agora"},
+			future:   map[string]string{"one": "em {0} seg.", "other": "em {0} seg."},
+			past:     map[string]string{"one": "há {0} seg.", "other": "há {0} seg."},
+		},
+		"second-narrow": {
+			relative: map[int]string{0: "agora"},
+			future:   map[string]string{"one": "em {0} seg.", "other": "em {0} seg."},
+			past:     map[string]string{"one": "há {0} seg.", "other": "há {0} seg."},
+		},
+	},
+	"bg": {
+		"year": {
+			relative: map[int]string{-1: "миналата година", 0: "тази година", 1: "следващата година"},
+			future:   map[string]string{"one": "след {0} година", "other": "след {0} години"},
+			past:     map[string]string{"one": "преди {0} година", "other": "преди {0} години"},
+		},
+		"year-short": {
+			relative: map[int]string{-1: "мин. г.", 0: "т. г.", 1: "следв. г."},
+			future:   map[string]string{"one": "след {0} г.", "other": "след {0} г."},
+			past:     map[string]string{"one": "преди {0} г.", "other": "преди {0} г."},
+		},
+		"year-narrow": {
+			relative: map[int]string{-1: "мин. г.", 0: "т. г.", 1: "сл. г."},
+			future:   map[string]string{"one": "сл. {0} г.", "other": "сл. {0} г."},
+			past:     map[string]string{"one": "пр. {0} г.", "other": "пр. {0} г."},
+		},
+		"quarter": {
+			relative: map[int]string{-1: "предходно тримесечие", 0: "това тримесечие", 1: "следващо тримесечие"},
+			future:   map[string]string{"one": "след {0} тримесечие", "other": "след {0} тримесечия"},
+			past:     map[string]string{"one": "преди {0} тримесечие", "other": "преди {0} тримесечия"},
+		},
+		"quarter-short": {
+			relative: map[int]string{-1: "мин. трим.", 0: "това трим.", 1: "следв. трим."},
+			future:   map[string]string{"one": "след {0} трим.", "other": "след {0} трим."},
+			past:     map[string]string{"one": "преди {0} трим.", "other": "преди {0} трим."},
+		},
+		"quarter-narrow": {
+			relative: map[int]string{-1: "мин. трим.", 0: "това трим.", 1: "следв. трим."},
+			future:   map[string]string{"one": "сл. {0} трим.", "other": "сл. {0} трим."},
+			past:     map[string]string{"one": "пр. {0} трим.", "other": "пр. {0} трим."},
+		},
+		"month": {
+			relative: map[int]string{-1: "предходен месец", 0: "този месец", 1: "следващ месец"},
+			future:   map[string]string{"one": "след {0} месец", "other": "след {0} месеца"},
+			past:     map[string]string{"one": "преди {0} месец", "other": "преди {0} месеца"},
+		},
+		"month-short": {
+			relative: map[int]string{-1: "мин. мес.", 0: "този мес.", 1: "следв. мес."},
+			future:   map[string]string{"one": "след {0} м.", "other": "след {0} м."},
+			past:     map[string]string{"one": "преди {0} м.", "other": "преди {0} м."},
+		},
+		"month-narrow": {
+			relative: map[int]string{-1: "мин. м.", 0: "т. м.", 1: "сл. м."},
+			future:   map[string]string{"one": "сл. {0} м.", "other": "сл. {0} м."},
+			past:     map[string]string{"one": "пр. {0} м.", "other": "пр. {0} м."},
+		},
+		"week": {
+			relative: map[int]string{-1: "предходната седмица", 0: "тази седмица", 1: "следващата седмица"},
+			future:   map[string]string{"one": "след {0} седмица", "other": "след {0} седмици"},
+			past:     map[string]string{"one": "преди {0} седмица", "other": "преди {0} седмици"},
+		},
+		"week-short": {
+			relative: map[int]string{-1: "мин. седм.", 0: "тази седм.", 1: "следв. седм."},
+			future:   map[string]string{"one": "след {0} седм.", "other": "след {0} седм."},
+			past:     map[string]string{"one": "преди {0} седм.", "other": "преди {0} седм."},
+		},
+		"week-narrow": {
+			relative: map[int]string{-1: "мин. седм.", 0: "тази седм.", 1: "сл. седм."},
+			future:   map[string]string{"one": "сл. {0} седм.", "other": "сл. {0} седм."},
+			past:     map[string]string{"one": "пр. {0} седм.", "other": "пр. {0} седм."},
+		},
+		"day": {
+			relative: map[int]string{-2: "онзи ден", -1: "вчера", 0: "днес", 1: "утре", 2: "вдругиден"},
+			future:   map[string]string{"one": "след {0} ден", "other": "след {0} дни"},
+			past:     map[string]string{"one": "преди {0} ден", "other": "преди {0} дни"},
+		},
+		"day-short": {
+			relative: map[int]string{-2: "онзи ден", -1: "вчера", 0: "днес", 1: "утре", 2: "вдругиден"},
+			future:   map[string]string{"one": "след {0} ден", "other": "след {0} дни"},
+			past:     map[string]string{"one": "преди {0} ден", "other": "преди {0} дни"},
+		},
+		"day-narrow": {
+			relative: map[int]string{-2: "онзи ден", -1: "вчера", 0: "днес", 1: "утре", 2: "вдругиден"},
+			future:   map[string]string{"one": "сл. {0} д", "other": "сл. {0} д"},
+			past:     map[string]string{"one": "пр. {0} д", "other": "пр. {0} д"},
+		},
+		"hour": {
+			relative: map[int]string{0: "в този час"},
+			future:   map[string]string{"one": "след {0} час", "other": "след {0} часа"},
+			past:     map[string]string{"one": "преди {0} час", "other": "преди {0} часа"},
+		},
+		"hour-short": {
+			relative: map[int]string{0: "в този час"},
+			future:   map[string]string{"one": "след {0} ч", "other": "след {0} ч"},
+			past:     map[string]string{"one": "преди {0} ч", "other": "преди {0} ч"},
+		},
+		"hour-narrow": {
+			relative: map[int]string{0: "в този час"},
+			future:   map[string]string{"one": "сл. {0} ч", "other": "сл. {0} ч"},
+			past:     map[string]string{"one": "пр. {0} ч", "other": "пр. {0} ч"},
+		},
+		"minute": {
+			relative: map[int]string{0: "в тази минута"},
+			future:   map[string]string{"one": "след {0} минута", "other": "след {0} минути"},
+			past:     map[string]string{"one": "преди {0} минута", "other": "преди {0} минути"},
+		},
+		"minute-short": {
+			relative: map[int]string{0: "в тази минута"},
+			future:   map[string]string{"one": "след {0} мин", "other": "след {0} мин"},
+			past:     map[string]string{"one": "преди {0} мин", "other": "преди {0} мин"},
+		},
+		"minute-narrow": {
+			relative: map[int]string{0: "в тази минута"},
+			future:   map[string]string{"one": "сл. {0} мин", "other": "сл. {0} мин"},
+			past:     map[string]string{"one": "пр. {0} мин", "other": "пр. {0} мин"},
+		},
+		"second": {
+			relative: map[int]string{0: "сега"},
+			future:   map[string]string{"one": "след {0} секунда", "other": "след {0} секунди"},
+			past:     map[string]string{"one": "преди {0} секунда", "other": "преди {0} секунди"},
+		},
+		"second-short": {
+			relative: map[int]string{0: "сега"},
+			future:   map[string]string{"one": "след {0} сек", "other": "след {0} сек"},
+			past:     map[string]string{"one": "преди {0} сек", "other": "преди {0} сек"},
+		},
+		"second-narrow": {
+			relative: map[int]string{0: "сега"},
+			future:   map[string]string{"one": "сл. {0} сек", "other": "сл. {0} сек"},
+			past:     map[string]string{"one": "пр. {0} сек", "other": "пр. {0} сек"},
+		},
+	},
+	"zh": {
+		"year": {
+			relative: map[int]string{-1: "去年", 0: "今年", 1: "明年"},
+			future:   map[string]string{"other": "{0}年后"},
+			past:     map[string]string{"other": "{0}年前"},
+		},
+		"year-short": {
+			relative: map[int]string{-1: "去年", 0: "今年", 1: "明年"},
+			future:   map[string]string{"other": "{0}年后"},
+			past:     map[string]string{"other": "{0}年前"},
+		},
+		"year-narrow": {
+			relative: map[int]string{-1: "去年", 0: "今年", 1: "明年"},
+			future:   map[string]string{"other": "{0}年后"},
+			past:     map[string]string{"other": "{0}年前"},
+		},
+		"quarter": {
+			relative: map[int]string{-1: "上季度", 0: "本季度", 1: "下季度"},
+			future:   map[string]string{"other": "{0}个季度后"},
+			past:     map[string]string{"other": "{0}个季度前"},
+		},
+		"quarter-short": {
+			relative: map[int]string{-1: "上季度", 0: "本季度", 1: "下季度"},
+			future:   map[string]string{"other": "{0}个季度后"},
+			past:     map[string]string{"other": "{0}个季度前"},
+		},
+		"quarter-narrow": {
+			relative: map[int]string{-1: "上季度", 0: "本季度", 1: "下季度"},
+			future:   map[string]string{"other": "{0}个季度后"},
+			past:     map[string]string{"other": "{0}个季度前"},
+		},
+		"month": {
+			relative: map[int]string{-1: "上个月", 0: "本月", 1: "下个月"},
+			future:   map[string]string{"other": "{0}个月后"},
+			past:     map[string]string{"other": "{0}个月前"},
+		},
+		"month-short": {
+			relative: map[int]string{-1: "上个月", 0: "本月", 1: "下个月"},
+			future:   map[string]string{"other": "{0}个月后"},
+			past:     map[string]string{"other": "{0}个月前"},
+		},
+		"month-narrow": {
+			relative: map[int]string{-1: "上个月", 0: "本月", 1: "下个月"},
+			future:   map[string]string{"other": "{0}个月后"},
+			past:     map[string]string{"other": "{0}个月前"},
+		},
+		"week": {
+			relative: map[int]string{-1: "上周", 0: "本周", 1: "下周"},
+			future:   map[string]string{"other": "{0}周后"},
+			past:     map[string]string{"other": "{0}周前"},
+		},
+		"week-short": {
+			relative: map[int]string{-1: "上周", 0: "本周", 1: "下周"},
+			future:   map[string]string{"other": "{0}周后"},
+			past:     map[string]string{"other": "{0}周前"},
+		},
+		"week-narrow": {
+			relative: map[int]string{-1: "上周", 0: "本周", 1: "下周"},
+			future:   map[string]string{"other": "{0}周后"},
+			past:     map[string]string{"other": "{0}周前"},
+		},
+		"day": {
+			relative: map[int]string{-2: "前天", -1: "昨天", 0: "今天", 1: "明天", 2: "后天"},
+			future:   map[string]string{"other": "{0}天后"},
+			past:     map[string]string{"other": "{0}天前"},
+		},
+		"day-short": {
+			relative: map[int]string{-2: "前天", -1: "昨天", 0: "今天", 1: "明天", 2: "后天"},
+			future:   map[string]string{"other": "{0}天后"},
+			past:     map[string]string{"other": "{0}天前"},
+		},
+		"day-narrow": {
+			relative: map[int]string{-2: "前天", -1: "昨天", 0: "今天", 1: "明天", 2: "后天"},
+			future:   map[string]string{"other": "{0}天后"},
+			past:     map[string]string{"other": "{0}天前"},
+		},
+		"hour": {
+			relative: map[int]string{0: "这一时间 / 此时"},
+			future:   map[string]string{"other": "{0}小时后"},
+			past:     map[string]string{"other": "{0}小时前"},
+		},
+		"hour-short": {
+			relative: map[int]string{0: "这一时间 / 此时"},
+			future:   map[string]string{"other": "{0}小时后"},
+			past:     map[string]string{"other": "{0}小时前"},
+		},
+		"hour-narrow": {
+			relative: map[int]string{0: "这一时间 / 此时"},
+			future:   map[string]string{"other": "{0}小时后"},
+			past:     map[string]string{"other": "{0}小时前"},
+		},
+		"minute": {
+			relative: map[int]string{0: "此刻"},
+			future:   map[string]string{"other": "{0}分钟后"},
+			past:     map[string]string{"other": "{0}分钟前"},
+		},
+		"minute-short": {
+			relative: map[int]string{0: "此刻"},
+			future:   map[string]string{"other": "{0}分钟后"},
+			past:     map[string]string{"other": "{0}分钟前"},
+		},
+		"minute-narrow": {
+			relative: map[int]string{0: "此刻"},
+			future:   map[string]string{"other": "{0}分钟后"},
+			past:     map[string]string{"other": "{0}分钟前"},
+		},
+		"second": {
+			relative: map[int]string{0: "现在"},
+			future:   map[string]string{"other": "{0}秒钟后"},
+			past:     map[string]string{"other": "{0}秒钟前"},
+		},
+		"second-short": {
+			relative: map[int]string{0: "现在"},
+			future:   map[string]string{"other": "{0}秒后"},
+			past:     map[string]string{"other": "{0}秒前"},
+		},
+		"second-narrow": {
+			relative: map[int]string{0: "现在"},
+			future:   map[string]string{"other": "{0}秒后"},
+			past:     map[string]string{"other": "{0}秒前"},
+		},
+	},
+}
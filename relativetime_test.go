@@ -0,0 +1,80 @@
+package icu
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatRelativeTime(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		tag   Tag
+		value int
+		unit  RelativeTimeUnit
+		style RelativeTimeStyle
+		want  string
+	}{
+		{"en", -3, RelativeDay, RelativeTimeStyle{}, "3 days ago"},
+		{"en", 2, RelativeHour, RelativeTimeStyle{}, "in 2 hours"},
+		{"en", 1, RelativeHour, RelativeTimeStyle{}, "in 1 hour"},
+		{"en", -1, RelativeDay, RelativeTimeStyle{}, "1 day ago"},
+		{"en", -1, RelativeDay, RelativeTimeStyle{Auto: true}, "yesterday"},
+		{"en", -3, RelativeDay, RelativeTimeStyle{Auto: true}, "3 days ago"},
+		{"en", 0, RelativeSecond, RelativeTimeStyle{Auto: true}, "now"},
+		{"en", 5, RelativeMinute, RelativeTimeStyle{Width: WidthShort}, "in 5 min."},
+		{"en", -5, RelativeMinute, RelativeTimeStyle{Width: WidthNarrow}, "5m ago"},
+		{"en", -1200, RelativeYear, RelativeTimeStyle{}, "1,200 years ago"},
+		{"de", -2, RelativeDay, RelativeTimeStyle{Auto: true}, "vorgestern"},
+		{"de", 1, RelativeWeek, RelativeTimeStyle{}, "in 1 Woche"},
+		{"de", -1500, RelativeDay, RelativeTimeStyle{}, "vor 1.500 Tagen"},
+		{"de-CH", 3, RelativeMonth, RelativeTimeStyle{Width: WidthShort}, "in 3 Monaten"},
+		{"fr", -1, RelativeHour, RelativeTimeStyle{}, "il y a 1 heure"},
+		{"fr", 1, RelativeYear, RelativeTimeStyle{Auto: true}, "l’année prochaine"},
+		{"es", 1, RelativeDay, RelativeTimeStyle{Auto: true}, "mañana"},
+		{"es", -1000, RelativeDay, RelativeTimeStyle{}, "hace 1000 días"},
+		{"xx", 2, RelativeDay, RelativeTimeStyle{}, "in 2 days"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.want, func(t *testing.T) {
+			got := FormatRelativeTime(tc.tag, tc.value, tc.unit, tc.style)
+			if tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTranslateRelativeTime(t *testing.T) {
+	now := time.Date(2023, time.July, 3, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name       string
+		tag        Tag
+		message    MessageFormat
+		parameters []Parameter
+		translated string
+	}{
+		{"time", "en", "{when, relativetime}", []Parameter{P("when", now.Add(-72*time.Hour)), P("$now", now)}, "3 days ago"},
+		{"time:auto", "en", "{when, relativetime, auto}", []Parameter{P("when", now.Add(-25*time.Hour)), P("$now", now)}, "yesterday"},
+		{"time:future", "de", "{when, relativetime}", []Parameter{P("when", now.Add(2*time.Hour)), P("$now", now)}, "in 2 Stunden"},
+		{"time:weeks", "en", "{when, relativetime}", []Parameter{P("when", now.Add(-20*24*time.Hour)), P("$now", now)}, "3 weeks ago"},
+		{"time:months", "en", "{when, relativetime}", []Parameter{P("when", now.AddDate(0, 2, 0)), P("$now", now)}, "in 2 months"},
+		{"time:unit", "en", "{when, relativetime, hour}", []Parameter{P("when", now.Add(-50*time.Hour)), P("$now", now)}, "50 hours ago"},
+		{"duration", "en", "{d, relativetime, short}", []Parameter{P("d", 90*time.Minute)}, "in 2 hr."},
+		{"number:unit", "en", "{n, relativetime, day, auto}", []Parameter{P("n", 1)}, "tomorrow"},
+		{"number:unit:narrow", "en", "{n, relativetime, day narrow}", []Parameter{P("n", -4)}, "4d ago"},
+		{"number:seconds", "fr", "{n, relativetime}", []Parameter{P("n", -45)}, "il y a 45 secondes"},
+		{"string", "en", "{n, relativetime}", []Parameter{P("n", "soon")}, "soon"},
+		{"missing", "en", "{n, relativetime}", nil, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Translate(tc.tag, tc.message, tc.parameters...)
+			if err != nil {
+				t.Errorf("parse: %s", err)
+			}
+			if tc.translated != got {
+				t.Errorf("expected: '%s', got: '%s'", tc.translated, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package icu
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+// LocaleResolver tells the languages a request asks for, e.g. in a query
+// parameter or in the Accept-Language header.
+type LocaleResolver interface {
+	Resolve(r *http.Request) (Specification, bool)
+}
+
+// LocaleResolverFunc resolves locales with a function, e.g. from the
+// preferences of a signed in user.
+type LocaleResolverFunc func(r *http.Request) (Specification, bool)
+
+func (f LocaleResolverFunc) Resolve(r *http.Request) (Specification, bool) {
+	return f(r)
+}
+
+// QueryResolver resolves the locale from a query parameter, e.g. "lang" for
+// "/?lang=de".
+type QueryResolver struct {
+	Param string
+}
+
+func (q QueryResolver) Resolve(r *http.Request) (Specification, bool) {
+	s := r.URL.Query().Get(q.Param)
+	return Specification(s), s != ""
+}
+
+// CookieResolver resolves the locale from the value of a cookie.
+type CookieResolver struct {
+	Name string
+}
+
+func (c CookieResolver) Resolve(r *http.Request) (Specification, bool) {
+	ck, err := r.Cookie(c.Name)
+	if err != nil || ck.Value == "" {
+		return "", false
+	}
+	return Specification(ck.Value), true
+}
+
+// PathResolver resolves the locale from the first segment of the path, e.g.
+// "de" for "/de/products".
+type PathResolver struct{}
+
+func (PathResolver) Resolve(r *http.Request) (Specification, bool) {
+	p := strings.TrimPrefix(r.URL.Path, "/")
+	if i := strings.Index(p, "/"); i >= 0 {
+		p = p[:i]
+	}
+	return localeSpecification(p)
+}
+
+// SubdomainResolver resolves the locale from the first label of the host,
+// e.g. "de" for "de.example.com".
+type SubdomainResolver struct{}
+
+func (SubdomainResolver) Resolve(r *http.Request) (Specification, bool) {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	labels := strings.Split(host, ".")
+	if len(labels) < 3 {
+		return "", false
+	}
+	return localeSpecification(labels[0])
+}
+
+// AcceptLanguageResolver resolves the locales from the Accept-Language header.
+type AcceptLanguageResolver struct{}
+
+func (AcceptLanguageResolver) Resolve(r *http.Request) (Specification, bool) {
+	s := ExtractSpecification(r)
+	return s, s != ""
+}
+
+// localeSpecification returns a specification for s if it is a valid tag
+// of a language with likely subtags, which leaves out path segments like
+// "products".
+func localeSpecification(s string) (Specification, bool) {
+	l, err := parseLocale(s)
+	if err != nil || l.Language == undetermined {
+		return "", false
+	}
+	if _, ok := likelyLookup(l.Canonicalize().Language, "", ""); !ok {
+		return "", false
+	}
+	return Specification(s), true
+}
+
+// Resolution is the tag resolved for a request, the confidence in it matching
+// the request and the resolver which decided, which is nil for the default
+// tag.
+type Resolution struct {
+	Tag        Tag
+	Confidence Confidence
+	Resolver   LocaleResolver
+}
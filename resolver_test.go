@@ -0,0 +1,83 @@
+package icu
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestLocaleResolvers(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		name     string
+		resolver LocaleResolver
+		target   string
+		header   func(r *http.Request)
+		want     Specification
+		ok       bool
+	}{
+		{"query", QueryResolver{Param: "lang"}, "/?lang=de-CH", nil, "de-CH", true},
+		{"query missing", QueryResolver{Param: "lang"}, "/?locale=de", nil, "", false},
+		{"cookie", CookieResolver{Name: "lang"}, "/", func(r *http.Request) { r.AddCookie(&http.Cookie{Name: "lang", Value: "fr"}) }, "fr", true},
+		{"cookie missing", CookieResolver{Name: "lang"}, "/", nil, "", false},
+		{"path", PathResolver{}, "/de/products/1", nil, "de", true},
+		{"path root", PathResolver{}, "/pt-BR", nil, "pt-BR", true},
+		{"path no tag", PathResolver{}, "/products/1", nil, "", false},
+		{"subdomain", SubdomainResolver{}, "http://fr.example.com:8080/", nil, "fr", true},
+		{"subdomain missing", SubdomainResolver{}, "http://example.com/", nil, "", false},
+		{"header", AcceptLanguageResolver{}, "/", func(r *http.Request) { r.Header.Set(HeaderAcceptLanguage, "de, en;q=0.5") }, "de, en;q=0.5", true},
+		{"func", LocaleResolverFunc(func(r *http.Request) (Specification, bool) { return "it", true }), "/", nil, "it", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", tc.target, nil)
+			if tc.header != nil {
+				tc.header(r)
+			}
+			got, ok := tc.resolver.Resolve(r)
+			if tc.want != got || tc.ok != ok {
+				t.Errorf("want: %q %t, got: %q %t", tc.want, tc.ok, got, ok)
+			}
+		})
+	}
+}
+
+func TestBundleResolve(t *testing.T) {
+	requireFullData(t)
+	dir := writeBundle(t, map[string]string{
+		"en.toml": "[Translations]\nhello = \"Hello\"\n",
+		"de.toml": "[Translations]\nhello = \"Hallo\"\n",
+		"fr.toml": "[Translations]\nhello = \"Bonjour\"\n",
+	})
+	b := NewBundle(dir, "en")
+	b.SetResolvers(QueryResolver{Param: "lang"}, CookieResolver{Name: "lang"}, PathResolver{}, AcceptLanguageResolver{})
+	testCases := []struct {
+		target string
+		cookie string
+		header string
+		want   Resolution
+		hello  string
+	}{
+		{"/?lang=fr", "de", "de", Resolution{"fr", Exact, QueryResolver{Param: "lang"}}, "Bonjour"},
+		{"/", "fr", "de", Resolution{"fr", Exact, CookieResolver{Name: "lang"}}, "Bonjour"},
+		{"/de-AT/page", "", "fr", Resolution{"de", High, PathResolver{}}, "Hallo"},
+		{"/?lang=ja", "", "fr-CA", Resolution{"fr", High, AcceptLanguageResolver{}}, "Bonjour"},
+		{"/page", "", "ja", Resolution{"en", No, nil}, "Hello"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.target, func(t *testing.T) {
+			r := httptest.NewRequest("GET", tc.target, nil)
+			if tc.cookie != "" {
+				r.AddCookie(&http.Cookie{Name: "lang", Value: tc.cookie})
+			}
+			r.Header.Set(HeaderAcceptLanguage, tc.header)
+			if got := b.Resolve(r); !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want: %+v, got: %+v", tc.want, got)
+			}
+			if got := b.TranslatorForRequest(r).Translate("hello"); tc.hello != got {
+				t.Errorf("want: %q, got: %q", tc.hello, got)
+			}
+		})
+	}
+}
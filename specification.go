@@ -7,50 +7,218 @@ import (
 	"strings"
 )
 
+// Specification is a language priority list like the value of an
+// Accept-Language header, e.g. "de-CH, de;q=0.9, en;q=0.5, *;q=0.1".
 type Specification string
 
+// maxLanguageRanges limits the ranges read from a specification, so that
+// hostile headers cannot make matching expensive.
+const maxLanguageRanges = 32
+
+// Wildcard is the language range matching all tags.
+const Wildcard Tag = "*"
+
+// Ranges returns the acceptable language ranges of the specification, sorted
+// by their quality. Invalid ranges and ranges with a quality of 0, which
+// mark tags as not acceptable, are left out.
 func (s Specification) Ranges() Ranges {
+	rs := Ranges{}
+	for _, r := range s.parse() {
+		if r.Quality > 0 {
+			rs = append(rs, r)
+		}
+	}
+	sort.Stable(rs)
+	return rs
+}
+
+// excluded returns the ranges with a quality of 0.
+func (s Specification) excluded() Ranges {
+	rs := Ranges{}
+	for _, r := range s.parse() {
+		if r.Quality == 0 {
+			rs = append(rs, r)
+		}
+	}
+	return rs
+}
+
+// parse parses the ranges of the specification as defined by RFC 7231, e.g.
+// "en" and "de; q=0.8". Qualities outside of 0 to 1 are clamped.
+func (s Specification) parse() Ranges {
 	rs := Ranges{}
 	if s == "" {
 		return rs
 	}
-	ps := strings.Split(string(s), ",")
-	for _, p := range ps {
-		lrps := strings.Split(p, ";q=")
-		c := Tag(strings.TrimSpace(lrps[0]))
-		if c == "" {
+	for _, p := range strings.SplitN(string(s), ",", maxLanguageRanges+1) {
+		if len(rs) == maxLanguageRanges {
+			break
+		}
+		params := strings.Split(p, ";")
+		lr := strings.Replace(strings.TrimSpace(params[0]), "_", "-", -1)
+		if !isLanguageRange(lr) {
 			continue
 		}
-		switch len(lrps) {
-		case 1:
-			rs = append(rs, Range{
-				Tag:     c,
-				Quality: 1,
-			})
-		case 2:
-			qStr := strings.TrimSpace(lrps[1])
-			q, err := strconv.ParseFloat(qStr, 64)
-			if err != nil {
+		q, ok := 1.0, true
+		for _, param := range params[1:] {
+			kv := strings.SplitN(param, "=", 2)
+			if len(kv) != 2 || !strings.EqualFold(strings.TrimSpace(kv[0]), "q") {
 				continue
 			}
-			rs = append(rs, Range{
-				Tag:     c,
-				Quality: q,
-			})
+			v, err := strconv.ParseFloat(strings.TrimSpace(kv[1]), 64)
+			if err != nil || v != v {
+				ok = false
+				break
+			}
+			q = v
+		}
+		if !ok {
+			continue
 		}
+		switch {
+		case q < 0:
+			q = 0
+		case q > 1:
+			q = 1
+		}
+		rs = append(rs, Range{
+			Tag:     Tag(lr).Canonical(),
+			Quality: q,
+		})
 	}
-	sort.Stable(rs)
 	return rs
 }
 
+// isLanguageRange tells whether s is "*" or subtags of up to eight letters
+// and digits, which may be "*" in extended ranges like "de-*-DE".
+func isLanguageRange(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, st := range strings.Split(s, "-") {
+		if st == "*" {
+			continue
+		}
+		if st == "" || len(st) > 8 {
+			return false
+		}
+		for _, r := range st {
+			alpha := r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+			if !alpha && (i == 0 || r < '0' || r > '9') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Tag returns the tag of the range with the highest quality which is not the
+// wildcard.
 func (s Specification) Tag() Tag {
-	rs := s.Ranges()
-	if len(rs) > 0 {
-		return rs[0].Tag
+	for _, r := range s.Ranges() {
+		if r.Tag != Wildcard {
+			return r.Tag
+		}
 	}
 	return ""
 }
 
+// Filter returns the tags matched by the ranges of the specification by
+// extended filtering as defined by RFC 4647, ordered by the quality of the
+// first range matching them. Tags matched by a range with a quality of 0 are
+// left out.
+func (s Specification) Filter(tags []Tag) []Tag {
+	excluded := s.excluded()
+	var res []Tag
+	seen := map[Tag]bool{}
+	for _, r := range s.Ranges() {
+		for _, t := range tags {
+			if seen[t] || !matchExtended(r.Tag, t) || excluded.matchAny(t) {
+				continue
+			}
+			seen[t] = true
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+func (rs Ranges) matchAny(t Tag) bool {
+	for _, r := range rs {
+		if matchExtended(r.Tag, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Lookup returns the tag best matching the ranges of the specification as
+// defined by RFC 4647, which shortens each range until a tag equals it,
+// e.g. "zh-Hant-CN", "zh-Hant" and "zh". Without a match it returns def.
+func (s Specification) Lookup(tags []Tag, def Tag) Tag {
+	excluded := s.excluded()
+	for _, r := range s.Ranges() {
+		for _, c := range lookupCandidates(r.Tag) {
+			for _, t := range tags {
+				if equalTags(c, t) && !excluded.matchAny(t) {
+					return t
+				}
+			}
+		}
+	}
+	return def
+}
+
+// lookupCandidates returns a range followed by its truncations, leaving out
+// singletons at the end, e.g. "zh-Hant-CN-x-a", "zh-Hant-CN", "zh-Hant" and
+// "zh". The wildcard and ranges with wildcards have none.
+func lookupCandidates(r Tag) []Tag {
+	if strings.Contains(string(r), "*") {
+		return nil
+	}
+	subtags := strings.Split(string(r), "-")
+	var res []Tag
+	for n := len(subtags); n > 0; n-- {
+		if len(subtags[n-1]) == 1 {
+			continue
+		}
+		res = append(res, Tag(strings.Join(subtags[:n], "-")))
+	}
+	return res
+}
+
+func equalTags(a Tag, b Tag) bool {
+	return strings.EqualFold(strings.Replace(string(a), "_", "-", -1), strings.Replace(string(b), "_", "-", -1))
+}
+
+// matchExtended tells whether a range like "de-*-DE" matches a tag like
+// "de-Latn-DE" by extended filtering: the first subtags must match, the
+// others may be separated by subtags other than singletons.
+func matchExtended(r Tag, t Tag) bool {
+	rs := strings.Split(strings.ToLower(string(r)), "-")
+	ts := strings.Split(strings.ToLower(strings.Replace(string(t), "_", "-", -1)), "-")
+	if rs[0] != "*" && rs[0] != ts[0] {
+		return false
+	}
+	i, j := 1, 1
+	for i < len(rs) {
+		switch {
+		case rs[i] == "*":
+			i++
+		case j >= len(ts):
+			return false
+		case rs[i] == ts[j]:
+			i++
+			j++
+		case len(ts[j]) == 1:
+			return false
+		default:
+			j++
+		}
+	}
+	return true
+}
+
 type Range struct {
 	Tag     Tag
 	Quality float64
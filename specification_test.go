@@ -0,0 +1,126 @@
+package icu
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSpecificationRanges(t *testing.T) {
+	testCases := []struct {
+		in   Specification
+		want Ranges
+	}{
+		{"", Ranges{}},
+		{"da, en-gb;q=0.8, en;q=0.7", Ranges{{"da", 1}, {"en-GB", 0.8}, {"en", 0.7}}},
+		{"en;q = 0.5, de; q=0.8", Ranges{{"de", 0.8}, {"en", 0.5}}},
+		{"de ;Q=0.3 , fr", Ranges{{"fr", 1}, {"de", 0.3}}},
+		{"en;q=0, de", Ranges{{"de", 1}}},
+		{"en;q=2, de;q=-1, fr;q=0.9", Ranges{{"en", 1}, {"fr", 0.9}}},
+		{"*;q=0.1, de-CH", Ranges{{"de-CH", 1}, {"*", 0.1}}},
+		{"en;q=abc, de;level=1;q=0.4", Ranges{{"de", 0.4}}},
+		{"en_US, iw, 12, de-DE-verylongsubtag, , es-419", Ranges{{"en-US", 1}, {"he", 1}, {"es-419", 1}}},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.in), func(t *testing.T) {
+			if got := tc.in.Ranges(); !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want: %v, got: %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSpecificationRangesLimit(t *testing.T) {
+	s := Specification(strings.Repeat("en, ", 1000) + "de")
+	if got := len(s.Ranges()); got != maxLanguageRanges {
+		t.Errorf("want: %d, got: %d", maxLanguageRanges, got)
+	}
+}
+
+func TestSpecificationTag(t *testing.T) {
+	if got, want := Specification("*, de;q=0.5").Tag(), Tag("de"); want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+}
+
+func TestSpecificationFilter(t *testing.T) {
+	// Examples from RFC 4647, sections 3.3.1 and 3.3.2.
+	tags := []Tag{"de", "de-DE", "de-de", "de-Latn-DE", "de-Latf-DE", "de-DE-x-goethe", "de-Latn-DE-1996", "de-Deva-DE", "de-x-DE", "de-Deva", "de-DE-1996"}
+	testCases := []struct {
+		in   Specification
+		want []Tag
+	}{
+		{"de-*-DE", []Tag{"de-DE", "de-de", "de-Latn-DE", "de-Latf-DE", "de-DE-x-goethe", "de-Latn-DE-1996", "de-Deva-DE", "de-DE-1996"}},
+		{"de-DE", []Tag{"de-DE", "de-de", "de-Latn-DE", "de-Latf-DE", "de-DE-x-goethe", "de-Latn-DE-1996", "de-Deva-DE", "de-DE-1996"}},
+		{"de-DE-1996", []Tag{"de-Latn-DE-1996", "de-DE-1996"}},
+		{"de-Deva, de-x-DE;q=0.5", []Tag{"de-Deva-DE", "de-Deva", "de-x-DE"}},
+		{"*, de-DE;q=0", []Tag{"de", "de-x-DE", "de-Deva"}},
+		{"en", nil},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.in), func(t *testing.T) {
+			if got := tc.in.Filter(tags); !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want: %v, got: %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSpecificationLookup(t *testing.T) {
+	testCases := []struct {
+		in   Specification
+		tags []Tag
+		want Tag
+	}{
+		// Examples from RFC 4647, section 3.4.
+		{"zh-Hant-CN-x-private1-private2", []Tag{"zh", "zh-Hant-CN-x-private1"}, "zh-Hant-CN-x-private1"},
+		{"zh-Hant-CN-x-private1-private2", []Tag{"zh", "zh-Hant-CN-x"}, "zh"},
+		{"zh-Hant-CN-x-private1-private2", []Tag{"zh-Hant", "zh-Hant-CN"}, "zh-Hant-CN"},
+		{"fr-FR, zh-Hant", []Tag{"zh-Hant", "fr"}, "fr"},
+		{"fr-FR, zh-Hant", []Tag{"de"}, "en"},
+		{"*, de;q=0.5", []Tag{"de"}, "de"},
+		{"de-CH, de;q=0", []Tag{"de", "fr"}, "en"},
+		{"de_ch;q=0.5, fr-ca", []Tag{"de", "fr"}, "fr"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.in), func(t *testing.T) {
+			if got := tc.in.Lookup(tc.tags, "en"); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTranslatorForRequest(t *testing.T) {
+	dir := t.TempDir()
+	for tag, hello := range map[string]string{"de": "Hallo", "fr": "Bonjour", "en": "Hello"} {
+		content := "[Translations]\nhello = \"" + hello + "\"\n"
+		if err := os.WriteFile(filepath.Join(dir, tag+".toml"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	b := NewBundle(dir, "en")
+	testCases := []struct {
+		in   string
+		want string
+	}{
+		{"de-CH, fr;q=0.8", "Hallo"},
+		{"it, fr; q=0.8", "Bonjour"},
+		{"de-AT;q=0, fr", "Bonjour"},
+		{"de-CH, de;q=0, fr;q=0.1", "Bonjour"},
+		{"*", "Hello"},
+		{"", "Hello"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.in, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.Header.Set(HeaderAcceptLanguage, tc.in)
+			if got := b.TranslatorForRequest(r).Translate("hello"); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,432 @@
+package icu
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Locale is a BCP 47 language tag parsed into its subtags, e.g. "sr", "Latn",
+// "RS" and the keyword "nu": "latn" for "sr-Latn-RS-u-nu-latn".
+type Locale struct {
+	Language string
+	Script   string
+	Region   string
+	Variants []string
+	// Keywords holds the keys and types of the "-u-" extension, like the
+	// numbering system "nu", the calendar "ca", the hour cycle "hc", the first
+	// day of the week "fw" and the currency "cu". Attributes of the extension
+	// are dropped.
+	Keywords map[string]string
+	// Extensions holds the other extensions and the private use subtags, e.g.
+	// "t-de" and "x-internal".
+	Extensions []string
+}
+
+const undetermined = "und"
+
+// extraLanguageAliases holds aliases which CLDR dropped when it made "no" the
+// canonical code of Norwegian Bokmål, kept for tags stored by older software.
+var extraLanguageAliases = map[string]string{
+	"no": "nb",
+}
+
+// ParseLocale parses a language tag into its subtags in their canonical case,
+// e.g. "en-US" for "EN_us". Extended language subtags replace the language,
+// e.g. "yue" for "zh-yue", and "root" is the same as "und".
+func ParseLocale(s string) (Locale, error) {
+	return parseLocale(s)
+}
+
+// parseLocale returns the subtags parsed up to an invalid one together with
+// the error.
+func parseLocale(s string) (Locale, error) {
+	l := Locale{Language: undetermined}
+	if s == "" {
+		return l, fmt.Errorf("empty language tag")
+	}
+	subtags := strings.Split(strings.ToLower(strings.Replace(s, "_", "-", -1)), "-")
+	var err error
+	for i, st := range subtags {
+		if st == "" || len(st) > 8 || !isAlphanumeric(st) {
+			subtags, err = subtags[:i], fmt.Errorf("invalid subtag %q in language tag %q", st, s)
+			break
+		}
+	}
+	if len(subtags) == 0 {
+		return l, err
+	}
+	i := 0
+	switch first := subtags[0]; {
+	case first == "root":
+		i++
+	case first == "x":
+	case isAlpha(first) && (len(first) == 2 || len(first) == 3 || len(first) >= 5):
+		l.Language = first
+		i++
+		if len(first) <= 3 && i < len(subtags) && len(subtags[i]) == 3 && isAlpha(subtags[i]) {
+			l.Language = subtags[i]
+			i++
+		}
+	default:
+		return l, fmt.Errorf("invalid language %q in language tag %q", first, s)
+	}
+	if i < len(subtags) && len(subtags[i]) == 4 && isAlpha(subtags[i]) {
+		l.Script = strings.ToUpper(subtags[i][:1]) + subtags[i][1:]
+		i++
+	}
+	if i < len(subtags) && isRegion(subtags[i]) {
+		l.Region = strings.ToUpper(subtags[i])
+		i++
+	}
+	for ; i < len(subtags) && isVariant(subtags[i]); i++ {
+		for _, v := range l.Variants {
+			if v == subtags[i] {
+				return l, fmt.Errorf("duplicate variant %q in language tag %q", v, s)
+			}
+		}
+		l.Variants = append(l.Variants, subtags[i])
+	}
+	seen := map[string]bool{}
+	for i < len(subtags) {
+		singleton := subtags[i]
+		if len(singleton) != 1 {
+			return l, fmt.Errorf("invalid subtag %q in language tag %q", singleton, s)
+		}
+		if seen[singleton] {
+			return l, fmt.Errorf("duplicate extension %q in language tag %q", singleton, s)
+		}
+		seen[singleton] = true
+		j := i + 1
+		for j < len(subtags) && (singleton == "x" || len(subtags[j]) > 1) {
+			j++
+		}
+		if j == i+1 {
+			return l, fmt.Errorf("empty extension %q in language tag %q", singleton, s)
+		}
+		switch singleton {
+		case "u":
+			l.Keywords = parseKeywords(l.Keywords, subtags[i+1:j])
+		default:
+			l.Extensions = append(l.Extensions, strings.Join(subtags[i:j], "-"))
+		}
+		i = j
+	}
+	return l, err
+}
+
+// parseKeywords adds the keywords of the subtags of a "-u-" extension. Keys
+// have two letters, types the longer subtags which follow.
+func parseKeywords(kw map[string]string, subtags []string) map[string]string {
+	if kw == nil {
+		kw = map[string]string{}
+	}
+	for i := 0; i < len(subtags); {
+		if len(subtags[i]) != 2 {
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(subtags) && len(subtags[j]) > 2 {
+			j++
+		}
+		if _, ok := kw[subtags[i]]; !ok {
+			kw[subtags[i]] = strings.Join(subtags[i+1:j], "-")
+		}
+		i = j
+	}
+	return kw
+}
+
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumeric(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func isRegion(s string) bool {
+	return len(s) == 2 && isAlpha(s) || len(s) == 3 && strings.Trim(s, "0123456789") == ""
+}
+
+func isVariant(s string) bool {
+	return len(s) >= 5 || len(s) == 4 && s[0] >= '0' && s[0] <= '9'
+}
+
+// String returns the tag of the locale with the keywords sorted by key and
+// the extensions by their singleton, e.g. "de-CH-u-ca-gregory-nu-latn".
+func (l Locale) String() string {
+	parts := []string{l.Language}
+	if l.Language == "" {
+		parts[0] = undetermined
+	}
+	if l.Script != "" {
+		parts = append(parts, l.Script)
+	}
+	if l.Region != "" {
+		parts = append(parts, l.Region)
+	}
+	parts = append(parts, l.Variants...)
+	exts := []string{}
+	var private string
+	for _, e := range l.Extensions {
+		if strings.HasPrefix(e, "x-") {
+			private = e
+			continue
+		}
+		exts = append(exts, e)
+	}
+	if len(l.Keywords) > 0 {
+		keys := make([]string, 0, len(l.Keywords))
+		for k := range l.Keywords {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		u := []string{"u"}
+		for _, k := range keys {
+			u = append(u, k)
+			if t := l.Keywords[k]; t != "" && t != "true" {
+				u = append(u, t)
+			}
+		}
+		exts = append(exts, strings.Join(u, "-"))
+	}
+	sort.Strings(exts)
+	parts = append(parts, exts...)
+	if private != "" {
+		parts = append(parts, private)
+	}
+	return strings.Join(parts, "-")
+}
+
+// Tag returns the tag of the locale.
+func (l Locale) Tag() Tag {
+	return Tag(l.String())
+}
+
+// Keyword returns the type of a key of the "-u-" extension, e.g. "arab" for
+// the key "nu" of "ar-u-nu-arab".
+func (l Locale) Keyword(key string) string {
+	return l.Keywords[key]
+}
+
+// Canonicalize replaces deprecated and legacy codes, e.g. "he" for "iw", "GB"
+// for "UK" and "sr-Latn" for "sh", and sorts the variants.
+func (l Locale) Canonicalize() Locale {
+	c := l
+	c.Variants = append([]string(nil), l.Variants...)
+	for n := 0; n < 4; n++ {
+		if !c.replaceLanguage() {
+			break
+		}
+	}
+	if s, ok := scriptAliases[c.Script]; ok {
+		c.Script = s
+	}
+	if r, ok := territoryAliases[c.Region]; ok {
+		c.Region = c.splitRegion(strings.Fields(r))
+	}
+	for i, v := range c.Variants {
+		if a, ok := variantAliases[v]; ok {
+			c.Variants[i] = a
+		}
+	}
+	sort.Strings(c.Variants)
+	return c
+}
+
+// replaceLanguage applies the first language alias matching the language
+// with a variant, with the region or alone.
+func (l *Locale) replaceLanguage() bool {
+	for i, v := range l.Variants {
+		if a, ok := languageAliases[l.Language+"-"+v]; ok {
+			l.Variants = append(l.Variants[:i:i], l.Variants[i+1:]...)
+			l.applyLanguageAlias(a, false)
+			return true
+		}
+	}
+	if l.Region != "" {
+		if a, ok := languageAliases[l.Language+"-"+l.Region]; ok {
+			l.applyLanguageAlias(a, true)
+			return true
+		}
+	}
+	a, ok := languageAliases[l.Language]
+	if !ok {
+		a, ok = extraLanguageAliases[l.Language]
+	}
+	if ok {
+		l.applyLanguageAlias(a, false)
+	}
+	return ok
+}
+
+func (l *Locale) applyLanguageAlias(alias string, region bool) {
+	r, _ := parseLocale(alias)
+	if r.Language != undetermined {
+		l.Language = r.Language
+	}
+	if l.Script == "" {
+		l.Script = r.Script
+	}
+	if region || l.Region == "" {
+		l.Region = r.Region
+	}
+	l.Variants = append(l.Variants, r.Variants...)
+}
+
+// splitRegion picks the replacement of a region which was split into
+// several, preferring the likely region of the language, e.g. "AM" for
+// "hy-SU".
+func (l Locale) splitRegion(regions []string) string {
+	if len(regions) > 1 {
+		if lsr, ok := likelyLookup(l.Language, l.Script, ""); ok {
+			likely := likelyLocale(lsr).Region
+			for _, r := range regions {
+				if r == likely {
+					return r
+				}
+			}
+		}
+	}
+	return regions[0]
+}
+
+// likelyLookup returns the likely subtags of the most specific combination of
+// a language with the script and the region.
+func likelyLookup(lang string, script string, region string) (string, bool) {
+	if lang == "" {
+		lang = undetermined
+	}
+	keys := []string{lang}
+	if region != "" {
+		keys = append(keys, lang+"-"+region)
+	}
+	if script != "" {
+		keys = append(keys, lang+"-"+script)
+	}
+	if script != "" && region != "" {
+		keys = append(keys, lang+"-"+script+"-"+region)
+	}
+	for i := len(keys) - 1; i >= 0; i-- {
+		if lsr, ok := likelySubtags[keys[i]]; ok {
+			return lsr, true
+		}
+	}
+	return "", false
+}
+
+func likelyLocale(lsr string) Locale {
+	l, _ := parseLocale(lsr)
+	return l
+}
+
+// Maximize adds the likely script and region to a canonicalized locale, e.g.
+// "zh-Hant-TW" for "zh-TW" and "sr-Cyrl-RS" for "sr". Languages without data
+// get the script and region likely for their script or region, or "Latn"
+// and "US".
+func (l Locale) Maximize() Locale {
+	c := l.Canonicalize()
+	lang, script, region := c.Language, c.Script, c.Region
+	if lang == undetermined {
+		lang = ""
+	}
+	if script == "Zzzz" {
+		script = ""
+	}
+	if region == "ZZ" {
+		region = ""
+	}
+	if lang != "" && script != "" && region != "" {
+		return c
+	}
+	lsr, ok := likelyLookup(lang, script, region)
+	if !ok {
+		lsr, ok = likelyLookup("", script, region)
+	}
+	if !ok {
+		lsr = likelySubtags[undetermined]
+	}
+	m := likelyLocale(lsr)
+	if lang != "" {
+		m.Language = lang
+	}
+	if script != "" {
+		m.Script = script
+	}
+	if region != "" {
+		m.Region = region
+	}
+	c.Language, c.Script, c.Region = m.Language, m.Script, m.Region
+	return c
+}
+
+// Minimize removes the script and region which Maximize would add back,
+// preferring to keep the region, e.g. "zh-TW" for "zh-Hant-TW" and "en" for
+// "en-Latn-US".
+func (l Locale) Minimize() Locale {
+	max := l.Maximize()
+	candidates := []Locale{
+		{Language: max.Language},
+		{Language: max.Language, Region: max.Region},
+		{Language: max.Language, Script: max.Script},
+	}
+	for _, c := range candidates {
+		m := c.Maximize()
+		if m.Language == max.Language && m.Script == max.Script && m.Region == max.Region {
+			max.Script, max.Region = c.Script, c.Region
+			return max
+		}
+	}
+	return max
+}
+
+// Locale parses a tag leniently, keeping the subtags before an invalid one.
+func (t Tag) Locale() Locale {
+	l, _ := parseLocale(string(t))
+	return l
+}
+
+// Canonical returns a tag in its canonical form, e.g. "en-US" for "EN_us" and
+// "he-IL" for "iw-IL". Invalid tags are returned unchanged.
+func (t Tag) Canonical() Tag {
+	l, err := parseLocale(string(t))
+	if err != nil {
+		return t
+	}
+	return l.Canonicalize().Tag()
+}
+
+// literal returns a tag with the case and separators of its canonical form
+// but without replacing aliases, e.g. "no-NO" for "NO_no", which Canonical
+// turns into "nb-NO". Bundles key their catalogs by it.
+func (t Tag) literal() Tag {
+	l, err := parseLocale(string(t))
+	if err != nil || t == localeRoot {
+		return t
+	}
+	return l.Tag()
+}
+
+// Maximize returns the tag with its likely script and region, e.g. "en-Latn-US"
+// for "en".
+func (t Tag) Maximize() Tag {
+	return t.Locale().Maximize().Tag()
+}
+
+// Minimize returns the tag without the script and region which are likely
+// anyway, e.g. "de" for "de-Latn-DE".
+func (t Tag) Minimize() Tag {
+	return t.Locale().Minimize().Tag()
+}
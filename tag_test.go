@@ -0,0 +1,180 @@
+package icu
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseLocale(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want Locale
+	}{
+		{"en", Locale{Language: "en"}},
+		{"EN_us", Locale{Language: "en", Region: "US"}},
+		{"zh-hant-tw", Locale{Language: "zh", Script: "Hant", Region: "TW"}},
+		{"es-419", Locale{Language: "es", Region: "419"}},
+		{"de-CH-1996", Locale{Language: "de", Region: "CH", Variants: []string{"1996"}}},
+		{"sl-rozaj-biske", Locale{Language: "sl", Variants: []string{"rozaj", "biske"}}},
+		{"zh-yue-HK", Locale{Language: "yue", Region: "HK"}},
+		{"root", Locale{Language: "und"}},
+		{"ar-u-ca-islamic-civil-nu-arab", Locale{Language: "ar", Keywords: map[string]string{"ca": "islamic-civil", "nu": "arab"}}},
+		{"en-US-u-hc-h23-fw-mon-cu-eur", Locale{Language: "en", Region: "US", Keywords: map[string]string{"hc": "h23", "fw": "mon", "cu": "eur"}}},
+		{"de-t-en-x-private", Locale{Language: "de", Extensions: []string{"t-en", "x-private"}}},
+		{"x-whatever", Locale{Language: "und", Extensions: []string{"x-whatever"}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := ParseLocale(tc.in)
+			if err != nil {
+				t.Fatalf("parse: %s", err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want: %+v, got: %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParseLocaleErrors(t *testing.T) {
+	for _, in := range []string{"", "e", "1de-DE", "de--DE", "de-DE-1996-1996", "de-u", "en-a-foo-a-bar", "de_DE.UTF-8"} {
+		t.Run(in, func(t *testing.T) {
+			if _, err := ParseLocale(in); err == nil {
+				t.Errorf("want an error for %q", in)
+			}
+		})
+	}
+}
+
+func TestLocaleString(t *testing.T) {
+	testCases := []struct {
+		in   Tag
+		want string
+	}{
+		{"EN-us", "en-US"},
+		{"sr_latn_rs", "sr-Latn-RS"},
+		{"en-u-NU-latn-CA-gregory", "en-u-ca-gregory-nu-latn"},
+		{"en-x-foo-t-de", "en-x-foo-t-de"},
+		{"en-t-de-x-foo", "en-t-de-x-foo"},
+		{"de-DE.UTF-8", "de"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.in), func(t *testing.T) {
+			if got := tc.in.Locale().String(); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTagCanonical(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		in   Tag
+		want Tag
+	}{
+		{"EN-us", "en-US"},
+		{"iw", "he"},
+		{"iw-IL", "he-IL"},
+		{"no", "nb"},
+		{"in-ID", "id-ID"},
+		{"sh", "sr-Latn"},
+		{"sh-Cyrl", "sr-Cyrl"},
+		{"tl", "fil"},
+		{"en-UK", "en-GB"},
+		{"de-DD", "de-DE"},
+		{"hy-SU", "hy-AM"},
+		{"ru-SU", "ru-RU"},
+		{"sgn-DE", "gsg"},
+		{"hy-arevmda", "hyw"},
+		{"el-polytoni", "el-polyton"},
+		{"en-Qaai", "en-Zinh"},
+		{"not a tag", "not a tag"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.in), func(t *testing.T) {
+			if got := tc.in.Canonical(); tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTagMaximizeMinimize(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		in       Tag
+		maximize Tag
+		minimize Tag
+	}{
+		{"en", "en-Latn-US", "en"},
+		{"en-GB", "en-Latn-GB", "en-GB"},
+		{"de-Latn-DE", "de-Latn-DE", "de"},
+		{"zh-TW", "zh-Hant-TW", "zh-TW"},
+		{"zh-Hant", "zh-Hant-TW", "zh-TW"},
+		{"zh-Hans-CN", "zh-Hans-CN", "zh"},
+		{"sr", "sr-Cyrl-RS", "sr"},
+		{"sr-ME", "sr-Latn-ME", "sr-ME"},
+		{"sr-Latn", "sr-Latn-RS", "sr-Latn"},
+		{"und", "en-Latn-US", "en"},
+		{"und-TW", "zh-Hant-TW", "zh-TW"},
+		{"und-Cyrl", "ru-Cyrl-RU", "ru"},
+		{"iw", "he-Hebr-IL", "he"},
+		{"xx-CH", "xx-Latn-CH", "xx-CH"},
+		{"pa-PK", "pa-Arab-PK", "pa-PK"},
+		{"de-CH-u-nu-latn", "de-Latn-CH-u-nu-latn", "de-CH-u-nu-latn"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.in), func(t *testing.T) {
+			if got := tc.in.Maximize(); tc.maximize != got {
+				t.Errorf("maximize: want: %q, got: %q", tc.maximize, got)
+			}
+			if got := tc.in.Minimize(); tc.minimize != got {
+				t.Errorf("minimize: want: %q, got: %q", tc.minimize, got)
+			}
+		})
+	}
+}
+
+func TestTranslateAliasedTag(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		name       string
+		tag        Tag
+		message    MessageFormat
+		parameters []Parameter
+		translated string
+	}{
+		{"iw", "iw", "{names, list}", []Parameter{P("names", []string{"אליס", "בוב"})}, "אליס ובוב"},
+		{"underscore", "de_CH", "{n, number, ::.00}", []Parameter{P("n", 1234.5)}, "1.234,50"},
+		{"upper-case", "FR-fr", "{n, plural, one {# chat} other {# chats}}", []Parameter{P("n", 1)}, "1 chat"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Translate(tc.tag, tc.message, tc.parameters...)
+			if err != nil {
+				t.Errorf("parse: %s", err)
+			}
+			if tc.translated != got {
+				t.Errorf("expected: '%s', got: '%s'", tc.translated, got)
+			}
+		})
+	}
+}
+
+func TestBundleCanonicalTag(t *testing.T) {
+	requireFullData(t)
+	dir := t.TempDir()
+	content := "[Translations]\nhello = \"Grüezi {n, number, ::.00}\"\n"
+	if err := os.WriteFile(filepath.Join(dir, "de-CH.toml"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	b := NewBundle(dir, "en")
+	for _, tag := range []Tag{"de-CH", "de_ch", "DE-ch"} {
+		if got, want := b.TranslatorForTag(tag).Translate("hello", P("n", 1000)), "Grüezi 1.000,00"; want != got {
+			t.Errorf("%s: want: %s, got: %s", tag, want, got)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package icu
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+const timeZoneParameter = "$time-zone"
+
+// TimeZone returns a parameter that converts date and time arguments to loc
+// before they are formatted. Passing an IANA time zone name as the "$time-zone"
+// parameter has the same effect, e.g. P("$time-zone", "Europe/Berlin").
+func TimeZone(loc *time.Location) Parameter {
+	return P(timeZoneParameter, loc)
+}
+
+var locations = struct {
+	sync.RWMutex
+	m map[string]*time.Location
+}{m: map[string]*time.Location{}}
+
+// locationFrom returns the location of a "$time-zone" parameter value or nil
+// if it does not denote a known time zone.
+func locationFrom(v interface{}) *time.Location {
+	switch v := v.(type) {
+	case *time.Location:
+		return v
+	case string:
+		locations.RLock()
+		loc, ok := locations.m[v]
+		locations.RUnlock()
+		if ok {
+			return loc
+		}
+		loc, err := time.LoadLocation(v)
+		if err != nil {
+			return nil
+		}
+		locations.Lock()
+		locations.m[v] = loc
+		locations.Unlock()
+		return loc
+	}
+	return nil
+}
+
+// ZoneStyle selects the form in which FormatZone displays a time zone.
+type ZoneStyle int
+
+const (
+	// ZoneSpecific is the long specific non-location format,
+	// e.g. "Central European Summer Time".
+	ZoneSpecific ZoneStyle = iota
+	// ZoneSpecificShort is the short specific non-location format,
+	// e.g. "EDT". Falls back to ZoneGMT where the locale has no abbreviation.
+	ZoneSpecificShort
+	// ZoneGeneric is the long generic non-location format,
+	// e.g. "Central European Time".
+	ZoneGeneric
+	// ZoneGenericShort is the short generic non-location format, e.g. "ET".
+	ZoneGenericShort
+	// ZoneGMT is the short localized GMT format, e.g. "GMT+2".
+	ZoneGMT
+	// ZoneGMTLong is the long localized GMT format, e.g. "GMT+02:00".
+	ZoneGMTLong
+	// ZoneLocation is the generic location format, e.g. "Berlin Time".
+	ZoneLocation
+)
+
+// FormatZone returns the localized name of the time zone of t.
+func FormatZone(tag Tag, t time.Time, style ZoneStyle) string {
+	tag = baseLocale(tag)
+	switch style {
+	case ZoneSpecific:
+		return formatZoneField(tag, t, 'z', 4)
+	case ZoneSpecificShort:
+		return formatZoneField(tag, t, 'z', 1)
+	case ZoneGeneric:
+		return formatZoneField(tag, t, 'v', 4)
+	case ZoneGenericShort:
+		return formatZoneField(tag, t, 'v', 1)
+	case ZoneGMT:
+		return formatZoneField(tag, t, 'O', 1)
+	case ZoneGMTLong:
+		return formatZoneField(tag, t, 'O', 4)
+	case ZoneLocation:
+		return formatZoneField(tag, t, 'V', 4)
+	}
+	return ""
+}
+
+type zoneNames struct {
+	generic       string
+	standard      string
+	daylight      string
+	genericShort  string
+	standardShort string
+	daylightShort string
+}
+
+func (n zoneNames) specific(dst bool, short bool) string {
+	switch {
+	case dst && short:
+		return n.daylightShort
+	case dst:
+		return n.daylight
+	case short:
+		return n.standardShort
+	}
+	return n.standard
+}
+
+type zoneSymbols struct {
+	gmtFormat     string
+	gmtZeroFormat string
+	hourFormat    string
+	regionFormat  string
+	metazones     map[string]zoneNames
+	zones         map[string]zoneNames
+	cities        map[string]string
+}
+
+func zoneSymbolsFor(tag Tag) *zoneSymbols {
+	if t, ok := lookupLocale(tag, func(key string) bool { _, ok := zoneSymbolsByLang[key]; return ok }); ok {
+		return zoneSymbolsByLang[string(t)]
+	}
+	return zoneSymbolsByLang[TagEn]
+}
+
+// names returns the names of the zone itself and of its metazone.
+func (s *zoneSymbols) names(id string) []zoneNames {
+	var ns []zoneNames
+	if n, ok := s.zones[id]; ok {
+		ns = append(ns, n)
+	}
+	if mz, ok := zoneMetazones[id]; ok {
+		if n, ok := s.metazones[mz]; ok {
+			ns = append(ns, n)
+		}
+	}
+	return ns
+}
+
+// city returns the exemplar city of a zone, which defaults to the last
+// segment of its identifier.
+func (s *zoneSymbols) city(id string) string {
+	if c, ok := s.cities[id]; ok {
+		return c
+	}
+	if id == "" || strings.HasPrefix(id, "Etc/") {
+		return ""
+	}
+	return strings.Replace(id[strings.LastIndex(id, "/")+1:], "_", " ", -1)
+}
+
+// zoneID returns the IANA identifier of the location of t if it has one.
+func zoneID(t time.Time) string {
+	switch id := t.Location().String(); id {
+	case "UTC":
+		return "Etc/UTC"
+	case "Local":
+		return ""
+	default:
+		return id
+	}
+}
+
+// observesDST reports whether the zone of t switches offsets in the half year
+// around t.
+func observesDST(t time.Time) bool {
+	if t.IsDST() {
+		return true
+	}
+	_, o := t.Zone()
+	_, before := t.AddDate(0, -6, 0).Zone()
+	_, after := t.AddDate(0, 6, 0).Zone()
+	return o != before || o != after
+}
+
+func formatZoneField(tag Tag, t time.Time, letter rune, count int) string {
+	sym := zoneSymbolsFor(tag)
+	id := zoneID(t)
+	_, offset := t.Zone()
+	switch letter {
+	case 'z':
+		short := count < 4
+		for _, n := range sym.names(id) {
+			if s := n.specific(t.IsDST(), short); s != "" {
+				return s
+			}
+		}
+		return sym.gmt(offset, short)
+	case 'v':
+		short := count < 4
+		for _, n := range sym.names(id) {
+			g := n.generic
+			if short {
+				g = n.genericShort
+			}
+			if g == "" && !observesDST(t) {
+				g = n.specific(false, short)
+			}
+			if g != "" {
+				return g
+			}
+		}
+		if c := sym.city(id); c != "" {
+			return strings.Replace(sym.regionFormat, "{0}", c, 1)
+		}
+		return sym.gmt(offset, true)
+	case 'O':
+		return sym.gmt(offset, count < 4)
+	case 'V':
+		switch count {
+		case 1, 2:
+			if id == "" {
+				return "unk"
+			}
+			return id
+		case 3:
+			return sym.city(id)
+		}
+		if c := sym.city(id); c != "" {
+			return strings.Replace(sym.regionFormat, "{0}", c, 1)
+		}
+		return sym.gmt(offset, false)
+	case 'Z':
+		switch count {
+		case 4:
+			return sym.gmt(offset, false)
+		case 5:
+			return isoOffset(offset, true, true, false)
+		}
+		return isoOffset(offset, false, false, false)
+	case 'X', 'x':
+		return isoOffset(offset, count == 3 || count == 5, letter == 'X', count == 1)
+	}
+	return ""
+}
+
+// gmt formats an offset in the localized GMT format, e.g. "GMT+02:00" or
+// "GMT+2" in its short form.
+func (s *zoneSymbols) gmt(offset int, short bool) string {
+	if offset == 0 {
+		return s.gmtZeroFormat
+	}
+	ps := strings.SplitN(s.hourFormat, ";", 2)
+	p := ps[0]
+	if offset < 0 {
+		offset = -offset
+		if len(ps) > 1 {
+			p = ps[1]
+		}
+	}
+	h, m := offset/3600, offset/60%60
+	if short {
+		if i := strings.Index(p, "mm"); i >= 0 && m == 0 {
+			p = p[:strings.LastIndex(p[:i], "H")+1] + p[i+2:]
+		}
+		p = strings.Replace(p, "HH", "H", 1)
+	}
+	p = strings.Replace(p, "HH", pad(h, 2), 1)
+	p = strings.Replace(p, "H", pad(h, 1), 1)
+	p = strings.Replace(p, "mm", pad(m, 2), 1)
+	return strings.Replace(s.gmtFormat, "{0}", p, 1)
+}
+
+// isoOffset formats an offset in the ISO 8601 format, e.g. "+02:00". Short
+// offsets omit zero minutes.
+func isoOffset(offset int, extended bool, utc bool, short bool) string {
+	if offset == 0 && utc {
+		return "Z"
+	}
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	h, m := offset/3600, offset/60%60
+	switch {
+	case short && m == 0:
+		return sign + pad(h, 2)
+	case extended:
+		return sign + pad(h, 2) + ":" + pad(m, 2)
+	}
+	return sign + pad(h, 2) + pad(m, 2)
+}
@@ -0,0 +1,791 @@
+package icu
+
+// zoneMetazones maps IANA time zones to their current CLDR metazone.
+var zoneMetazones = map[string]string{
+	"Europe/London":                  "GMT",
+	"Europe/Dublin":                  "GMT",
+	"Europe/Lisbon":                  "Europe_Western",
+	"Europe/Madrid":                  "Europe_Central",
+	"Europe/Paris":                   "Europe_Central",
+	"Europe/Brussels":                "Europe_Central",
+	"Europe/Amsterdam":               "Europe_Central",
+	"Europe/Berlin":                  "Europe_Central",
+	"Europe/Zurich":                  "Europe_Central",
+	"Europe/Vienna":                  "Europe_Central",
+	"Europe/Rome":                    "Europe_Central",
+	"Europe/Stockholm":               "Europe_Central",
+	"Europe/Oslo":                    "Europe_Central",
+	"Europe/Copenhagen":              "Europe_Central",
+	"Europe/Warsaw":                  "Europe_Central",
+	"Europe/Prague":                  "Europe_Central",
+	"Europe/Budapest":                "Europe_Central",
+	"Europe/Athens":                  "Europe_Eastern",
+	"Europe/Sofia":                   "Europe_Eastern",
+	"Europe/Bucharest":               "Europe_Eastern",
+	"Europe/Helsinki":                "Europe_Eastern",
+	"Europe/Kyiv":                    "Europe_Eastern",
+	"Europe/Istanbul":                "Turkey",
+	"Europe/Moscow":                  "Moscow",
+	"America/New_York":               "America_Eastern",
+	"America/Chicago":                "America_Central",
+	"America/Denver":                 "America_Mountain",
+	"America/Phoenix":                "America_Mountain",
+	"America/Los_Angeles":            "America_Pacific",
+	"America/Anchorage":              "Alaska",
+	"America/Toronto":                "America_Eastern",
+	"America/Vancouver":              "America_Pacific",
+	"America/Halifax":                "Atlantic",
+	"America/Mexico_City":            "America_Central",
+	"America/Bogota":                 "Colombia",
+	"America/Lima":                   "Peru",
+	"America/Santiago":               "Chile",
+	"America/Sao_Paulo":              "Brasilia",
+	"America/Argentina/Buenos_Aires": "Argentina",
+	"Pacific/Honolulu":               "Hawaii",
+	"Pacific/Auckland":               "New_Zealand",
+	"Asia/Dubai":                     "Gulf",
+	"Asia/Karachi":                   "Pakistan",
+	"Asia/Kolkata":                   "India",
+	"Asia/Bangkok":                   "Indochina",
+	"Asia/Jakarta":                   "Indonesia_Western",
+	"Asia/Singapore":                 "Singapore",
+	"Asia/Shanghai":                  "China",
+	"Asia/Hong_Kong":                 "Hong_Kong",
+	"Asia/Taipei":                    "Taipei",
+	"Asia/Seoul":                     "Korea",
+	"Asia/Tokyo":                     "Japan",
+	"Asia/Tehran":                    "Iran",
+	"Asia/Jerusalem":                 "Israel",
+	"Asia/Riyadh":                    "Arabian",
+	"Africa/Cairo":                   "Europe_Eastern",
+	"Africa/Johannesburg":            "Africa_Southern",
+	"Africa/Lagos":                   "Africa_Western",
+	"Africa/Nairobi":                 "Africa_Eastern",
+	"Australia/Perth":                "Australia_Western",
+	"Australia/Adelaide":             "Australia_Central",
+	"Australia/Brisbane":             "Australia_Eastern",
+	"Australia/Sydney":               "Australia_Eastern",
+	"Australia/Melbourne":            "Australia_Eastern",
+}
+
+// Time zone display names of a selection of zones and metazones, maintained
+// by hand after CLDR 48. Languages without names use the English ones.
+var zoneSymbolsByLang = map[string]*zoneSymbols{
+	"en": {
+		gmtFormat:     "GMT{0}",
+		gmtZeroFormat: "GMT",
+		hourFormat:    "+HH:mm;-HH:mm",
+		regionFormat:  "{0} Time",
+		metazones: map[string]zoneNames{
+			"Africa_Eastern":    {standard: "East Africa Time"},
+			"Africa_Southern":   {standard: "South Africa Standard Time"},
+			"Africa_Western":    {standard: "West Africa Time"},
+			"Alaska":            {generic: "Alaska Time", standard: "Alaska Standard Time", daylight: "Alaska Daylight Time", genericShort: "AKT", standardShort: "AKST", daylightShort: "AKDT"},
+			"America_Central":   {generic: "Central Time", standard: "Central Standard Time", daylight: "Central Daylight Time", genericShort: "CT", standardShort: "CST", daylightShort: "CDT"},
+			"America_Eastern":   {generic: "Eastern Time", standard: "Eastern Standard Time", daylight: "Eastern Daylight Time", genericShort: "ET", standardShort: "EST", daylightShort: "EDT"},
+			"America_Mountain":  {generic: "Mountain Time", standard: "Mountain Standard Time", daylight: "Mountain Daylight Time", genericShort: "MT", standardShort: "MST", daylightShort: "MDT"},
+			"America_Pacific":   {generic: "Pacific Time", standard: "Pacific Standard Time", daylight: "Pacific Daylight Time", genericShort: "PT", standardShort: "PST", daylightShort: "PDT"},
+			"Arabian":           {generic: "Arabian Time", standard: "Arabian Standard Time", daylight: "Arabian Daylight Time"},
+			"Argentina":         {generic: "Argentina Time", standard: "Argentina Standard Time", daylight: "Argentina Summer Time"},
+			"Atlantic":          {generic: "Atlantic Time", standard: "Atlantic Standard Time", daylight: "Atlantic Daylight Time", genericShort: "AT", standardShort: "AST", daylightShort: "ADT"},
+			"Australia_Central": {generic: "Australian Central Time", standard: "Australian Central Standard Time", daylight: "Australian Central Daylight Time"},
+			"Australia_Eastern": {generic: "Australian Eastern Time", standard: "Australian Eastern Standard Time", daylight: "Australian Eastern Daylight Time"},
+			"Australia_Western": {generic: "Australian Western Time", standard: "Australian Western Standard Time", daylight: "Australian Western Daylight Time"},
+			"Brasilia":          {generic: "Brasilia Time", standard: "Brasilia Standard Time", daylight: "Brasilia Summer Time"},
+			"Chile":             {generic: "Chile Time", standard: "Chile Standard Time", daylight: "Chile Summer Time"},
+			"China":             {generic: "China Time", standard: "China Standard Time", daylight: "China Daylight Time"},
+			"Colombia":          {generic: "Colombia Time", standard: "Colombia Standard Time", daylight: "Colombia Summer Time"},
+			"Europe_Central":    {generic: "Central European Time", standard: "Central European Standard Time", daylight: "Central European Summer Time"},
+			"Europe_Eastern":    {generic: "Eastern European Time", standard: "Eastern European Standard Time", daylight: "Eastern European Summer Time"},
+			"Europe_Western":    {generic: "Western European Time", standard: "Western European Standard Time", daylight: "Western European Summer Time"},
+			"GMT":               {standard: "Greenwich Mean Time", standardShort: "GMT"},
+			"Gulf":              {standard: "Gulf Standard Time"},
+			"Hawaii":            {standard: "Hawaii-Aleutian Standard Time", standardShort: "HST"},
+			"Hong_Kong":         {generic: "Hong Kong Time", standard: "Hong Kong Standard Time", daylight: "Hong Kong Summer Time"},
+			"India":             {standard: "India Standard Time"},
+			"Indochina":         {standard: "Indochina Time"},
+			"Indonesia_Western": {standard: "Western Indonesia Time"},
+			"Iran":              {generic: "Iran Time", standard: "Iran Standard Time", daylight: "Iran Daylight Time"},
+			"Israel":            {generic: "Israel Time", standard: "Israel Standard Time", daylight: "Israel Daylight Time"},
+			"Japan":             {generic: "Japan Time", standard: "Japan Standard Time", daylight: "Japan Daylight Time"},
+			"Korea":             {generic: "Korean Time", standard: "Korean Standard Time", daylight: "Korean Daylight Time"},
+			"Moscow":            {generic: "Moscow Time", standard: "Moscow Standard Time", daylight: "Moscow Summer Time"},
+			"New_Zealand":       {generic: "New Zealand Time", standard: "New Zealand Standard Time", daylight: "New Zealand Daylight Time"},
+			"Pakistan":          {generic: "Pakistan Time", standard: "Pakistan Standard Time", daylight: "Pakistan Summer Time"},
+			"Peru":              {generic: "Peru Time", standard: "Peru Standard Time", daylight: "Peru Summer Time"},
+			"Singapore":         {standard: "Singapore Standard Time"},
+			"Taipei":            {generic: "Taiwan Time", standard: "Taiwan Standard Time", daylight: "Taiwan Daylight Time"},
+			"Turkey":            {generic: "Türkiye Time", standard: "Türkiye Standard Time", daylight: "Türkiye Summer Time"},
+		},
+		zones: map[string]zoneNames{
+			"Etc/UTC":       {standard: "Coordinated Universal Time", standardShort: "UTC"},
+			"Europe/London": {daylight: "British Summer Time"},
+			"Europe/Dublin": {daylight: "Irish Standard Time"},
+		},
+		cities: map[string]string{
+			"Europe/Kyiv":         "Kyiv",
+			"America/Mexico_City": "Mexico City",
+			"America/Bogota":      "Bogotá",
+			"America/Sao_Paulo":   "São Paulo",
+			"Asia/Kolkata":        "Kolkata",
+		},
+	},
+	"de": {
+		gmtFormat:     "GMT{0}",
+		gmtZeroFormat: "GMT",
+		hourFormat:    "+HH:mm;-HH:mm",
+		regionFormat:  "{0} (Ortszeit)",
+		metazones: map[string]zoneNames{
+			"Africa_Eastern":    {standard: "Ostafrikanische Zeit"},
+			"Africa_Southern":   {standard: "Südafrikanische Zeit"},
+			"Africa_Western":    {standard: "Westafrikanische Zeit"},
+			"Alaska":            {generic: "Alaska-Zeit", standard: "Alaska-Normalzeit", daylight: "Alaska-Sommerzeit"},
+			"America_Central":   {generic: "Nordamerikanische Zentralzeit", standard: "Nordamerikanische Zentral-Normalzeit", daylight: "Nordamerikanische Zentral-Sommerzeit"},
+			"America_Eastern":   {generic: "Nordamerikanische Ostküstenzeit", standard: "Nordamerikanische Ostküsten-Normalzeit", daylight: "Nordamerikanische Ostküsten-Sommerzeit"},
+			"America_Mountain":  {generic: "Rocky-Mountains-Zeit", standard: "Rocky-Mountains-Normalzeit", daylight: "Rocky-Mountains-Sommerzeit"},
+			"America_Pacific":   {generic: "Nordamerikanische Westküstenzeit", standard: "Nordamerikanische Westküsten-Normalzeit", daylight: "Nordamerikanische Westküsten-Sommerzeit"},
+			"Arabian":           {generic: "Arabische Zeit", standard: "Arabische Normalzeit", daylight: "Arabische Sommerzeit"},
+			"Argentina":         {generic: "Argentinische Zeit", standard: "Argentinische Normalzeit", daylight: "Argentinische Sommerzeit"},
+			"Atlantic":          {generic: "Atlantik-Zeit", standard: "Atlantik-Normalzeit", daylight: "Atlantik-Sommerzeit"},
+			"Australia_Central": {generic: "Zentralaustralische Zeit", standard: "Zentralaustralische Normalzeit", daylight: "Zentralaustralische Sommerzeit"},
+			"Australia_Eastern": {generic: "Ostaustralische Zeit", standard: "Ostaustralische Normalzeit", daylight: "Ostaustralische Sommerzeit"},
+			"Australia_Western": {generic: "Westaustralische Zeit", standard: "Westaustralische Normalzeit", daylight: "Westaustralische Sommerzeit"},
+			"Brasilia":          {generic: "Brasília-Zeit", standard: "Brasília-Normalzeit", daylight: "Brasília-Sommerzeit"},
+			"Chile":             {generic: "Chilenische Zeit", standard: "Chilenische Normalzeit", daylight: "Chilenische Sommerzeit"},
+			"China":             {generic: "Chinesische Zeit", standard: "Chinesische Normalzeit", daylight: "Chinesische Sommerzeit"},
+			"Colombia":          {generic: "Kolumbianische Zeit", standard: "Kolumbianische Normalzeit", daylight: "Kolumbianische Sommerzeit"},
+			"Europe_Central":    {generic: "Mitteleuropäische Zeit", standard: "Mitteleuropäische Normalzeit", daylight: "Mitteleuropäische Sommerzeit", genericShort: "MEZ", standardShort: "MEZ", daylightShort: "MESZ"},
+			"Europe_Eastern":    {generic: "Osteuropäische Zeit", standard: "Osteuropäische Normalzeit", daylight: "Osteuropäische Sommerzeit", genericShort: "OEZ", standardShort: "OEZ", daylightShort: "OESZ"},
+			"Europe_Western":    {generic: "Westeuropäische Zeit", standard: "Westeuropäische Normalzeit", daylight: "Westeuropäische Sommerzeit", genericShort: "WEZ", standardShort: "WEZ", daylightShort: "WESZ"},
+			"GMT":               {standard: "Mittlere Greenwich-Zeit"},
+			"Gulf":              {standard: "Golf-Zeit"},
+			"Hawaii":            {standard: "Hawaii-Aleuten-Normalzeit"},
+			"Hong_Kong":         {generic: "Hongkong-Zeit", standard: "Hongkong-Normalzeit", daylight: "Hongkong-Sommerzeit"},
+			"India":             {standard: "Indische Normalzeit"},
+			"Indochina":         {standard: "Indochina-Zeit"},
+			"Indonesia_Western": {standard: "Westindonesische Zeit"},
+			"Iran":              {generic: "Iranische Zeit", standard: "Iranische Normalzeit", daylight: "Iranische Sommerzeit"},
+			"Israel":            {generic: "Israelische Zeit", standard: "Israelische Normalzeit", daylight: "Israelische Sommerzeit"},
+			"Japan":             {generic: "Japanische Zeit", standard: "Japanische Normalzeit", daylight: "Japanische Sommerzeit"},
+			"Korea":             {generic: "Koreanische Zeit", standard: "Koreanische Normalzeit", daylight: "Koreanische Sommerzeit"},
+			"Moscow":            {generic: "Moskauer Zeit", standard: "Moskauer Normalzeit", daylight: "Moskauer Sommerzeit"},
+			"New_Zealand":       {generic: "Neuseeland-Zeit", standard: "Neuseeland-Normalzeit", daylight: "Neuseeland-Sommerzeit"},
+			"Pakistan":          {generic: "Pakistanische Zeit", standard: "Pakistanische Normalzeit", daylight: "Pakistanische Sommerzeit"},
+			"Peru":              {generic: "Peruanische Zeit", standard: "Peruanische Normalzeit", daylight: "Peruanische Sommerzeit"},
+			"Singapore":         {standard: "Singapurische Normalzeit"},
+			"Taipei":            {generic: "Taipeh-Zeit", standard: "Taipeh-Normalzeit", daylight: "Taipeh-Sommerzeit"},
+			"Turkey":            {generic: "Türkische Zeit", standard: "Türkische Normalzeit", daylight: "Türkische Sommerzeit"},
+		},
+		zones: map[string]zoneNames{
+			"Etc/UTC":       {standard: "Koordinierte Weltzeit", standardShort: "UTC"},
+			"Europe/London": {daylight: "Britische Sommerzeit"},
+			"Europe/Dublin": {daylight: "Irische Sommerzeit"},
+		},
+		cities: map[string]string{
+			"Europe/Lisbon":       "Lissabon",
+			"Europe/Brussels":     "Brüssel",
+			"Europe/Zurich":       "Zürich",
+			"Europe/Vienna":       "Wien",
+			"Europe/Rome":         "Rom",
+			"Europe/Copenhagen":   "Kopenhagen",
+			"Europe/Warsaw":       "Warschau",
+			"Europe/Prague":       "Prag",
+			"Europe/Athens":       "Athen",
+			"Europe/Bucharest":    "Bukarest",
+			"Europe/Kyiv":         "Kiew",
+			"Europe/Moscow":       "Moskau",
+			"America/Mexico_City": "Mexiko-Stadt",
+			"America/Bogota":      "Bogotá",
+			"America/Sao_Paulo":   "São Paulo",
+			"Pacific/Honolulu":    "Honolulu",
+			"Asia/Karachi":        "Karatschi",
+			"Asia/Kolkata":        "Kalkutta",
+			"Asia/Singapore":      "Singapur",
+			"Asia/Hong_Kong":      "Hongkong",
+			"Asia/Taipei":         "Taipeh",
+			"Asia/Tokyo":          "Tokio",
+			"Asia/Tehran":         "Teheran",
+			"Asia/Riyadh":         "Riad",
+			"Africa/Cairo":        "Kairo",
+		},
+	},
+	"fr": {
+		gmtFormat:     "UTC{0}",
+		gmtZeroFormat: "UTC",
+		hourFormat:    "+HH:mm;−HH:mm",
+		regionFormat:  "heure : {0}",
+		metazones: map[string]zoneNames{
+			"Africa_Eastern":    {standard: "heure normale d’Afrique de l’Est"},
+			"Africa_Southern":   {standard: "heure normale d’Afrique méridionale"},
+			"Africa_Western":    {standard: "heure d’Afrique de l’Ouest"},
+			"Alaska":            {generic: "heure de l’Alaska", standard: "heure normale de l’Alaska", daylight: "heure d’été de l’Alaska"},
+			"America_Central":   {generic: "heure du centre nord-américain", standard: "heure normale du centre nord-américain", daylight: "heure d’été du centre nord-américain"},
+			"America_Eastern":   {generic: "heure de l’Est nord-américain", standard: "heure normale de l’Est nord-américain", daylight: "heure d’été de l’Est nord-américain"},
+			"America_Mountain":  {generic: "heure des Rocheuses", standard: "heure normale des Rocheuses", daylight: "heure d’été des Rocheuses"},
+			"America_Pacific":   {generic: "heure du Pacifique nord-américain", standard: "heure normale du Pacifique nord-américain", daylight: "heure d’été du Pacifique nord-américain"},
+			"Arabian":           {generic: "heure de l’Arabie", standard: "heure normale de l’Arabie", daylight: "heure d’été de l’Arabie"},
+			"Argentina":         {generic: "heure de l’Argentine", standard: "heure normale d’Argentine", daylight: "heure d’été de l’Argentine"},
+			"Atlantic":          {generic: "heure de l’Atlantique", standard: "heure normale de l’Atlantique", daylight: "heure d’été de l’Atlantique"},
+			"Australia_Central": {generic: "heure du centre de l’Australie", standard: "heure normale du centre de l’Australie", daylight: "heure d’été du centre de l’Australie"},
+			"Australia_Eastern": {generic: "heure de l’Est de l’Australie", standard: "heure normale de l’Est de l’Australie", daylight: "heure d’été de l’Est de l’Australie"},
+			"Australia_Western": {generic: "heure de l’Ouest de l’Australie", standard: "heure normale de l’Ouest de l’Australie", daylight: "heure d’été de l’Ouest de l’Australie"},
+			"Brasilia":          {generic: "heure de Brasilia", standard: "heure normale de Brasilia", daylight: "heure d’été de Brasilia"},
+			"Chile":             {generic: "heure du Chili", standard: "heure normale du Chili", daylight: "heure d’été du Chili"},
+			"China":             {generic: "heure de la Chine", standard: "heure normale de la Chine", daylight: "heure d’été de Chine"},
+			"Colombia":          {generic: "heure de Colombie", standard: "heure normale de Colombie", daylight: "heure d’été de Colombie"},
+			"Europe_Central":    {generic: "heure d’Europe centrale", standard: "heure normale d’Europe centrale", daylight: "heure d’été d’Europe centrale"},
+			"Europe_Eastern":    {generic: "heure d’Europe de l’Est", standard: "heure normale d’Europe de l’Est", daylight: "heure d’été d’Europe de l’Est"},
+			"Europe_Western":    {generic: "heure d’Europe de l’Ouest", standard: "heure normale d’Europe de l’Ouest", daylight: "heure d’été d’Europe de l’Ouest"},
+			"GMT":               {standard: "heure moyenne de Greenwich"},
+			"Gulf":              {standard: "heure du Golfe"},
+			"Hawaii":            {standard: "heure normale d’Hawaï - Aléoutiennes"},
+			"Hong_Kong":         {generic: "heure de Hong Kong", standard: "heure normale de Hong Kong", daylight: "heure d’été de Hong Kong"},
+			"India":             {standard: "heure de l’Inde"},
+			"Indochina":         {standard: "heure d’Indochine"},
+			"Indonesia_Western": {standard: "heure de l’Ouest indonésien"},
+			"Iran":              {generic: "heure de l’Iran", standard: "heure normale d’Iran", daylight: "heure d’été d’Iran"},
+			"Israel":            {generic: "heure d’Israël", standard: "heure normale d’Israël", daylight: "heure d’été d’Israël"},
+			"Japan":             {generic: "heure du Japon", standard: "heure normale du Japon", daylight: "heure d’été du Japon"},
+			"Korea":             {generic: "heure de la Corée", standard: "heure normale de la Corée", daylight: "heure d’été de Corée"},
+			"Moscow":            {generic: "heure de Moscou", standard: "heure normale de Moscou", daylight: "heure d’été de Moscou"},
+			"New_Zealand":       {generic: "heure de la Nouvelle-Zélande", standard: "heure normale de la Nouvelle-Zélande", daylight: "heure d’été de la Nouvelle-Zélande"},
+			"Pakistan":          {generic: "heure du Pakistan", standard: "heure normale du Pakistan", daylight: "heure d’été du Pakistan"},
+			"Peru":              {generic: "heure du Pérou", standard: "heure normale du Pérou", daylight: "heure d’été du Pérou"},
+			"Singapore":         {standard: "heure de Singapour"},
+			"Taipei":            {generic: "heure de Taipei", standard: "heure normale de Taipei", daylight: "heure d’été de Taipei"},
+			"Turkey":            {generic: "heure de Turquie", standard: "heure normale de Turquie", daylight: "heure avancée de Turquie"},
+		},
+		zones: map[string]zoneNames{
+			"Etc/UTC":       {standard: "temps universel coordonné", standardShort: "UTC"},
+			"Europe/London": {daylight: "heure d’été britannique"},
+			"Europe/Dublin": {daylight: "heure d’été irlandaise"},
+		},
+		cities: map[string]string{
+			"Europe/London":       "Londres",
+			"Europe/Lisbon":       "Lisbonne",
+			"Europe/Brussels":     "Bruxelles",
+			"Europe/Vienna":       "Vienne",
+			"Europe/Copenhagen":   "Copenhague",
+			"Europe/Warsaw":       "Varsovie",
+			"Europe/Athens":       "Athènes",
+			"Europe/Bucharest":    "Bucarest",
+			"Europe/Kyiv":         "Kiev",
+			"Europe/Moscow":       "Moscou",
+			"America/Mexico_City": "Mexico",
+			"America/Bogota":      "Bogotá",
+			"America/Sao_Paulo":   "São Paulo",
+			"Pacific/Honolulu":    "Honolulu",
+			"Asia/Dubai":          "Dubaï",
+			"Asia/Kolkata":        "Calcutta",
+			"Asia/Singapore":      "Singapour",
+			"Asia/Seoul":          "Séoul",
+			"Asia/Tehran":         "Téhéran",
+			"Asia/Jerusalem":      "Jérusalem",
+			"Asia/Riyadh":         "Riyad",
+			"Africa/Cairo":        "Le Caire",
+			"Australia/Adelaide":  "Adélaïde",
+		},
+	},
+	"es": {
+		gmtFormat:     "GMT{0}",
+		gmtZeroFormat: "GMT",
+		hourFormat:    "+HH:mm;-HH:mm",
+		regionFormat:  "hora de {0}",
+		metazones: map[string]zoneNames{
+			"Africa_Eastern":    {standard: "hora de África oriental"},
+			"Africa_Southern":   {standard: "hora de Sudáfrica"},
+			"Africa_Western":    {standard: "hora de África occidental"},
+			"Alaska":            {generic: "hora de Alaska", standard: "hora estándar de Alaska", daylight: "hora de verano de Alaska"},
+			"America_Central":   {generic: "hora central", standard: "hora estándar central", daylight: "hora de verano central"},
+			"America_Eastern":   {generic: "hora oriental", standard: "hora estándar oriental", daylight: "hora de verano oriental"},
+			"America_Mountain":  {generic: "hora de las Montañas Rocosas", standard: "hora estándar de las Montañas Rocosas", daylight: "hora de verano de las Montañas Rocosas"},
+			"America_Pacific":   {generic: "hora del Pacífico", standard: "hora estándar del Pacífico", daylight: "hora de verano del Pacífico"},
+			"Arabian":           {generic: "hora de Arabia", standard: "hora estándar de Arabia", daylight: "hora de verano de Arabia"},
+			"Argentina":         {generic: "hora de Argentina", standard: "hora estándar de Argentina", daylight: "hora de verano de Argentina"},
+			"Atlantic":          {generic: "hora del Atlántico", standard: "hora estándar del Atlántico", daylight: "hora de verano del Atlántico"},
+			"Australia_Central": {generic: "hora de Australia central", standard: "hora estándar de Australia central", daylight: "hora de verano de Australia central"},
+			"Australia_Eastern": {generic: "hora de Australia oriental", standard: "hora estándar de Australia oriental", daylight: "hora de verano de Australia oriental"},
+			"Australia_Western": {generic: "hora de Australia occidental", standard: "hora estándar de Australia occidental", daylight: "hora de verano de Australia occidental"},
+			"Brasilia":          {generic: "hora de Brasilia", standard: "hora estándar de Brasilia", daylight: "hora de verano de Brasilia"},
+			"Chile":             {generic: "hora de Chile", standard: "hora estándar de Chile", daylight: "hora de verano de Chile"},
+			"China":             {generic: "hora de China", standard: "hora estándar de China", daylight: "hora de verano de China"},
+			"Colombia":          {generic: "hora de Colombia", standard: "hora estándar de Colombia", daylight: "hora de verano de Colombia"},
+			"Europe_Central":    {generic: "hora de Europa central", standard: "hora estándar de Europa central", daylight: "hora de verano de Europa central", genericShort: "CET", standardShort: "CET", daylightShort: "CEST"},
+			"Europe_Eastern":    {generic: "hora de Europa oriental", standard: "hora estándar de Europa oriental", daylight: "hora de verano de Europa oriental", genericShort: "EET", standardShort: "EET", daylightShort: "EEST"},
+			"Europe_Western":    {generic: "hora de Europa occidental", standard: "hora estándar de Europa occidental", daylight: "hora de verano de Europa occidental", genericShort: "WET", standardShort: "WET", daylightShort: "WEST"},
+			"GMT":               {standard: "hora del meridiano de Greenwich", standardShort: "GMT"},
+			"Gulf":              {standard: "hora estándar del Golfo"},
+			"Hawaii":            {standard: "hora estándar de Hawái-Aleutianas"},
+			"Hong_Kong":         {generic: "hora de Hong Kong", standard: "hora estándar de Hong Kong", daylight: "hora de verano de Hong Kong"},
+			"India":             {standard: "hora estándar de la India"},
+			"Indochina":         {standard: "hora de Indochina"},
+			"Indonesia_Western": {standard: "hora de Indonesia occidental"},
+			"Iran":              {generic: "hora de Irán", standard: "hora estándar de Irán", daylight: "hora de verano de Irán"},
+			"Israel":            {generic: "hora de Israel", standard: "hora estándar de Israel", daylight: "hora de verano de Israel"},
+			"Japan":             {generic: "hora de Japón", standard: "hora estándar de Japón", daylight: "hora de verano de Japón"},
+			"Korea":             {generic: "hora de Corea", standard: "hora estándar de Corea", daylight: "hora de verano de Corea"},
+			"Moscow":            {generic: "hora de Moscú", standard: "hora estándar de Moscú", daylight: "hora de verano de Moscú"},
+			"New_Zealand":       {generic: "hora de Nueva Zelanda", standard: "hora estándar de Nueva Zelanda", daylight: "hora de verano de Nueva Zelanda"},
+			"Pakistan":          {generic: "hora de Pakistán", standard: "hora estándar de Pakistán", daylight: "hora de verano de Pakistán"},
+			"Peru":              {generic: "hora de Perú", standard: "hora estándar de Perú", daylight: "hora de verano de Perú"},
+			"Singapore":         {standard: "hora de Singapur"},
+			"Taipei":            {generic: "hora de Taipéi", standard: "hora estándar de Taipéi", daylight: "hora de verano de Taipéi"},
+			"Turkey":            {generic: "Hora de Turquía", standard: "Hora estándar de Turquía", daylight: "Hora de verano de Turquía"},
+		},
+		zones: map[string]zoneNames{
+			"Etc/UTC":       {standard: "tiempo universal coordinado", standardShort: "UTC"},
+			"Europe/London": {daylight: "hora de verano británica"},
+			"Europe/Dublin": {daylight: "hora de verano de Irlanda"},
+		},
+		cities: map[string]string{
+			"Europe/London":       "Londres",
+			"Europe/Dublin":       "Dublín",
+			"Europe/Lisbon":       "Lisboa",
+			"Europe/Paris":        "París",
+			"Europe/Brussels":     "Bruselas",
+			"Europe/Amsterdam":    "Ámsterdam",
+			"Europe/Berlin":       "Berlín",
+			"Europe/Zurich":       "Zúrich",
+			"Europe/Vienna":       "Viena",
+			"Europe/Rome":         "Roma",
+			"Europe/Stockholm":    "Estocolmo",
+			"Europe/Copenhagen":   "Copenhague",
+			"Europe/Warsaw":       "Varsovia",
+			"Europe/Prague":       "Praga",
+			"Europe/Athens":       "Atenas",
+			"Europe/Sofia":        "Sofía",
+			"Europe/Bucharest":    "Bucarest",
+			"Europe/Kyiv":         "Kiev",
+			"Europe/Istanbul":     "Estambul",
+			"Europe/Moscow":       "Moscú",
+			"America/New_York":    "Nueva York",
+			"America/Los_Angeles": "Los Ángeles",
+			"America/Mexico_City": "Ciudad de México",
+			"America/Bogota":      "Bogotá",
+			"America/Santiago":    "Santiago de Chile",
+			"America/Sao_Paulo":   "São Paulo",
+			"Pacific/Honolulu":    "Honolulú",
+			"Asia/Dubai":          "Dubái",
+			"Asia/Kolkata":        "Calcuta",
+			"Asia/Jakarta":        "Yakarta",
+			"Asia/Singapore":      "Singapur",
+			"Asia/Shanghai":       "Shanghái",
+			"Asia/Taipei":         "Taipéi",
+			"Asia/Seoul":          "Seúl",
+			"Asia/Tokyo":          "Tokio",
+			"Asia/Tehran":         "Teherán",
+			"Asia/Jerusalem":      "Jerusalén",
+			"Asia/Riyadh":         "Riad",
+			"Africa/Cairo":        "El Cairo",
+			"Africa/Johannesburg": "Johannesburgo",
+			"Australia/Adelaide":  "Adelaida",
+			"Australia/Sydney":    "Sídney",
+		},
+	},
+	"it": {
+		gmtFormat:     "GMT{0}",
+		gmtZeroFormat: "GMT",
+		hourFormat:    "+HH:mm;-HH:mm",
+		regionFormat:  "Ora {0}",
+		metazones: map[string]zoneNames{
+			"Africa_Eastern":    {standard: "Ora dell’Africa orientale"},
+			"Africa_Southern":   {standard: "Ora dell’Africa meridionale"},
+			"Africa_Western":    {standard: "Ora dell’Africa occidentale"},
+			"Alaska":            {generic: "Ora dell’Alaska", standard: "Ora standard dell’Alaska", daylight: "Ora legale dell’Alaska"},
+			"America_Central":   {generic: "Ora centrale USA", standard: "Ora standard centrale USA", daylight: "Ora legale centrale USA"},
+			"America_Eastern":   {generic: "Ora orientale USA", standard: "Ora standard orientale USA", daylight: "Ora legale orientale USA"},
+			"America_Mountain":  {generic: "Ora Montagne Rocciose USA", standard: "Ora standard Montagne Rocciose USA", daylight: "Ora legale Montagne Rocciose USA"},
+			"America_Pacific":   {generic: "Ora del Pacifico USA", standard: "Ora standard del Pacifico USA", daylight: "Ora legale del Pacifico USA"},
+			"Arabian":           {generic: "Ora araba", standard: "Ora standard araba", daylight: "Ora legale araba"},
+			"Argentina":         {generic: "Ora dell’Argentina", standard: "Ora standard dell’Argentina", daylight: "Ora legale dell’Argentina"},
+			"Atlantic":          {generic: "Ora dell’Atlantico", standard: "Ora standard dell’Atlantico", daylight: "Ora legale dell’Atlantico"},
+			"Australia_Central": {generic: "Ora dell’Australia centrale", standard: "Ora standard dell’Australia centrale", daylight: "Ora legale dell’Australia centrale"},
+			"Australia_Eastern": {generic: "Ora dell’Australia orientale", standard: "Ora standard dell’Australia orientale", daylight: "Ora legale dell’Australia orientale"},
+			"Australia_Western": {generic: "Ora dell’Australia occidentale", standard: "Ora standard dell’Australia occidentale", daylight: "Ora legale dell’Australia occidentale"},
+			"Brasilia":          {generic: "Ora di Brasilia", standard: "Ora standard di Brasilia", daylight: "Ora legale di Brasilia"},
+			"Chile":             {generic: "Ora del Cile", standard: "Ora standard del Cile", daylight: "Ora legale del Cile"},
+			"China":             {generic: "Ora della Cina", standard: "Ora standard della Cina", daylight: "Ora legale della Cina"},
+			"Colombia":          {generic: "Ora della Colombia", standard: "Ora standard della Colombia", daylight: "Ora legale della Colombia"},
+			"Europe_Central":    {generic: "Ora dell’Europa centrale", standard: "Ora standard dell’Europa centrale", daylight: "Ora legale dell’Europa centrale", genericShort: "CET", standardShort: "CET", daylightShort: "CEST"},
+			"Europe_Eastern":    {generic: "Ora dell’Europa orientale", standard: "Ora standard dell’Europa orientale", daylight: "Ora legale dell’Europa orientale", genericShort: "EET", standardShort: "EET", daylightShort: "EEST"},
+			"Europe_Western":    {generic: "Ora dell’Europa occidentale", standard: "Ora standard dell’Europa occidentale", daylight: "Ora legale dell’Europa occidentale", genericShort: "WET", standardShort: "WET", daylightShort: "WEST"},
+			"GMT":               {standard: "Ora del meridiano di Greenwich"},
+			"Gulf":              {standard: "Ora del Golfo"},
+			"Hawaii":            {standard: "Ora standard delle Isole Hawaii-Aleutine"},
+			"Hong_Kong":         {generic: "Ora di Hong Kong", standard: "Ora standard di Hong Kong", daylight: "Ora legale di Hong Kong"},
+			"India":             {standard: "Ora standard dell’India"},
+			"Indochina":         {standard: "Ora dell’Indocina"},
+			"Indonesia_Western": {standard: "Ora dell’Indonesia occidentale"},
+			"Iran":              {generic: "Ora dell’Iran", standard: "Ora standard dell’Iran", daylight: "Ora legale dell’Iran"},
+			"Israel":            {generic: "Ora di Israele", standard: "Ora standard di Israele", daylight: "Ora legale di Israele"},
+			"Japan":             {generic: "Ora del Giappone", standard: "Ora standard del Giappone", daylight: "Ora legale del Giappone"},
+			"Korea":             {generic: "Ora coreana", standard: "Ora standard coreana", daylight: "Ora legale coreana"},
+			"Moscow":            {generic: "Ora di Mosca", standard: "Ora standard di Mosca", daylight: "Ora legale di Mosca"},
+			"New_Zealand":       {generic: "Ora della Nuova Zelanda", standard: "Ora standard della Nuova Zelanda", daylight: "Ora legale della Nuova Zelanda"},
+			"Pakistan":          {generic: "Ora del Pakistan", standard: "Ora standard del Pakistan", daylight: "Ora legale del Pakistan"},
+			"Peru":              {generic: "Ora del Perù", standard: "Ora standard del Perù", daylight: "Ora legale del Perù"},
+			"Singapore":         {standard: "Ora di Singapore"},
+			"Taipei":            {generic: "Ora di Taipei", standard: "Ora standard di Taipei", daylight: "Ora legale di Taipei"},
+		},
+		zones: map[string]zoneNames{
+			"Etc/UTC":       {standard: "Tempo coordinato universale", standardShort: "UTC"},
+			"Europe/London": {daylight: "Ora legale del Regno Unito"},
+			"Europe/Dublin": {daylight: "Ora legale dell’Irlanda"},
+		},
+		cities: map[string]string{
+			"Europe/London":       "Londra",
+			"Europe/Dublin":       "Dublino",
+			"Europe/Lisbon":       "Lisbona",
+			"Europe/Paris":        "Parigi",
+			"Europe/Brussels":     "Bruxelles",
+			"Europe/Berlin":       "Berlino",
+			"Europe/Zurich":       "Zurigo",
+			"Europe/Rome":         "Roma",
+			"Europe/Stockholm":    "Stoccolma",
+			"Europe/Copenhagen":   "Copenaghen",
+			"Europe/Warsaw":       "Varsavia",
+			"Europe/Prague":       "Praga",
+			"Europe/Athens":       "Atene",
+			"Europe/Bucharest":    "Bucarest",
+			"Europe/Kyiv":         "Kiev",
+			"Europe/Moscow":       "Mosca",
+			"America/Mexico_City": "Città del Messico",
+			"America/Bogota":      "Bogotá",
+			"America/Sao_Paulo":   "San Paolo",
+			"Pacific/Honolulu":    "Honolulu",
+			"Asia/Kolkata":        "Calcutta",
+			"Asia/Jakarta":        "Giacarta",
+			"Asia/Seoul":          "Seul",
+			"Asia/Tehran":         "Teheran",
+			"Asia/Jerusalem":      "Gerusalemme",
+			"Asia/Riyadh":         "Riyad",
+			"Africa/Cairo":        "Il Cairo",
+		},
+	},
+	"pt": {
+		gmtFormat:     "GMT{0}",
+		gmtZeroFormat: "GMT",
+		hourFormat:    "+HH:mm;-HH:mm",
+		regionFormat:  "Horário {0}",
+		metazones: map[string]zoneNames{
+			"Africa_Eastern":    {standard: "Horário da África Oriental"},
+			"Africa_Southern":   {standard: "Horário da África do Sul"},
+			"Africa_Western":    {standard: "Horário da África Ocidental"},
+			"Alaska":            {generic: "Horário do Alasca", standard: "Horário Padrão do Alasca", daylight: "Horário de Verão do Alasca"},
+			"America_Central":   {generic: "Horário Central", standard: "Horário Padrão Central", daylight: "Horário de Verão Central"},
+			"America_Eastern":   {generic: "Horário do Leste", standard: "Horário Padrão do Leste", daylight: "Horário de Verão do Leste"},
+			"America_Mountain":  {generic: "Horário das Montanhas", standard: "Horário Padrão das Montanhas", daylight: "Horário de Verão das Montanhas"},
+			"America_Pacific":   {generic: "Horário do Pacífico", standard: "Horário Padrão do Pacífico", daylight: "Horário de Verão do Pacífico"},
+			"Arabian":           {generic: "Horário da Arábia", standard: "Horário Padrão da Arábia", daylight: "Horário de Verão da Arábia"},
+			"Argentina":         {generic: "Horário da Argentina", standard: "Horário Padrão da Argentina", daylight: "Horário de Verão da Argentina"},
+			"Atlantic":          {generic: "Horário do Atlântico", standard: "Horário Padrão do Atlântico", daylight: "Horário de Verão do Atlântico"},
+			"Australia_Central": {generic: "Horário da Austrália Central", standard: "Horário Padrão da Austrália Central", daylight: "Horário de Verão da Austrália Central"},
+			"Australia_Eastern": {generic: "Horário da Austrália Oriental", standard: "Horário Padrão da Austrália Oriental", daylight: "Horário de Verão da Austrália Oriental"},
+			"Australia_Western": {generic: "Horário da Austrália Ocidental", standard: "Horário Padrão da Austrália Ocidental", daylight: "Horário de Verão da Austrália Ocidental"},
+			"Brasilia":          {generic: "Horário de Brasília", standard: "Horário Padrão de Brasília", daylight: "Horário de Verão de Brasília", genericShort: "BRT", standardShort: "BRT", daylightShort: "BRST"},
+			"Chile":             {generic: "Horário do Chile", standard: "Horário Padrão do Chile", daylight: "Horário de Verão do Chile"},
+			"China":             {generic: "Horário da China", standard: "Horário Padrão da China", daylight: "Horário de Verão da China"},
+			"Colombia":          {generic: "Horário da Colômbia", standard: "Horário Padrão da Colômbia", daylight: "Horário de Verão da Colômbia"},
+			"Europe_Central":    {generic: "Horário da Europa Central", standard: "Horário Padrão da Europa Central", daylight: "Horário de Verão da Europa Central"},
+			"Europe_Eastern":    {generic: "Horário da Europa Oriental", standard: "Horário Padrão da Europa Oriental", daylight: "Horário de Verão da Europa Oriental"},
+			"Europe_Western":    {generic: "Horário da Europa Ocidental", standard: "Horário Padrão da Europa Ocidental", daylight: "Horário de Verão da Europa Ocidental"},
+			"GMT":               {standard: "Horário do Meridiano de Greenwich"},
+			"Gulf":              {standard: "Horário do Golfo"},
+			"Hawaii":            {standard: "Horário Padrão do Havaí e Ilhas Aleutas"},
+			"Hong_Kong":         {generic: "Horário de Hong Kong", standard: "Horário Padrão de Hong Kong", daylight: "Horário de Verão de Hong Kong"},
+			"India":             {standard: "Horário Padrão da Índia"},
+			"Indochina":         {standard: "Horário da Indochina"},
+			"Indonesia_Western": {standard: "Horário da Indonésia Ocidental"},
+			"Iran":              {generic: "Horário do Irã", standard: "Horário Padrão do Irã", daylight: "Horário de Verão do Irã"},
+			"Israel":            {generic: "Horário de Israel", standard: "Horário Padrão de Israel", daylight: "Horário de Verão de Israel"},
+			"Japan":             {generic: "Horário do Japão", standard: "Horário Padrão do Japão", daylight: "Horário de Verão do Japão"},
+			"Korea":             {generic: "Horário da Coreia", standard: "Horário Padrão da Coreia", daylight: "Horário de Verão da Coreia"},
+			"Moscow":            {generic: "Horário de Moscou", standard: "Horário Padrão de Moscou", daylight: "Horário de Verão de Moscou"},
+			"New_Zealand":       {generic: "Horário da Nova Zelândia", standard: "Horário Padrão da Nova Zelândia", daylight: "Horário de Verão da Nova Zelândia"},
+			"Pakistan":          {generic: "Horário do Paquistão", standard: "Horário Padrão do Paquistão", daylight: "Horário de Verão do Paquistão"},
+			"Peru":              {generic: "Horário do Peru", standard: "Horário Padrão do Peru", daylight: "Horário de Verão do Peru"},
+			"Singapore":         {standard: "Horário Padrão de Singapura"},
+			"Taipei":            {generic: "Horário de Taipei", standard: "Horário Padrão de Taipei", daylight: "Horário de Verão de Taipei"},
+			"Turkey":            {generic: "Horário da Turquia", standard: "Horário Padrão da Turquia", daylight: "Horário de Verão da Turquia"},
+		},
+		zones: map[string]zoneNames{
+			"Etc/UTC":       {standard: "Horário Universal Coordenado", standardShort: "UTC"},
+			"Europe/London": {daylight: "Horário de Verão Britânico"},
+			"Europe/Dublin": {daylight: "Horário Padrão Irlandês"},
+		},
+		cities: map[string]string{
+			"Europe/London":       "Londres",
+			"Europe/Lisbon":       "Lisboa",
+			"Europe/Madrid":       "Madri",
+			"Europe/Brussels":     "Bruxelas",
+			"Europe/Amsterdam":    "Amsterdã",
+			"Europe/Berlin":       "Berlim",
+			"Europe/Zurich":       "Zurique",
+			"Europe/Vienna":       "Viena",
+			"Europe/Rome":         "Roma",
+			"Europe/Stockholm":    "Estocolmo",
+			"Europe/Copenhagen":   "Copenhague",
+			"Europe/Warsaw":       "Varsóvia",
+			"Europe/Prague":       "Praga",
+			"Europe/Budapest":     "Budapeste",
+			"Europe/Athens":       "Atenas",
+			"Europe/Sofia":        "Sófia",
+			"Europe/Bucharest":    "Bucareste",
+			"Europe/Helsinki":     "Helsinque",
+			"Europe/Kyiv":         "Kiev",
+			"Europe/Istanbul":     "Istambul",
+			"Europe/Moscow":       "Moscou",
+			"America/New_York":    "Nova York",
+			"America/Mexico_City": "Cidade do México",
+			"America/Bogota":      "Bogotá",
+			"America/Sao_Paulo":   "São Paulo",
+			"Pacific/Honolulu":    "Honolulu",
+			"Asia/Kolkata":        "Calcutá",
+			"Asia/Jakarta":        "Jacarta",
+			"Asia/Singapore":      "Singapura",
+			"Asia/Shanghai":       "Xangai",
+			"Asia/Seoul":          "Seul",
+			"Asia/Tokyo":          "Tóquio",
+			"Asia/Tehran":         "Teerã",
+			"Asia/Jerusalem":      "Jerusalém",
+			"Asia/Riyadh":         "Riade",
+			"Africa/Johannesburg": "Joanesburgo",
+			"Africa/Nairobi":      "Nairóbi",
+		},
+	},
+	"bg": {
+		gmtFormat:     "Гринуич{0}",
+		gmtZeroFormat: "Гринуич",
+		hourFormat:    "+HH:mm;-HH:mm",
+		regionFormat:  "{0}",
+		metazones: map[string]zoneNames{
+			"Africa_Eastern":    {standard: "Източноафриканско време"},
+			"Africa_Southern":   {standard: "Южноафриканско време"},
+			"Africa_Western":    {standard: "Западноафриканско време"},
+			"Alaska":            {generic: "Аляска", standard: "Аляска – стандартно време", daylight: "Аляска – лятно часово време"},
+			"America_Central":   {generic: "Северноамериканско централно време", standard: "Северноамериканско централно стандартно време", daylight: "Северноамериканско централно лятно часово време"},
+			"America_Eastern":   {generic: "Северноамериканско източно време", standard: "Северноамериканско източно стандартно време", daylight: "Северноамериканско източно лятно часово време"},
+			"America_Mountain":  {generic: "Северноамериканско планинско време", standard: "Северноамериканско планинско стандартно време", daylight: "Северноамериканско планинско лятно часово време"},
+			"America_Pacific":   {generic: "Северноамериканско тихоокеанско време", standard: "Северноамериканско тихоокеанско стандартно време", daylight: "Северноамериканско тихоокеанско лятно часово време"},
+			"Arabian":           {generic: "Арабско време", standard: "Арабско стандартно време", daylight: "Арабско лятно часово време"},
+			"Argentina":         {generic: "Аржентинско време", standard: "Аржентинско стандартно време", daylight: "Аржентинско лятно часово време"},
+			"Atlantic":          {generic: "Северноамериканско атлантическо време", standard: "Северноамериканско атлантическо стандартно време", daylight: "Северноамериканско атлантическо лятно часово време"},
+			"Australia_Central": {generic: "Централноавстралийско време", standard: "Централноавстралийско стандартно време", daylight: "Централноавстралийско лятно часово време"},
+			"Australia_Eastern": {generic: "Източноавстралийско време", standard: "Източноавстралийско стандартно време", daylight: "Източноавстралийско лятно часово време"},
+			"Australia_Western": {generic: "Западноавстралийско време", standard: "Западноавстралийско стандартно време", daylight: "Западноавстралийско лятно часово време"},
+			"Brasilia":          {generic: "Бразилско време", standard: "Бразилско стандартно време", daylight: "Бразилско лятно часово време"},
+			"Chile":             {generic: "Чилийско време", standard: "Чилийско стандартно време", daylight: "Чилийско лятно часово време"},
+			"China":             {generic: "Китайско време", standard: "Китайско стандартно време", daylight: "Китайско лятно часово време"},
+			"Colombia":          {generic: "Колумбийско време", standard: "Колумбийско стандартно време", daylight: "Колумбийско лятно часово време"},
+			"Europe_Central":    {generic: "Централноевропейско време", standard: "Централноевропейско стандартно време", daylight: "Централноевропейско лятно часово време"},
+			"Europe_Eastern":    {generic: "Източноевропейско време", standard: "Източноевропейско стандартно време", daylight: "Източноевропейско лятно часово време"},
+			"Europe_Western":    {generic: "Западноевропейско време", standard: "Западноевропейско стандартно време", daylight: "Западноевропейско лятно време"},
+			"GMT":               {standard: "Средно гринуичко време"},
+			"Gulf":              {standard: "Персийски залив"},
+			"Hawaii":            {standard: "Хавайско-алеутско стандартно време"},
+			"Hong_Kong":         {generic: "Хонконгско време", standard: "Хонконгско стандартно време", daylight: "Хонконгско лятно часово време"},
+			"India":             {standard: "Индийско време"},
+			"Indochina":         {standard: "Индокитайско време"},
+			"Indonesia_Western": {standard: "Западноиндонезийско време"},
+			"Iran":              {generic: "Иранско време", standard: "Иранско стандартно време", daylight: "Иранско лятно часово време"},
+			"Israel":            {generic: "Израелско време", standard: "Израелско стандартно време", daylight: "Израелско лятно часово време"},
+			"Japan":             {generic: "Японско време", standard: "Японско стандартно време", daylight: "Японско лятно часово време"},
+			"Korea":             {generic: "Корейско време", standard: "Корейско стандартно време", daylight: "Корейско лятно часово време"},
+			"Moscow":            {generic: "Московско време", standard: "Московско стандартно време", daylight: "Московско лятно часово време"},
+			"New_Zealand":       {generic: "Новозеландско време", standard: "Новозеландско стандартно време", daylight: "Новозеландско лятно часово време"},
+			"Pakistan":          {generic: "Пакистанско време", standard: "Пакистанско стандартно време", daylight: "Пакистанско лятно часово време"},
+			"Peru":              {generic: "Перуанско време", standard: "Перуанско стандартно време", daylight: "Перуанско лятно часово време"},
+			"Singapore":         {standard: "Сингапурско време"},
+			"Taipei":            {generic: "Тайпе", standard: "Тайпе – стандартно време", daylight: "Тайпе – лятно часово време"},
+		},
+		zones: map[string]zoneNames{
+			"Etc/UTC":       {standard: "Координирано универсално време", standardShort: "UTC"},
+			"Europe/London": {daylight: "Британско лятно часово време"},
+			"Europe/Dublin": {daylight: "Ирландско стандартно време"},
+		},
+		cities: map[string]string{
+			"Europe/London":                  "Лондон",
+			"Europe/Dublin":                  "Дъблин",
+			"Europe/Lisbon":                  "Лисабон",
+			"Europe/Madrid":                  "Мадрид",
+			"Europe/Paris":                   "Париж",
+			"Europe/Brussels":                "Брюксел",
+			"Europe/Amsterdam":               "Амстердам",
+			"Europe/Berlin":                  "Берлин",
+			"Europe/Zurich":                  "Цюрих",
+			"Europe/Vienna":                  "Виена",
+			"Europe/Rome":                    "Рим",
+			"Europe/Stockholm":               "Стокхолм",
+			"Europe/Oslo":                    "Осло",
+			"Europe/Copenhagen":              "Копенхаген",
+			"Europe/Warsaw":                  "Варшава",
+			"Europe/Prague":                  "Прага",
+			"Europe/Budapest":                "Будапеща",
+			"Europe/Athens":                  "Атина",
+			"Europe/Sofia":                   "София",
+			"Europe/Bucharest":               "Букурещ",
+			"Europe/Helsinki":                "Хелзинки",
+			"Europe/Kyiv":                    "Киев",
+			"Europe/Istanbul":                "Истанбул",
+			"Europe/Moscow":                  "Москва",
+			"America/New_York":               "Ню Йорк",
+			"America/Chicago":                "Чикаго",
+			"America/Denver":                 "Денвър",
+			"America/Phoenix":                "Финикс",
+			"America/Los_Angeles":            "Лос Анджелис",
+			"America/Anchorage":              "Анкъридж",
+			"America/Toronto":                "Торонто",
+			"America/Vancouver":              "Ванкувър",
+			"America/Halifax":                "Халифакс",
+			"America/Mexico_City":            "Мексико Сити",
+			"America/Bogota":                 "Богота",
+			"America/Lima":                   "Лима",
+			"America/Santiago":               "Сантяго",
+			"America/Sao_Paulo":              "Сао Пауло",
+			"America/Argentina/Buenos_Aires": "Буенос Айрес",
+			"Pacific/Honolulu":               "Хонолулу",
+			"Pacific/Auckland":               "Окланд",
+			"Asia/Dubai":                     "Дубай",
+			"Asia/Karachi":                   "Карачи",
+			"Asia/Kolkata":                   "Колката",
+			"Asia/Bangkok":                   "Банкок",
+			"Asia/Jakarta":                   "Джакарта",
+			"Asia/Singapore":                 "Сингапур",
+			"Asia/Shanghai":                  "Шанхай",
+			"Asia/Hong_Kong":                 "Хонконг",
+			"Asia/Taipei":                    "Тайпе",
+			"Asia/Seoul":                     "Сеул",
+			"Asia/Tokyo":                     "Токио",
+			"Asia/Tehran":                    "Техеран",
+			"Asia/Jerusalem":                 "Йерусалим",
+			"Asia/Riyadh":                    "Рияд",
+			"Africa/Cairo":                   "Кайро",
+			"Africa/Johannesburg":            "Йоханесбург",
+			"Africa/Lagos":                   "Лагос",
+			"Africa/Nairobi":                 "Найроби",
+			"Australia/Perth":                "Пърт",
+			"Australia/Adelaide":             "Аделаида",
+			"Australia/Brisbane":             "Бризбейн",
+			"Australia/Sydney":               "Сидни",
+			"Australia/Melbourne":            "Мелбърн",
+		},
+	},
+	"zh": {
+		gmtFormat:     "GMT{0}",
+		gmtZeroFormat: "GMT",
+		hourFormat:    "+HH:mm;-HH:mm",
+		regionFormat:  "{0}时间",
+		metazones: map[string]zoneNames{
+			"Africa_Eastern":    {standard: "东部非洲时间"},
+			"Africa_Southern":   {standard: "南非标准时间"},
+			"Africa_Western":    {standard: "西部非洲时间"},
+			"Alaska":            {generic: "阿拉斯加时间", standard: "阿拉斯加标准时间", daylight: "阿拉斯加夏令时间"},
+			"America_Central":   {generic: "北美中部时间", standard: "北美中部标准时间", daylight: "北美中部夏令时间"},
+			"America_Eastern":   {generic: "北美东部时间", standard: "北美东部标准时间", daylight: "北美东部夏令时间"},
+			"America_Mountain":  {generic: "北美山区时间", standard: "北美山区标准时间", daylight: "北美山区夏令时间"},
+			"America_Pacific":   {generic: "北美太平洋时间", standard: "北美太平洋标准时间", daylight: "北美太平洋夏令时间"},
+			"Arabian":           {generic: "阿拉伯时间", standard: "阿拉伯标准时间", daylight: "阿拉伯夏令时间"},
+			"Argentina":         {generic: "阿根廷时间", standard: "阿根廷标准时间", daylight: "阿根廷夏令时间"},
+			"Atlantic":          {generic: "大西洋时间", standard: "大西洋标准时间", daylight: "大西洋夏令时间"},
+			"Australia_Central": {generic: "澳大利亚中部时间", standard: "澳大利亚中部标准时间", daylight: "澳大利亚中部夏令时间"},
+			"Australia_Eastern": {generic: "澳大利亚东部时间", standard: "澳大利亚东部标准时间", daylight: "澳大利亚东部夏令时间"},
+			"Australia_Western": {generic: "澳大利亚西部时间", standard: "澳大利亚西部标准时间", daylight: "澳大利亚西部夏令时间"},
+			"Brasilia":          {generic: "巴西利亚时间", standard: "巴西利亚标准时间", daylight: "巴西利亚夏令时间"},
+			"Chile":             {generic: "智利时间", standard: "智利标准时间", daylight: "智利夏令时间"},
+			"China":             {generic: "中国时间", standard: "中国标准时间", daylight: "中国夏令时间"},
+			"Colombia":          {generic: "哥伦比亚时间", standard: "哥伦比亚标准时间", daylight: "哥伦比亚夏令时间"},
+			"Europe_Central":    {generic: "中欧时间", standard: "中欧标准时间", daylight: "中欧夏令时间"},
+			"Europe_Eastern":    {generic: "东欧时间", standard: "东欧标准时间", daylight: "东欧夏令时间"},
+			"Europe_Western":    {generic: "西欧时间", standard: "西欧标准时间", daylight: "西欧夏令时间"},
+			"GMT":               {standard: "格林尼治标准时间"},
+			"Gulf":              {standard: "海湾标准时间"},
+			"Hawaii":            {standard: "夏威夷-阿留申标准时间"},
+			"Hong_Kong":         {generic: "香港时间", standard: "香港标准时间", daylight: "香港夏令时间"},
+			"India":             {standard: "印度时间"},
+			"Indochina":         {standard: "中南半岛时间"},
+			"Indonesia_Western": {standard: "印度尼西亚西部时间"},
+			"Iran":              {generic: "伊朗时间", standard: "伊朗标准时间", daylight: "伊朗夏令时间"},
+			"Israel":            {generic: "以色列时间", standard: "以色列标准时间", daylight: "以色列夏令时间"},
+			"Japan":             {generic: "日本时间", standard: "日本标准时间", daylight: "日本夏令时间"},
+			"Korea":             {generic: "韩国时间", standard: "韩国标准时间", daylight: "韩国夏令时间"},
+			"Moscow":            {generic: "莫斯科时间", standard: "莫斯科标准时间", daylight: "莫斯科夏令时间"},
+			"New_Zealand":       {generic: "新西兰时间", standard: "新西兰标准时间", daylight: "新西兰夏令时间"},
+			"Pakistan":          {generic: "巴基斯坦时间", standard: "巴基斯坦标准时间", daylight: "巴基斯坦夏令时间"},
+			"Peru":              {generic: "秘鲁时间", standard: "秘鲁标准时间", daylight: "秘鲁夏令时间"},
+			"Singapore":         {standard: "新加坡标准时间"},
+			"Taipei":            {generic: "台北时间", standard: "台北标准时间", daylight: "台北夏令时间"},
+			"Turkey":            {generic: "土耳其时间", standard: "土耳其标准时间", daylight: "土耳其夏令时间"},
+		},
+		zones: map[string]zoneNames{
+			"Etc/UTC":       {standard: "协调世界时", standardShort: "UTC"},
+			"Europe/London": {daylight: "英国夏令时间"},
+			"Europe/Dublin": {daylight: "爱尔兰标准时间"},
+		},
+		cities: map[string]string{
+			"Europe/London":                  "伦敦",
+			"Europe/Dublin":                  "都柏林",
+			"Europe/Lisbon":                  "里斯本",
+			"Europe/Madrid":                  "马德里",
+			"Europe/Paris":                   "巴黎",
+			"Europe/Brussels":                "布鲁塞尔",
+			"Europe/Amsterdam":               "阿姆斯特丹",
+			"Europe/Berlin":                  "柏林",
+			"Europe/Zurich":                  "苏黎世",
+			"Europe/Vienna":                  "维也纳",
+			"Europe/Rome":                    "罗马",
+			"Europe/Stockholm":               "斯德哥尔摩",
+			"Europe/Oslo":                    "奥斯陆",
+			"Europe/Copenhagen":              "哥本哈根",
+			"Europe/Warsaw":                  "华沙",
+			"Europe/Prague":                  "布拉格",
+			"Europe/Budapest":                "布达佩斯",
+			"Europe/Athens":                  "雅典",
+			"Europe/Sofia":                   "索非亚",
+			"Europe/Bucharest":               "布加勒斯特",
+			"Europe/Helsinki":                "赫尔辛基",
+			"Europe/Kyiv":                    "基辅",
+			"Europe/Istanbul":                "伊斯坦布尔",
+			"Europe/Moscow":                  "莫斯科",
+			"America/New_York":               "纽约",
+			"America/Chicago":                "芝加哥",
+			"America/Denver":                 "丹佛",
+			"America/Phoenix":                "凤凰城",
+			"America/Los_Angeles":            "洛杉矶",
+			"America/Anchorage":              "安克雷奇",
+			"America/Toronto":                "多伦多",
+			"America/Vancouver":              "温哥华",
+			"America/Halifax":                "哈利法克斯",
+			"America/Mexico_City":            "墨西哥城",
+			"America/Bogota":                 "波哥大",
+			"America/Lima":                   "利马",
+			"America/Santiago":               "圣地亚哥",
+			"America/Sao_Paulo":              "圣保罗",
+			"America/Argentina/Buenos_Aires": "布宜诺斯艾利斯",
+			"Pacific/Honolulu":               "檀香山",
+			"Pacific/Auckland":               "奥克兰",
+			"Asia/Dubai":                     "迪拜",
+			"Asia/Karachi":                   "卡拉奇",
+			"Asia/Kolkata":                   "加尔各答",
+			"Asia/Bangkok":                   "曼谷",
+			"Asia/Jakarta":                   "雅加达",
+			"Asia/Singapore":                 "新加坡",
+			"Asia/Shanghai":                  "上海",
+			"Asia/Hong_Kong":                 "香港",
+			"Asia/Taipei":                    "台北",
+			"Asia/Seoul":                     "首尔",
+			"Asia/Tokyo":                     "东京",
+			"Asia/Tehran":                    "德黑兰",
+			"Asia/Jerusalem":                 "耶路撒冷",
+			"Asia/Riyadh":                    "利雅得",
+			"Africa/Cairo":                   "开罗",
+			"Africa/Johannesburg":            "约翰内斯堡",
+			"Africa/Lagos":                   "拉各斯",
+			"Africa/Nairobi":                 "内罗毕",
+			"Australia/Perth":                "珀斯",
+			"Australia/Adelaide":             "阿德莱德",
+			"Australia/Brisbane":             "布里斯班",
+			"Australia/Sydney":               "悉尼",
+			"Australia/Melbourne":            "墨尔本",
+		},
+	},
+}
@@ -0,0 +1,98 @@
+package icu
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("load location %s: %s", name, err)
+	}
+	return loc
+}
+
+func TestFormatZone(t *testing.T) {
+	summer := time.Date(2023, time.July, 3, 12, 0, 0, 0, time.UTC)
+	winter := time.Date(2023, time.January, 3, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		tag   Tag
+		zone  string
+		time  time.Time
+		style ZoneStyle
+		want  string
+	}{
+		{"en", "Europe/Berlin", summer, ZoneSpecific, "Central European Summer Time"},
+		{"en", "Europe/Berlin", winter, ZoneSpecific, "Central European Standard Time"},
+		{"en", "Europe/Berlin", summer, ZoneSpecificShort, "GMT+2"},
+		{"en", "Europe/Berlin", summer, ZoneGeneric, "Central European Time"},
+		{"en", "Europe/Berlin", summer, ZoneGMT, "GMT+2"},
+		{"en", "Europe/Berlin", summer, ZoneGMTLong, "GMT+02:00"},
+		{"en", "Europe/Berlin", summer, ZoneLocation, "Berlin Time"},
+		{"en-US", "America/New_York", summer, ZoneSpecificShort, "EDT"},
+		{"en", "America/New_York", winter, ZoneGenericShort, "ET"},
+		{"en", "America/Los_Angeles", winter, ZoneGMT, "GMT-8"},
+		{"en", "Asia/Kolkata", summer, ZoneGMT, "GMT+5:30"},
+		{"en", "Asia/Kolkata", summer, ZoneGeneric, "India Standard Time"},
+		{"en", "Europe/London", summer, ZoneSpecific, "British Summer Time"},
+		{"en", "Europe/London", winter, ZoneSpecific, "Greenwich Mean Time"},
+		{"en", "UTC", summer, ZoneGMT, "GMT"},
+		{"en", "UTC", summer, ZoneSpecific, "Coordinated Universal Time"},
+		{"de", "Europe/Berlin", summer, ZoneSpecific, "Mitteleuropäische Sommerzeit"},
+		{"de", "Europe/Berlin", winter, ZoneSpecificShort, "MEZ"},
+		{"de", "Europe/Vienna", summer, ZoneLocation, "Wien (Ortszeit)"},
+		{"de-AT", "Europe/Vienna", summer, ZoneGMT, "GMT+2"},
+		{"fr", "Europe/Paris", summer, ZoneGMT, "UTC+2"},
+		{"fr", "America/New_York", summer, ZoneGMTLong, "UTC−04:00"},
+		{"xx", "Europe/Berlin", summer, ZoneGeneric, "Central European Time"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag)+":"+tc.zone, func(t *testing.T) {
+			got := FormatZone(tc.tag, tc.time.In(mustLoadLocation(t, tc.zone)), tc.style)
+			if tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTranslateTimeZone(t *testing.T) {
+	requireFullData(t)
+	ts := time.Date(2023, time.July, 3, 22, 30, 0, 0, time.UTC)
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	testCases := []struct {
+		name       string
+		tag        Tag
+		message    MessageFormat
+		parameters []Parameter
+		translated string
+	}{
+		{"time:no-zone", "de", "{t, time, short}", []Parameter{P("t", ts)}, "22:30"},
+		{"time:location", "de", "{t, time, short}", []Parameter{P("t", ts), TimeZone(berlin)}, "00:30"},
+		{"time:name", "en", "{t, time, short}", []Parameter{P("t", ts), P("$time-zone", "America/New_York")}, "6:30\u202fPM"},
+		{"time:unknown", "de", "{t, time, short}", []Parameter{P("t", ts), P("$time-zone", "Mars/Olympus_Mons")}, "22:30"},
+		{"time:full", "de", "{t, time, full}", []Parameter{P("t", ts), TimeZone(berlin)}, "00:30:00 Mitteleuropäische Sommerzeit"},
+		{"time:long", "en", "{t, time, long}", []Parameter{P("t", ts), TimeZone(berlin)}, "12:30:00\u202fAM GMT+2"},
+		{"time:pattern", "en", "{t, time, HH:mm VVVV}", []Parameter{P("t", ts), TimeZone(berlin)}, "00:30 Berlin Time"},
+		{"time:pattern:generic", "en", "{t, time, h:mm a v}", []Parameter{P("t", ts), P("$time-zone", "America/Los_Angeles")}, "3:30 PM PT"},
+		{"date:day-changes", "de", "{t, date, long}", []Parameter{P("t", ts), TimeZone(berlin)}, "4. Juli 2023"},
+		{"date:full", "en", "{t, date, full}", []Parameter{P("t", ts)}, "Monday, July 3, 2023"},
+		{"date:pattern", "de", "{t, date, dd.MM.y}", []Parameter{P("t", ts), TimeZone(berlin)}, "04.07.2023"},
+		{"date:layout", "en", "{t, date}", []Parameter{P("t", ts), TimeZone(berlin)}, "2023-07-04T00:30:00+02:00"},
+		{"date:date-format", "en", "{t, date, long}", []Parameter{P("t", ts), TimeZone(berlin), P("$date-format", "02.01.2006 15:04")}, "04.07.2023 00:30"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Translate(tc.tag, tc.message, tc.parameters...)
+			if err != nil {
+				t.Errorf("parse: %s", err)
+			}
+			if tc.translated != got {
+				t.Errorf("expected: '%s', got: '%s'", tc.translated, got)
+			}
+		})
+	}
+}
@@ -21,6 +21,10 @@ type HierachicalTranslator struct {
 	Base         Translator
 	Tag          Tag
 	Translations map[string]MessageFormat
+	formatters   *formatterRegistry
+	// invalid holds the messages failing the checks of a strict bundle,
+	// which fall back like missing ones.
+	invalid map[string]error
 }
 
 func (t *HierachicalTranslator) IsRoot() bool {
@@ -31,8 +35,8 @@ func (t *HierachicalTranslator) Translate(key string, ps ...Parameter) string {
 	if t == nil {
 		return key
 	}
-	if mf, ok := t.Translations[key]; ok {
-		if v, err := mf.Translate(t.Tag, ps...); err == nil {
+	if mf, ok := t.Translations[key]; ok && t.invalid[key] == nil {
+		if v, err := translate(t.Tag, mf, t.formatters, ps...); err == nil {
 			return v
 		}
 	}
@@ -0,0 +1,178 @@
+package icu
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// unitPatterns holds the patterns of a unit in one width by plural category
+// and its per unit pattern under "per", e.g. "{0}/km".
+type unitPatterns map[string]string
+
+// formatUnitInteger formats n of a CLDR unit such as "duration-hour", e.g.
+// "2 hours".
+func formatUnitInteger(tag Tag, n int, unit string, width Width) string {
+	_, d := localeDataFor(tag, hasUnits)
+	ps := d.units[unit+width.suffix()]
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+	p, ok := ps[cardinalToCategory(tag, abs)]
+	if !ok {
+		p, ok = ps[other]
+		if !ok {
+			p = "{0} " + unit[strings.Index(unit, "-")+1:]
+		}
+	}
+	return strings.Replace(p, "{0}", formatInteger(tag, n), 1)
+}
+
+var unitKeys struct {
+	sync.Once
+	m map[string]string
+}
+
+// unitKey returns the key of a unit in the unit tables, which is prefixed by
+// its type, e.g. "length-kilometer" for "kilometer".
+func unitKey(unit string) (string, bool) {
+	unitKeys.Do(func() {
+		unitKeys.m = map[string]string{}
+		for k := range localeDataTable[TagEn].units {
+			if strings.HasSuffix(k, WidthShort.suffix()) || strings.HasSuffix(k, WidthNarrow.suffix()) {
+				continue
+			}
+			unitKeys.m[k] = k
+			unitKeys.m[k[strings.Index(k, "-")+1:]] = k
+		}
+	})
+	k, ok := unitKeys.m[unit]
+	return k, ok
+}
+
+// defaultUnitPattern shows up to six fraction digits.
+const defaultUnitPattern = "#,##0.######"
+
+// FormatUnit formats an amount of a unit like "kilometer", "kilogram" or
+// "kilometer-per-hour", e.g. "5 km" in English or "3 Kilogramm" in German.
+// Units may also be given with their type, e.g. "length-kilometer". Compound
+// units like "megabyte-per-second" that the locale has no patterns for are
+// composed from their parts.
+func FormatUnit(tag Tag, v float64, unit string, width Width) (string, error) {
+	return formatUnit(baseLocale(tag), NumberingSystem(tag), v, unit, width, defaultUnitPattern)
+}
+
+func formatUnit(tag Tag, numbering string, v float64, unit string, width Width, pattern string) (string, error) {
+	_, d := localeDataFor(tag, hasUnits)
+	us := d.units
+	digits := decimalDigits(v, pattern)
+	num := localizeNumber(tag, numbering, digits, v < 0 && strings.Trim(digits, "0.") != "", patternGrouping(pattern))
+	cat := cardinalCategory(tag, operands(digits))
+
+	if key, ok := unitKey(unit); ok {
+		return fillUnit(us[key+width.suffix()], cat, num), nil
+	}
+	i := strings.Index(unit, "-per-")
+	if i < 0 {
+		return "", fmt.Errorf("unknown unit %q", unit)
+	}
+	numerator, denominator := unit[:i], unit[i+len("-per-"):]
+	nk, ok := unitKey(numerator)
+	if !ok {
+		return "", fmt.Errorf("unknown unit %q", numerator)
+	}
+	dk, ok := unitKey(denominator)
+	if !ok {
+		return "", fmt.Errorf("unknown unit %q", denominator)
+	}
+	s := fillUnit(us[nk+width.suffix()], cat, num)
+	dps := us[dk+width.suffix()]
+	if p, ok := dps["per"]; ok {
+		return strings.Replace(p, "{0}", s, 1), nil
+	}
+	name := strings.TrimSpace(strings.Replace(fillUnit(dps, one, "{0}"), "{0}", "", 1))
+	return fillList(d.unitCompounds["per"+width.suffix()], s, name), nil
+}
+
+// fillUnit fills the pattern of the plural category into which the number
+// falls.
+func fillUnit(ps unitPatterns, cat string, num string) string {
+	p, ok := ps[cat]
+	if !ok {
+		p = ps[other]
+	}
+	return strings.Replace(p, "{0}", num, 1)
+}
+
+// numberSkeleton holds the options of an ICU number skeleton like
+// "::unit/kilometer unit-width-full-name .00". Without precision options,
+// units converted for a usage are rounded as the usage prefers.
+type numberSkeleton struct {
+	unit      string
+	perUnit   string
+	usage     string
+	width     Width
+	pattern   string
+	precision bool
+}
+
+func parseNumberSkeleton(s string) numberSkeleton {
+	sk := numberSkeleton{width: WidthShort, pattern: defaultUnitPattern}
+	for _, t := range strings.Fields(s) {
+		switch {
+		case strings.HasPrefix(t, "unit/"):
+			sk.unit = t[len("unit/"):]
+		case strings.HasPrefix(t, "measure-unit/"):
+			sk.unit = t[len("measure-unit/"):]
+		case strings.HasPrefix(t, "per-measure-unit/"):
+			sk.perUnit = t[len("per-measure-unit/"):]
+		case strings.HasPrefix(t, "usage/"):
+			sk.usage = t[len("usage/"):]
+		case t == "unit-width-full-name":
+			sk.width = WidthLong
+		case t == "unit-width-short":
+			sk.width = WidthShort
+		case t == "unit-width-narrow":
+			sk.width = WidthNarrow
+		case t == "precision-integer":
+			sk.pattern = strings.Split(sk.pattern, ".")[0]
+			sk.precision = true
+		case t == "group-off":
+			sk.pattern = strings.Replace(sk.pattern, ",", "", 1)
+		case strings.HasPrefix(t, ".") && strings.Trim(t[1:], "0#") == "":
+			sk.pattern = strings.Split(sk.pattern, ".")[0] + t
+			sk.precision = true
+		}
+	}
+	return sk
+}
+
+// formatNumberSkeleton formats a number according to a skeleton, e.g. as an
+// amount of a unit, which is converted for a usage in the region.
+func formatNumberSkeleton(tag Tag, region string, numbering string, v float64, s string) (string, error) {
+	sk := parseNumberSkeleton(s)
+	if sk.unit == "" {
+		return formatNumber(tag, numbering, v, sk.pattern), nil
+	}
+	unit := sk.unit
+	if sk.perUnit != "" {
+		unit = stripUnitType(unit) + "-per-" + stripUnitType(sk.perUnit)
+	}
+	if sk.usage != "" {
+		pattern := ""
+		if sk.precision {
+			pattern = sk.pattern
+		}
+		return formatUnitForUsage(tag, region, numbering, v, unit, sk.usage, sk.width, pattern)
+	}
+	return formatUnit(tag, numbering, v, unit, sk.width, sk.pattern)
+}
+
+// stripUnitType turns "length-kilometer" into "kilometer".
+func stripUnitType(unit string) string {
+	if k, ok := unitKey(unit); ok && k == unit {
+		return unit[strings.Index(unit, "-")+1:]
+	}
+	return unit
+}
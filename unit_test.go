@@ -0,0 +1,82 @@
+package icu
+
+import "testing"
+
+func TestFormatUnit(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		tag   Tag
+		value float64
+		unit  string
+		width Width
+		want  string
+	}{
+		{"en", 5, "kilometer", WidthShort, "5 km"},
+		{"en", 1, "kilometer", WidthLong, "1 kilometer"},
+		{"en", 1.5, "kilometer", WidthLong, "1.5 kilometers"},
+		{"en", 1234.5, "length-meter", WidthLong, "1,234.5 meters"},
+		{"en", 100, "kilometer-per-hour", WidthShort, "100 km/h"},
+		{"en", 12, "kilowatt-hour", WidthShort, "12 kWh"},
+		{"en", 5, "megabyte-per-second", WidthShort, "5 MB/s"},
+		{"en", 5, "megabyte-per-second", WidthLong, "5 megabytes per second"},
+		{"en", -3, "celsius", WidthShort, "-3°C"},
+		{"de", 3, "kilogram", WidthLong, "3 Kilogramm"},
+		{"de", 2.5, "kilogram", WidthShort, "2,5 kg"},
+		{"de-AT", 100, "kilometer-per-hour", WidthLong, "100 Kilometer pro Stunde"},
+		{"de", 5, "gigabyte-per-hour", WidthLong, "5 Gigabyte pro Stunde"},
+		{"fr", 1.5, "kilogram", WidthLong, "1,5\u00a0kilogramme"},
+		{"fr", 2, "kilogram", WidthLong, "2\u00a0kilogrammes"},
+		{"es", 1000000, "meter", WidthLong, "1.000.000 metros"},
+		{"zh", 5, "kilometer", WidthLong, "5公里"},
+		{"xx", 5, "kilometer", WidthShort, "5 km"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag)+":"+tc.want, func(t *testing.T) {
+			got, err := FormatUnit(tc.tag, tc.value, tc.unit, tc.width)
+			if err != nil {
+				t.Fatalf("format: %s", err)
+			}
+			if tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+	for _, unit := range []string{"parsec", "furlong-per-fortnight", "kilometer-per-parsec"} {
+		if _, err := FormatUnit("en", 1, unit, WidthShort); err == nil {
+			t.Errorf("expected an error for %q", unit)
+		}
+	}
+}
+
+func TestTranslateNumberSkeleton(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		name       string
+		tag        Tag
+		message    MessageFormat
+		parameters []Parameter
+		translated string
+	}{
+		{"unit", "en", "{d, number, ::unit/kilometer}", []Parameter{P("d", 5)}, "5 km"},
+		{"unit:full-name", "de", "{d, number, ::unit/kilogram unit-width-full-name}", []Parameter{P("d", 3)}, "3 Kilogramm"},
+		{"unit:narrow", "en", "{d, number, ::unit/kilogram unit-width-narrow}", []Parameter{P("d", 3)}, "3kg"},
+		{"unit:per", "de", "{d, number, ::measure-unit/length-meter per-measure-unit/duration-second .00}", []Parameter{P("d", 12.345)}, "12,35 m/s"},
+		{"unit:plural", "en", "{d, number, ::unit/mile unit-width-full-name}", []Parameter{P("d", 1)}, "1 mile"},
+		{"unit:unknown", "en", "{d, number, ::unit/parsec}", []Parameter{P("d", 1)}, "1"},
+		{"precision-integer", "de", "{d, number, ::precision-integer}", []Parameter{P("d", 12345.678)}, "12.346"},
+		{"group-off", "de", "{d, number, ::group-off}", []Parameter{P("d", 12345.678)}, "12345,678"},
+		{"fmt", "de", "{d, number, %.1f}", []Parameter{P("d", 12345.678)}, "12345.7"},
+		{"not-a-number", "en", "{d, number, ::unit/kilometer}", []Parameter{P("d", "far")}, "far"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Translate(tc.tag, tc.message, tc.parameters...)
+			if err != nil {
+				t.Errorf("parse: %s", err)
+			}
+			if tc.translated != got {
+				t.Errorf("expected: '%s', got: '%s'", tc.translated, got)
+			}
+		})
+	}
+}
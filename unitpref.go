@@ -0,0 +1,169 @@
+package icu
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// unitConversion converts a unit into the base unit of its quantity, e.g.
+// "foot" into meters of "length". Reciprocal units like "mile-per-gallon"
+// are converted into the inverse of the base unit.
+type unitConversion struct {
+	quantity   string
+	factor     float64
+	offset     float64
+	reciprocal bool
+}
+
+func (c unitConversion) toBase(v float64) float64 {
+	if c.reciprocal {
+		return 1 / (v * c.factor)
+	}
+	return v*c.factor + c.offset
+}
+
+func (c unitConversion) fromBase(v float64) float64 {
+	if c.reciprocal {
+		return 1 / (v * c.factor)
+	}
+	return (v - c.offset) / c.factor
+}
+
+// unitPreference is a unit preferred for amounts of at least geq of the
+// first of its units. Amounts are rounded to a multiple of increment, if set.
+type unitPreference struct {
+	unit      string
+	geq       float64
+	increment float64
+}
+
+func unitConversionFor(unit string) (unitConversion, error) {
+	c, ok := unitConversionData[stripUnitType(unit)]
+	if !ok {
+		return c, fmt.Errorf("unknown unit %q", unit)
+	}
+	return c, nil
+}
+
+// ConvertUnit converts an amount of one unit into another one of the same
+// quantity, e.g. kilometers into miles or celsius into fahrenheit.
+func ConvertUnit(v float64, from string, to string) (float64, error) {
+	fc, err := unitConversionFor(from)
+	if err != nil {
+		return 0, err
+	}
+	tc, err := unitConversionFor(to)
+	if err != nil {
+		return 0, err
+	}
+	if fc.quantity != tc.quantity {
+		return 0, fmt.Errorf("cannot convert %s into %s", from, to)
+	}
+	return tc.fromBase(fc.toBase(v)), nil
+}
+
+// FormatUnitForUsage converts an amount of a unit into the units the region
+// of the tag prefers for a usage like "road", "person-height" or "weather"
+// and formats it, e.g. 180 centimeters of "person-height" become
+// "5 ft, 11 in" for en-US and stay "180 cm" for de-DE.
+func FormatUnitForUsage(tag Tag, v float64, unit string, usage string, width Width) (string, error) {
+	return formatUnitForUsage(baseLocale(tag), regionOf(tag), NumberingSystem(tag), v, unit, usage, width, "")
+}
+
+// formatUnitForUsage rounds according to the preference or to the pattern,
+// if set.
+func formatUnitForUsage(tag Tag, region string, numbering string, v float64, unit string, usage string, width Width, pattern string) (string, error) {
+	c, err := unitConversionFor(unit)
+	if err != nil {
+		return "", err
+	}
+	ps := unitPreferencesFor(c.quantity, usage, region)
+	if len(ps) == 0 {
+		if pattern == "" {
+			pattern = defaultUnitPattern
+		}
+		return formatUnit(tag, numbering, v, unit, width, pattern)
+	}
+	base := c.toBase(v)
+	p := ps[len(ps)-1]
+	for _, q := range ps[:len(ps)-1] {
+		first := strings.SplitN(q.unit, "-and-", 2)[0]
+		if math.Abs(unitConversionData[first].fromBase(base))*(1+1e-12) >= q.geq {
+			p = q
+			break
+		}
+	}
+	return formatMixedUnit(tag, numbering, base, p, width, pattern)
+}
+
+// unitPreferencesFor looks up the preferences of a usage, dropping its last
+// parts until there are some, e.g. "person-height", "person" and "default".
+// Regions without preferences of their own use the ones of the world.
+func unitPreferencesFor(quantity string, usage string, region string) []unitPreference {
+	for {
+		if rs, ok := unitPreferenceData[quantity+"/"+usage]; ok {
+			if ps, ok := rs[region]; ok {
+				return ps
+			}
+			return rs["001"]
+		}
+		i := strings.LastIndex(usage, "-")
+		if i < 0 {
+			break
+		}
+		usage = usage[:i]
+	}
+	return unitPreferenceData[quantity+"/default"]["001"]
+}
+
+// formatMixedUnit formats a base amount in the units of a preference like
+// "foot-and-inch", e.g. "5 ft, 11 in". Only the last unit has fraction
+// digits.
+func formatMixedUnit(tag Tag, numbering string, base float64, p unitPreference, width Width, pattern string) (string, error) {
+	units := strings.Split(p.unit, "-and-")
+	last := unitConversionData[units[len(units)-1]]
+	x := last.fromBase(base)
+	negative := x < 0
+	x = math.Abs(x)
+	switch {
+	case p.increment > 0:
+		x = math.Round(x/p.increment) * p.increment
+		pattern = "#,##0"
+	case pattern == "":
+		pattern = usagePattern(x)
+	}
+	x, _ = strconv.ParseFloat(decimalDigits(x, pattern), 64)
+
+	var parts []string
+	for i, u := range units {
+		n, pat := x, pattern
+		if i < len(units)-1 {
+			ratio := unitConversionData[u].factor / last.factor
+			n = math.Floor(x/ratio + 1e-9)
+			x = math.Max(x-n*ratio, 0)
+			pat = "#,##0"
+			if n == 0 && len(parts) == 0 {
+				continue
+			}
+		}
+		if negative && len(parts) == 0 {
+			n = -n
+		}
+		s, err := formatUnit(tag, numbering, n, u, width, pat)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, s)
+	}
+	return joinList(tag, parts, "unit"+width.suffix()), nil
+}
+
+// usagePattern rounds to integers but keeps at least two significant digits.
+func usagePattern(x float64) string {
+	if x == 0 || x >= 10 {
+		return "#,##0"
+	}
+	return "#,##0." + strings.Repeat("#", 1-int(math.Floor(math.Log10(x))))
+}
@@ -0,0 +1,445 @@
+package icu
+
+// Conversions of units into the base unit of their quantity, copied by hand
+// from the units.json supplemental data of CLDR 48.
+var unitConversionData = map[string]unitConversion{
+	"acre":                              {"area", 4046.8564224, 0, false},
+	"ampere":                            {"electric-current", 1, 0, false},
+	"arc-minute":                        {"angle", 4.6296296296296294e-05, 0, false},
+	"arc-second":                        {"angle", 7.716049382716049e-07, 0, false},
+	"atmosphere":                        {"pressure", 101325, 0, false},
+	"bar":                               {"pressure", 100000, 0, false},
+	"barrel":                            {"volume", 0.158987294928, 0, false},
+	"bit":                               {"digital", 1, 0, false},
+	"british-thermal-unit":              {"energy", 1054.3502644888888, 0, false},
+	"byte":                              {"digital", 8, 0, false},
+	"calorie":                           {"energy", 4.184, 0, false},
+	"carat":                             {"mass", 0.0002, 0, false},
+	"celsius":                           {"temperature", 1, 273.15, false},
+	"centiliter":                        {"volume", 1e-05, 0, false},
+	"centimeter":                        {"length", 0.01, 0, false},
+	"centimeter-per-hour":               {"speed", 2.777777777777778e-06, 0, false},
+	"century":                           {"year-duration", 100, 0, false},
+	"cubic-centimeter":                  {"volume", 1e-06, 0, false},
+	"cubic-foot":                        {"volume", 0.028316846592, 0, false},
+	"cubic-inch":                        {"volume", 1.6387064e-05, 0, false},
+	"cubic-meter":                       {"volume", 1, 0, false},
+	"cup":                               {"volume", 0.0002365882365, 0, false},
+	"day":                               {"duration", 86400, 0, false},
+	"decade":                            {"year-duration", 10, 0, false},
+	"deciliter":                         {"volume", 0.0001, 0, false},
+	"decimeter":                         {"length", 0.1, 0, false},
+	"degree":                            {"angle", 0.002777777777777778, 0, false},
+	"dot-per-inch":                      {"resolution", 39.37007874015748, 0, false},
+	"electronvolt":                      {"energy", 1.602177e-19, 0, false},
+	"fahrenheit":                        {"temperature", 0.5555555555555556, 255.37222222222223, false},
+	"fluid-ounce":                       {"volume", 2.95735295625e-05, 0, false},
+	"fluid-ounce-imperial":              {"volume", 2.84130625e-05, 0, false},
+	"foodcalorie":                       {"energy", 4184, 0, false},
+	"foot":                              {"length", 0.3048, 0, false},
+	"g-force":                           {"acceleration", 9.80665, 0, false},
+	"gallon":                            {"volume", 0.003785411784, 0, false},
+	"gallon-imperial":                   {"volume", 0.00454609, 0, false},
+	"gigabit":                           {"digital", 1000000000, 0, false},
+	"gigabyte":                          {"digital", 8000000000, 0, false},
+	"gigahertz":                         {"frequency", 1000000000, 0, false},
+	"gigawatt":                          {"power", 1000000000, 0, false},
+	"gram":                              {"mass", 0.001, 0, false},
+	"hectare":                           {"area", 10000, 0, false},
+	"hectoliter":                        {"volume", 0.1, 0, false},
+	"hectopascal":                       {"pressure", 100, 0, false},
+	"hertz":                             {"frequency", 1, 0, false},
+	"horsepower":                        {"power", 745.6998715822702, 0, false},
+	"hour":                              {"duration", 3600, 0, false},
+	"inch":                              {"length", 0.0254, 0, false},
+	"inch-ofhg":                         {"pressure", 3386.388640341, 0, false},
+	"inch-per-hour":                     {"speed", 7.055555555555556e-06, 0, false},
+	"item":                              {"substance-amount", 1, 0, false},
+	"item-per-cubic-meter":              {"concentration", 1, 0, false},
+	"joule":                             {"energy", 1, 0, false},
+	"karat":                             {"portion", 0.041666666666666664, 0, false},
+	"kelvin":                            {"temperature", 1, 0, false},
+	"kilobit":                           {"digital", 1000, 0, false},
+	"kilobyte":                          {"digital", 8000, 0, false},
+	"kilocalorie":                       {"energy", 4184, 0, false},
+	"kilogram":                          {"mass", 1, 0, false},
+	"kilogram-per-cubic-meter":          {"mass-density", 1, 0, false},
+	"kilohertz":                         {"frequency", 1000, 0, false},
+	"kilojoule":                         {"energy", 1000, 0, false},
+	"kilometer":                         {"length", 1000, 0, false},
+	"kilometer-per-hour":                {"speed", 0.2777777777777778, 0, false},
+	"kilopascal":                        {"pressure", 1000, 0, false},
+	"kilowatt":                          {"power", 1000, 0, false},
+	"kilowatt-hour":                     {"energy", 3600000, 0, false},
+	"knot":                              {"speed", 0.5144444444444445, 0, false},
+	"light-year":                        {"length", 9460730472580800, 0, false},
+	"liter":                             {"volume", 0.001, 0, false},
+	"liter-per-100-kilometer":           {"consumption", 1e-08, 0, false},
+	"liter-per-kilometer":               {"consumption", 1e-06, 0, false},
+	"megabit":                           {"digital", 1000000, 0, false},
+	"megabyte":                          {"digital", 8000000, 0, false},
+	"megahertz":                         {"frequency", 1000000, 0, false},
+	"megapascal":                        {"pressure", 1000000, 0, false},
+	"megapixel":                         {"graphics", 1000000, 0, false},
+	"megawatt":                          {"power", 1000000, 0, false},
+	"meter":                             {"length", 1, 0, false},
+	"meter-per-second":                  {"speed", 1, 0, false},
+	"meter-per-square-second":           {"acceleration", 1, 0, false},
+	"microgram":                         {"mass", 1e-09, 0, false},
+	"micrometer":                        {"length", 1e-06, 0, false},
+	"microsecond":                       {"duration", 1e-06, 0, false},
+	"mile":                              {"length", 1609.344, 0, false},
+	"mile-per-gallon":                   {"consumption", 425143.707430272, 0, true},
+	"mile-per-gallon-imperial":          {"consumption", 354006.1899346471, 0, true},
+	"mile-per-hour":                     {"speed", 0.44704, 0, false},
+	"mile-scandinavian":                 {"length", 10000, 0, false},
+	"milliampere":                       {"electric-current", 0.001, 0, false},
+	"millibar":                          {"pressure", 100, 0, false},
+	"milligram":                         {"mass", 1e-06, 0, false},
+	"milligram-ofglucose-per-deciliter": {"concentration", 3.342742283480345e+22, 0, false},
+	"milliliter":                        {"volume", 1e-06, 0, false},
+	"millimeter":                        {"length", 0.001, 0, false},
+	"millimeter-ofhg":                   {"pressure", 133.322387415, 0, false},
+	"millimeter-per-hour":               {"speed", 2.7777777777777776e-07, 0, false},
+	"millimole-per-liter":               {"concentration", 6.02214076e+23, 0, false},
+	"millisecond":                       {"duration", 0.001, 0, false},
+	"milliwatt":                         {"power", 0.001, 0, false},
+	"minute":                            {"duration", 60, 0, false},
+	"month":                             {"year-duration", 0.08333333333333333, 0, false},
+	"month-person":                      {"year-duration", 0.08333333333333333, 0, false},
+	"nanometer":                         {"length", 1e-09, 0, false},
+	"nanosecond":                        {"duration", 1e-09, 0, false},
+	"nautical-mile":                     {"length", 1852, 0, false},
+	"ohm":                               {"electric-resistance", 1, 0, false},
+	"ounce":                             {"mass", 0.028349523125, 0, false},
+	"part-per-1e6":                      {"portion", 1e-06, 0, false},
+	"pascal":                            {"pressure", 1, 0, false},
+	"percent":                           {"portion", 0.01, 0, false},
+	"permille":                          {"portion", 0.001, 0, false},
+	"petabyte":                          {"digital", 8000000000000000, 0, false},
+	"pint":                              {"volume", 0.000473176473, 0, false},
+	"pixel":                             {"graphics", 1, 0, false},
+	"pound":                             {"mass", 0.45359237, 0, false},
+	"pound-force-per-square-inch":       {"pressure", 6894.757293168362, 0, false},
+	"quart":                             {"volume", 0.000946352946, 0, false},
+	"quarter":                           {"year-duration", 0.25, 0, false},
+	"radian":                            {"angle", 0.15915494309189535, 0, false},
+	"second":                            {"duration", 1, 0, false},
+	"square-centimeter":                 {"area", 0.0001, 0, false},
+	"square-foot":                       {"area", 0.09290304, 0, false},
+	"square-inch":                       {"area", 0.00064516, 0, false},
+	"square-kilometer":                  {"area", 1000000, 0, false},
+	"square-meter":                      {"area", 1, 0, false},
+	"square-mile":                       {"area", 2589988.110336, 0, false},
+	"stone":                             {"mass", 6.35029318, 0, false},
+	"tablespoon":                        {"volume", 1.478676478125e-05, 0, false},
+	"teaspoon":                          {"volume", 4.92892159375e-06, 0, false},
+	"terabyte":                          {"digital", 8000000000000, 0, false},
+	"ton":                               {"mass", 907.18474, 0, false},
+	"tonne":                             {"mass", 1000, 0, false},
+	"volt":                              {"voltage", 1, 0, false},
+	"watt":                              {"power", 1, 0, false},
+	"week":                              {"duration", 604800, 0, false},
+	"yard":                              {"length", 0.9144, 0, false},
+	"year":                              {"year-duration", 1, 0, false},
+	"year-person":                       {"year-duration", 1, 0, false},
+}
+
+// Preferred units by quantity, usage and region, a hand-maintained subset of
+// the unitPreferenceData of CLDR 48.
+var unitPreferenceData = map[string]map[string][]unitPreference{
+	"area/default": {
+		"001": {{"square-kilometer", 1, 0}, {"hectare", 1, 0}, {"square-meter", 1, 0}, {"square-centimeter", 1, 0}},
+		"GB":  {{"square-mile", 1, 0}, {"acre", 1, 0}, {"square-foot", 1, 0}, {"square-inch", 1, 0}},
+		"US":  {{"square-mile", 1, 0}, {"acre", 1, 0}, {"square-foot", 1, 0}, {"square-inch", 1, 0}},
+	},
+	"area/floor": {
+		"001": {{"square-meter", 1, 0}},
+		"CA":  {{"square-foot", 1, 0}},
+		"GB":  {{"square-foot", 1, 0}},
+		"MM":  {{"square-foot", 1, 0}},
+		"US":  {{"square-foot", 1, 0}},
+	},
+	"area/geograph": {
+		"001": {{"square-kilometer", 1, 0}},
+		"GB":  {{"square-mile", 1, 0}},
+		"US":  {{"square-mile", 1, 0}},
+	},
+	"area/land": {
+		"001": {{"hectare", 1, 0}},
+		"GB":  {{"acre", 1, 0}},
+		"US":  {{"acre", 1, 0}},
+	},
+	"concentration/blood-glucose": {
+		"001": {{"milligram-ofglucose-per-deciliter", 1, 0}},
+		"AG":  {{"millimole-per-liter", 1, 0}},
+		"AI":  {{"millimole-per-liter", 1, 0}},
+		"AO":  {{"millimole-per-liter", 1, 0}},
+		"AU":  {{"millimole-per-liter", 1, 0}},
+		"BA":  {{"millimole-per-liter", 1, 0}},
+		"BG":  {{"millimole-per-liter", 1, 0}},
+		"BH":  {{"millimole-per-liter", 1, 0}},
+		"BM":  {{"millimole-per-liter", 1, 0}},
+		"BN":  {{"millimole-per-liter", 1, 0}},
+		"BW":  {{"millimole-per-liter", 1, 0}},
+		"BY":  {{"millimole-per-liter", 1, 0}},
+		"CA":  {{"millimole-per-liter", 1, 0}},
+		"CH":  {{"millimole-per-liter", 1, 0}},
+		"CM":  {{"millimole-per-liter", 1, 0}},
+		"CN":  {{"millimole-per-liter", 1, 0}},
+		"CZ":  {{"millimole-per-liter", 1, 0}},
+		"DK":  {{"millimole-per-liter", 1, 0}},
+		"DM":  {{"millimole-per-liter", 1, 0}},
+		"EE":  {{"millimole-per-liter", 1, 0}},
+		"FI":  {{"millimole-per-liter", 1, 0}},
+		"FJ":  {{"millimole-per-liter", 1, 0}},
+		"GB":  {{"millimole-per-liter", 1, 0}},
+		"GD":  {{"millimole-per-liter", 1, 0}},
+		"HK":  {{"millimole-per-liter", 1, 0}},
+		"HR":  {{"millimole-per-liter", 1, 0}},
+		"HU":  {{"millimole-per-liter", 1, 0}},
+		"IE":  {{"millimole-per-liter", 1, 0}},
+		"IM":  {{"millimole-per-liter", 1, 0}},
+		"IS":  {{"millimole-per-liter", 1, 0}},
+		"KE":  {{"millimole-per-liter", 1, 0}},
+		"KN":  {{"millimole-per-liter", 1, 0}},
+		"KW":  {{"millimole-per-liter", 1, 0}},
+		"KZ":  {{"millimole-per-liter", 1, 0}},
+		"LC":  {{"millimole-per-liter", 1, 0}},
+		"LI":  {{"millimole-per-liter", 1, 0}},
+		"LT":  {{"millimole-per-liter", 1, 0}},
+		"LU":  {{"millimole-per-liter", 1, 0}},
+		"LV":  {{"millimole-per-liter", 1, 0}},
+		"ME":  {{"millimole-per-liter", 1, 0}},
+		"MG":  {{"millimole-per-liter", 1, 0}},
+		"MK":  {{"millimole-per-liter", 1, 0}},
+		"MO":  {{"millimole-per-liter", 1, 0}},
+		"MS":  {{"millimole-per-liter", 1, 0}},
+		"MT":  {{"millimole-per-liter", 1, 0}},
+		"MU":  {{"millimole-per-liter", 1, 0}},
+		"MY":  {{"millimole-per-liter", 1, 0}},
+		"MZ":  {{"millimole-per-liter", 1, 0}},
+		"NA":  {{"millimole-per-liter", 1, 0}},
+		"NL":  {{"millimole-per-liter", 1, 0}},
+		"NO":  {{"millimole-per-liter", 1, 0}},
+		"NZ":  {{"millimole-per-liter", 1, 0}},
+		"OM":  {{"millimole-per-liter", 1, 0}},
+		"PG":  {{"millimole-per-liter", 1, 0}},
+		"RS":  {{"millimole-per-liter", 1, 0}},
+		"RU":  {{"millimole-per-liter", 1, 0}},
+		"SE":  {{"millimole-per-liter", 1, 0}},
+		"SG":  {{"millimole-per-liter", 1, 0}},
+		"SI":  {{"millimole-per-liter", 1, 0}},
+		"SK":  {{"millimole-per-liter", 1, 0}},
+		"TC":  {{"millimole-per-liter", 1, 0}},
+		"TO":  {{"millimole-per-liter", 1, 0}},
+		"UA":  {{"millimole-per-liter", 1, 0}},
+		"UG":  {{"millimole-per-liter", 1, 0}},
+		"VC":  {{"millimole-per-liter", 1, 0}},
+		"VG":  {{"millimole-per-liter", 1, 0}},
+		"VN":  {{"millimole-per-liter", 1, 0}},
+		"VU":  {{"millimole-per-liter", 1, 0}},
+		"ZA":  {{"millimole-per-liter", 1, 0}},
+	},
+	"concentration/default": {
+		"001": {{"item-per-cubic-meter", 1, 0}},
+	},
+	"consumption/default": {
+		"001": {{"liter-per-100-kilometer", 1, 0}},
+	},
+	"consumption/vehicle-fuel": {
+		"001": {{"liter-per-100-kilometer", 1, 0}},
+		"BR":  {{"liter-per-kilometer", 1, 0}},
+		"CA":  {{"mile-per-gallon-imperial", 1, 0}},
+		"GB":  {{"mile-per-gallon-imperial", 1, 0}},
+		"IT":  {{"liter-per-kilometer", 1, 0}},
+		"JP":  {{"liter-per-kilometer", 1, 0}},
+		"KR":  {{"liter-per-kilometer", 1, 0}},
+		"MX":  {{"liter-per-kilometer", 1, 0}},
+		"MY":  {{"liter-per-kilometer", 1, 0}},
+		"NL":  {{"liter-per-kilometer", 1, 0}},
+		"TH":  {{"liter-per-kilometer", 1, 0}},
+		"TR":  {{"liter-per-kilometer", 1, 0}},
+		"US":  {{"mile-per-gallon", 1, 0}},
+	},
+	"duration/default": {
+		"001": {{"day", 1, 0}, {"hour", 1, 0}, {"minute", 1, 0}, {"second", 1, 0}, {"millisecond", 1, 0}, {"microsecond", 1, 0}, {"nanosecond", 1, 0}},
+	},
+	"duration/media": {
+		"001": {{"minute-and-second", 1, 0}, {"second", 1, 0}},
+	},
+	"energy/default": {
+		"001": {{"kilowatt-hour", 1, 0}},
+	},
+	"energy/food": {
+		"001": {{"kilocalorie", 1, 0}},
+		"US":  {{"foodcalorie", 1, 0}},
+	},
+	"length/default": {
+		"001": {{"kilometer", 1, 0}, {"meter", 1, 0}, {"centimeter", 1, 0}},
+		"GB":  {{"mile", 1, 0}, {"foot", 1, 0}, {"inch", 1, 0}},
+		"US":  {{"mile", 1, 0}, {"foot", 1, 0}, {"inch", 1, 0}},
+	},
+	"length/focal-length": {
+		"001": {{"millimeter", 1, 0}},
+	},
+	"length/person": {
+		"001": {{"centimeter", 1, 0}},
+		"CA":  {{"inch", 1, 0}},
+		"GB":  {{"inch", 1, 0}},
+		"IN":  {{"inch", 1, 0}},
+		"US":  {{"inch", 1, 0}},
+	},
+	"length/person-height": {
+		"001": {{"centimeter", 1, 0}},
+		"AT":  {{"meter-and-centimeter", 1, 0}},
+		"BE":  {{"meter-and-centimeter", 1, 0}},
+		"CA":  {{"foot-and-inch", 3, 0}, {"inch", 1, 0}},
+		"DZ":  {{"meter-and-centimeter", 1, 0}},
+		"EG":  {{"meter-and-centimeter", 1, 0}},
+		"ES":  {{"meter-and-centimeter", 1, 0}},
+		"FR":  {{"meter-and-centimeter", 1, 0}},
+		"GB":  {{"foot-and-inch", 3, 0}, {"inch", 1, 0}},
+		"HK":  {{"meter-and-centimeter", 1, 0}},
+		"ID":  {{"meter-and-centimeter", 1, 0}},
+		"IL":  {{"meter-and-centimeter", 1, 0}},
+		"IN":  {{"foot-and-inch", 3, 0}, {"inch", 1, 0}},
+		"IT":  {{"meter-and-centimeter", 1, 0}},
+		"JO":  {{"meter-and-centimeter", 1, 0}},
+		"MY":  {{"meter-and-centimeter", 1, 0}},
+		"SA":  {{"meter-and-centimeter", 1, 0}},
+		"SE":  {{"meter-and-centimeter", 1, 0}},
+		"TR":  {{"meter-and-centimeter", 1, 0}},
+		"US":  {{"foot-and-inch", 3, 0}, {"inch", 1, 0}},
+		"VN":  {{"meter-and-centimeter", 1, 0}},
+	},
+	"length/rainfall": {
+		"001": {{"millimeter", 1, 0}},
+		"BR":  {{"centimeter", 1, 0}},
+		"US":  {{"inch", 1, 0}},
+	},
+	"length/road": {
+		"001": {{"kilometer", 0.9, 0}, {"meter", 300, 50}, {"meter", 10, 10}, {"meter", 1, 1}},
+		"GB":  {{"mile", 0.5, 0}, {"yard", 100, 50}, {"yard", 10, 10}, {"yard", 1, 1}},
+		"SE":  {{"mile-scandinavian", 1, 0}, {"kilometer", 1, 0}, {"meter", 300, 50}, {"meter", 10, 10}, {"meter", 1, 1}},
+		"US":  {{"mile", 0.5, 0}, {"foot", 100, 50}, {"foot", 10, 10}, {"foot", 1, 1}},
+	},
+	"length/snowfall": {
+		"001": {{"centimeter", 1, 0}},
+		"US":  {{"inch", 1, 0}},
+	},
+	"length/vehicle": {
+		"001": {{"meter", 1, 0}},
+		"GB":  {{"foot-and-inch", 1, 0}},
+		"US":  {{"foot-and-inch", 1, 0}},
+	},
+	"length/visiblty": {
+		"001": {{"kilometer", 0.1, 0}, {"meter", 1, 0}},
+		"DE":  {{"meter", 1, 0}},
+		"GB":  {{"mile", 1, 0}, {"foot", 1, 0}},
+		"NL":  {{"meter", 1, 0}},
+		"US":  {{"mile", 1, 0}, {"foot", 1, 0}},
+	},
+	"mass/default": {
+		"001": {{"tonne", 1, 0}, {"kilogram", 1, 0}, {"gram", 1, 0}, {"milligram", 1, 0}, {"microgram", 1, 0}},
+		"GB":  {{"ton", 1, 0}, {"pound", 1, 0}, {"ounce", 1, 0}},
+		"US":  {{"ton", 1, 0}, {"pound", 1, 0}, {"ounce", 1, 0}},
+	},
+	"mass/person": {
+		"001": {{"kilogram", 1, 0}, {"gram", 1, 0}},
+		"GB":  {{"stone-and-pound", 1, 0}, {"pound-and-ounce", 1, 0}},
+		"HK":  {{"pound-and-ounce", 1, 0}},
+		"US":  {{"pound", 1, 0}, {"pound-and-ounce", 1, 0}},
+	},
+	"mass-density/default": {
+		"001": {{"kilogram-per-cubic-meter", 1, 0}},
+	},
+	"power/default": {
+		"001": {{"gigawatt", 1, 0}, {"megawatt", 1, 0}, {"kilowatt", 1, 0}, {"watt", 1, 0}, {"milliwatt", 1, 0}},
+	},
+	"power/engine": {
+		"001": {{"kilowatt", 1, 0}},
+		"GB":  {{"horsepower", 1, 0}},
+		"US":  {{"horsepower", 1, 0}},
+	},
+	"pressure/baromtrc": {
+		"001": {{"hectopascal", 1, 0}},
+		"BR":  {{"millibar", 1, 0}},
+		"EG":  {{"millibar", 1, 0}},
+		"GB":  {{"millibar", 1, 0}},
+		"IL":  {{"millibar", 1, 0}},
+		"MX":  {{"millimeter-ofhg", 1, 0}},
+		"RU":  {{"millimeter-ofhg", 1, 0}},
+		"TH":  {{"millibar", 1, 0}},
+		"US":  {{"inch-ofhg", 1, 0}},
+	},
+	"pressure/default": {
+		"001": {{"megapascal", 1, 0}, {"pascal", 1, 0}},
+		"GB":  {{"pound-force-per-square-inch", 1, 0}},
+		"US":  {{"pound-force-per-square-inch", 1, 0}},
+	},
+	"speed/default": {
+		"001": {{"kilometer-per-hour", 1, 0}},
+		"GB":  {{"mile-per-hour", 1, 0}},
+		"US":  {{"mile-per-hour", 1, 0}},
+	},
+	"speed/rainfall": {
+		"001": {{"millimeter-per-hour", 1, 0}},
+		"BR":  {{"centimeter-per-hour", 1, 0}},
+		"US":  {{"inch-per-hour", 1, 0}},
+	},
+	"speed/snowfall": {
+		"001": {{"centimeter-per-hour", 1, 0}},
+		"US":  {{"inch-per-hour", 1, 0}},
+	},
+	"speed/wind": {
+		"001": {{"kilometer-per-hour", 1, 0}},
+		"CN":  {{"meter-per-second", 1, 0}},
+		"DK":  {{"meter-per-second", 1, 0}},
+		"FI":  {{"meter-per-second", 1, 0}},
+		"GB":  {{"mile-per-hour", 1, 0}},
+		"JP":  {{"meter-per-second", 1, 0}},
+		"KR":  {{"meter-per-second", 1, 0}},
+		"NO":  {{"meter-per-second", 1, 0}},
+		"PL":  {{"meter-per-second", 1, 0}},
+		"RU":  {{"meter-per-second", 1, 0}},
+		"SE":  {{"meter-per-second", 1, 0}},
+		"US":  {{"mile-per-hour", 1, 0}},
+	},
+	"temperature/default": {
+		"001": {{"celsius", 1, 0}},
+		"US":  {{"fahrenheit", 1, 0}},
+	},
+	"temperature/weather": {
+		"001": {{"celsius", 1, 0}},
+		"BS":  {{"fahrenheit", 1, 0}},
+		"BZ":  {{"fahrenheit", 1, 0}},
+		"KY":  {{"fahrenheit", 1, 0}},
+		"PR":  {{"fahrenheit", 1, 0}},
+		"PW":  {{"fahrenheit", 1, 0}},
+		"US":  {{"fahrenheit", 1, 0}},
+	},
+	"volume/default": {
+		"001": {{"cubic-meter", 1, 0}, {"cubic-centimeter", 1, 0}},
+		"GB":  {{"cubic-foot", 1, 0}, {"cubic-inch", 1, 0}},
+		"US":  {{"cubic-foot", 1, 0}, {"cubic-inch", 1, 0}},
+	},
+	"volume/fluid": {
+		"001": {{"liter", 1, 0}, {"milliliter", 1, 0}},
+		"GB":  {{"gallon-imperial", 1, 0}, {"fluid-ounce-imperial", 1, 0}},
+		"US":  {{"gallon", 1, 0}, {"quart", 1, 0}, {"pint", 1, 0}, {"cup", 1, 0}, {"fluid-ounce", 1, 0}, {"tablespoon", 1, 0}, {"teaspoon", 1, 0}},
+	},
+	"volume/oil": {
+		"001": {{"barrel", 1, 0}},
+	},
+	"volume/vehicle": {
+		"001": {{"liter", 1, 0}},
+		"US":  {{"gallon", 1, 0}},
+	},
+	"year-duration/default": {
+		"001": {{"year", 1, 0}, {"month", 1, 0}},
+	},
+	"year-duration/person-age": {
+		"001": {{"year-person", 2.5, 0}, {"year-person-and-month-person", 1, 0}, {"month-person", 1, 0}},
+	},
+}
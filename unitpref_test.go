@@ -0,0 +1,107 @@
+package icu
+
+import (
+	"math"
+	"testing"
+)
+
+func TestConvertUnit(t *testing.T) {
+	testCases := []struct {
+		value float64
+		from  string
+		to    string
+		want  float64
+	}{
+		{1, "mile", "kilometer", 1.609344},
+		{1, "length-foot", "inch", 12},
+		{100, "celsius", "fahrenheit", 212},
+		{32, "fahrenheit", "kelvin", 273.15},
+		{100, "kilometer-per-hour", "meter-per-second", 27.777777777777},
+		{10, "liter-per-100-kilometer", "mile-per-gallon", 23.521458},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.from+":"+tc.to, func(t *testing.T) {
+			got, err := ConvertUnit(tc.value, tc.from, tc.to)
+			if err != nil {
+				t.Fatalf("convert: %s", err)
+			}
+			if math.Abs(tc.want-got) > 1e-6 {
+				t.Errorf("want: %v, got: %v", tc.want, got)
+			}
+		})
+	}
+	if _, err := ConvertUnit(1, "mile", "kilogram"); err == nil {
+		t.Errorf("expected an error for units of different quantities")
+	}
+	if _, err := ConvertUnit(1, "parsec", "meter"); err == nil {
+		t.Errorf("expected an error for an unknown unit")
+	}
+}
+
+func TestFormatUnitForUsage(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		tag   Tag
+		value float64
+		unit  string
+		usage string
+		width Width
+		want  string
+	}{
+		{"en-US", 1000, "meter", "road", WidthShort, "0.62 mi"},
+		{"en-US", 50, "meter", "road", WidthShort, "150 ft"},
+		{"en", 120, "meter", "road", WidthLong, "400 feet"},
+		{"de-DE", 1000, "meter", "road", WidthShort, "1 km"},
+		{"en-GB", 5, "kilometer", "road", WidthShort, "3.1 mi"},
+		{"en-US", 180, "centimeter", "person-height", WidthShort, "5 ft, 11 in"},
+		{"en-US", 180, "centimeter", "person-height", WidthLong, "5 feet, 11 inches"},
+		{"de-DE", 180, "centimeter", "person-height", WidthShort, "180 cm"},
+		{"de-AT", 178, "centimeter", "person-height", WidthShort, "1 m, 78 cm"},
+		{"en-US", 20, "celsius", "weather", WidthShort, "68°F"},
+		{"en-US", -10, "celsius", "weather", WidthShort, "14°F"},
+		{"de", 68, "fahrenheit", "weather", WidthShort, "20 °C"},
+		{"en-US", 100, "kilometer-per-hour", "default", WidthShort, "62 mph"},
+		{"en-US", 70, "kilogram", "person", WidthShort, "154 lb"},
+		{"en-US", 6, "liter-per-100-kilometer", "vehicle-fuel", WidthShort, "39 mpg"},
+		{"en-US", 5, "megabyte", "default", WidthShort, "5 MB"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.tag)+":"+tc.want, func(t *testing.T) {
+			got, err := FormatUnitForUsage(tc.tag, tc.value, tc.unit, tc.usage, tc.width)
+			if err != nil {
+				t.Fatalf("format: %s", err)
+			}
+			if tc.want != got {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTranslateUnitUsage(t *testing.T) {
+	requireFullData(t)
+	testCases := []struct {
+		name       string
+		tag        Tag
+		message    MessageFormat
+		parameters []Parameter
+		translated string
+	}{
+		{"road", "en-US", "{d, number, ::unit/meter usage/road unit-width-full-name}", []Parameter{P("d", 12000)}, "7.5 miles"},
+		{"road:de", "de-DE", "{d, number, ::unit/meter usage/road unit-width-full-name}", []Parameter{P("d", 12000)}, "12 Kilometer"},
+		{"person-height", "de-CH", "{d, number, ::unit/centimeter usage/person-height}", []Parameter{P("d", 180)}, "180 cm"},
+		{"weather:precision", "en-US", "{d, number, ::unit/celsius usage/weather .0}", []Parameter{P("d", 21)}, "69.8°F"},
+		{"no-region", "en", "{d, number, ::unit/celsius usage/weather}", []Parameter{P("d", 21)}, "70°F"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Translate(tc.tag, tc.message, tc.parameters...)
+			if err != nil {
+				t.Errorf("parse: %s", err)
+			}
+			if tc.translated != got {
+				t.Errorf("expected: '%s', got: '%s'", tc.translated, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package icu
+
+import (
+	gocontext "context"
+	"errors"
+	"time"
+)
+
+// ErrWatchUnsupported is returned by loaders unable to watch their catalogs.
+var ErrWatchUnsupported = errors.New("watching catalogs is not supported")
+
+// WatchLoader is a Loader telling when catalogs change, e.g. by file system
+// notifications.
+type WatchLoader interface {
+	Loader
+	// Watch starts calling changed with the tags of changed catalogs in the
+	// background until the context is done. Loaders unable to watch fail with
+	// ErrWatchUnsupported.
+	Watch(ctx gocontext.Context, changed func(tag Tag)) error
+}
+
+// ReloadEvent tells that the catalog of a tag was reloaded in the background.
+// Catalogs that were not served before, e.g. added ones, have no old version,
+// those no longer served, e.g. removed ones, no new version.
+type ReloadEvent struct {
+	Tag Tag
+	Old Version
+	New Version
+}
+
+// Watch reloads changed catalogs in the background until the context is
+// done. Meanwhile translators are served from memory, without asking the
+// loader for versions. Loaders that are no WatchLoader fail with
+// ErrWatchUnsupported, see Poll.
+func (b *Bundle) Watch(ctx gocontext.Context) error {
+	wl, ok := b.loader.(WatchLoader)
+	if !ok {
+		return ErrWatchUnsupported
+	}
+	if err := wl.Watch(ctx, func(tag Tag) { b.reload(ctx, tag) }); err != nil {
+		return err
+	}
+	b.background(ctx)
+	return nil
+}
+
+// Poll reloads changed catalogs in the background every interval until the
+// context is done, e.g. if the loader cannot watch. Meanwhile translators are
+// served from memory like with Watch.
+func (b *Bundle) Poll(ctx gocontext.Context, interval time.Duration) {
+	b.background(ctx)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				b.poll(ctx)
+			}
+		}
+	}()
+}
+
+// Subscribe calls f with the events of catalogs reloaded in the background.
+// The returned function cancels the subscription.
+func (b *Bundle) Subscribe(f func(ReloadEvent)) (cancel func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.subscribers == nil {
+		b.subscribers = map[int]func(ReloadEvent){}
+	}
+	id := b.subscriber
+	b.subscriber++
+	b.subscribers[id] = f
+	return func() {
+		b.mu.Lock()
+		delete(b.subscribers, id)
+		b.mu.Unlock()
+	}
+}
+
+// background serves translators from memory until the context is done, after
+// catching up with the changes made since they were loaded.
+func (b *Bundle) background(ctx gocontext.Context) {
+	b.poll(ctx)
+	b.mu.Lock()
+	b.watchers++
+	b.mu.Unlock()
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		b.watchers--
+		b.mu.Unlock()
+	}()
+}
+
+// poll reloads the cached catalogs whose version changed, or all of them if
+// the loader has no versions.
+func (b *Bundle) poll(ctx gocontext.Context) {
+	b.mu.Lock()
+	b.tagsCached = false
+	entries := make([]cacheEntry, 0, len(b.cache))
+	for _, e := range b.cache {
+		entries = append(entries, e)
+	}
+	b.mu.Unlock()
+	vl, versioned := b.loader.(VersionLoader)
+	for _, e := range entries {
+		if versioned {
+			v, err := vl.Version(ctx, e.tag)
+			if (err == nil && v == e.seen) || (err != nil && e.seen == "" && e.err != nil) {
+				continue
+			}
+		}
+		b.reload(ctx, e.tag)
+	}
+}
+
+// reload swaps the cached catalog of a tag for its current version and tells
+// subscribers if the served version changed. Translators already handed out
+// keep the old one.
+func (b *Bundle) reload(ctx gocontext.Context, tag Tag) {
+	b.reloadMu.Lock()
+	defer b.reloadMu.Unlock()
+	key := tag.literal()
+	b.mu.RLock()
+	old := b.cache[key]
+	b.mu.RUnlock()
+	c, v, err := b.loader.Load(ctx, tag)
+	if ctx.Err() != nil || interrupted(err) {
+		return
+	}
+	e := b.entry(old, tag, c, v, err)
+	b.mu.Lock()
+	b.cache[key] = e
+	b.tagsCached = false
+	var subscribers []func(ReloadEvent)
+	for _, f := range b.subscribers {
+		subscribers = append(subscribers, f)
+	}
+	b.mu.Unlock()
+	if old.served() == e.served() {
+		return
+	}
+	ev := ReloadEvent{Tag: key, Old: old.served(), New: e.served()}
+	for _, f := range subscribers {
+		f(ev)
+	}
+}
@@ -0,0 +1,52 @@
+//go:build linux
+// +build linux
+
+package icu
+
+import (
+	gocontext "context"
+	"os"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+// Watch reports the files of the directory written, removed or renamed, using
+// inotify.
+func (l DirLoader) Watch(ctx gocontext.Context, changed func(tag Tag)) error {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return os.NewSyscallError("inotify_init1", err)
+	}
+	mask := uint32(syscall.IN_CLOSE_WRITE | syscall.IN_DELETE | syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO)
+	if _, err := syscall.InotifyAddWatch(fd, l.Dir, mask); err != nil {
+		syscall.Close(fd)
+		return os.NewSyscallError("inotify_add_watch", err)
+	}
+	// The descriptor is non-blocking, so reads go through the runtime poller
+	// and closing the file ends a pending read.
+	f := os.NewFile(uintptr(fd), l.Dir)
+	go func() {
+		<-ctx.Done()
+		f.Close()
+	}()
+	go func() {
+		buf := make([]byte, 64*(syscall.SizeofInotifyEvent+syscall.NAME_MAX+1))
+		for {
+			n, err := f.Read(buf)
+			if err != nil {
+				return
+			}
+			for i := 0; i+syscall.SizeofInotifyEvent <= n; {
+				e := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[i]))
+				i += syscall.SizeofInotifyEvent
+				name := strings.TrimRight(string(buf[i:i+int(e.Len)]), "\x00")
+				i += int(e.Len)
+				if strings.HasSuffix(name, ".toml") {
+					changed(Tag(strings.TrimSuffix(name, ".toml")))
+				}
+			}
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,11 @@
+//go:build !linux
+// +build !linux
+
+package icu
+
+import gocontext "context"
+
+// Watch is not supported on this platform, see Bundle.Poll.
+func (l DirLoader) Watch(ctx gocontext.Context, changed func(tag Tag)) error {
+	return ErrWatchUnsupported
+}
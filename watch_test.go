@@ -0,0 +1,140 @@
+package icu
+
+import (
+	gocontext "context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestBundlePoll(t *testing.T) {
+	modTime := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	fsys := fstest.MapFS{
+		"en.toml": {Data: []byte("[Translations]\nhello = \"Hello\"\n"), ModTime: modTime},
+		"de.toml": {Data: []byte("[Translations]\nhello = \"Hallo\"\n"), ModTime: modTime},
+	}
+	b := NewBundleFS(fsys, "en")
+	if got, want := b.TranslatorForTag("de").Translate("hello"), "Hallo"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+	var events []ReloadEvent
+	cancel := b.Subscribe(func(e ReloadEvent) { events = append(events, e) })
+	ctx, stop := gocontext.WithCancel(gocontext.Background())
+	defer stop()
+	b.Poll(ctx, time.Hour)
+
+	// Changes are only seen when polling.
+	fsys["de.toml"] = &fstest.MapFile{Data: []byte("[Translations]\nhello = \"Servus\"\n"), ModTime: modTime.Add(time.Second)}
+	if got, want := b.TranslatorForTag("de").Translate("hello"), "Hallo"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+	b.poll(ctx)
+	if got, want := b.TranslatorForTag("de").Translate("hello"), "Servus"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+
+	delete(fsys, "de.toml")
+	b.poll(ctx)
+	if got, want := b.TranslatorForTag("de").Translate("hello"), "Hello"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+	cancel()
+	fsys["de.toml"] = &fstest.MapFile{Data: []byte("[Translations]\nhello = \"Hallo\"\n"), ModTime: modTime}
+	b.poll(ctx)
+
+	want := []ReloadEvent{
+		{Tag: "de", Old: "2021-06-01T12:00:00Z", New: "2021-06-01T12:00:01Z"},
+		{Tag: "de", Old: "2021-06-01T12:00:01Z", New: ""},
+	}
+	if !reflect.DeepEqual(want, events) {
+		t.Errorf("want: %v, got: %v", want, events)
+	}
+}
+
+func TestBundlePollSQL(t *testing.T) {
+	db, f := openFakeDB(t, [3]string{"en", "hello", "Hello"})
+	b := NewBundleLoader(SQLLoader{DB: db, Query: "translations", TagsQuery: "tags"}, "en")
+	events := 0
+	b.Subscribe(func(e ReloadEvent) { events++ })
+	ctx, stop := gocontext.WithCancel(gocontext.Background())
+	defer stop()
+	b.Poll(ctx, time.Hour)
+	if got, want := b.TranslatorForTag("en").Translate("hello"), "Hello"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+	b.poll(ctx)
+	f.mu.Lock()
+	f.rows[0][2] = "Hi"
+	f.mu.Unlock()
+	b.poll(ctx)
+	if got, want := b.TranslatorForTag("en").Translate("hello"), "Hi"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+	if events != 1 {
+		t.Errorf("want: 1 event, got: %d", events)
+	}
+}
+
+func TestBundleWatch(t *testing.T) {
+	dir := writeBundle(t, map[string]string{
+		"en.toml": "[Translations]\nhello = \"Hello\"\n",
+		"de.toml": "[Translations]\nhello = \"Hallo\"\n",
+	})
+	b := NewBundle(dir, "en")
+	if got, want := b.TranslatorForTag("de").Translate("hello"), "Hallo"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+	events := make(chan ReloadEvent, 8)
+	b.Subscribe(func(e ReloadEvent) { events <- e })
+	ctx, stop := gocontext.WithCancel(gocontext.Background())
+	defer stop()
+	if err := b.Watch(ctx); errors.Is(err, ErrWatchUnsupported) {
+		t.Skip(err)
+	} else if err != nil {
+		t.Fatal(err)
+	}
+
+	// Renaming a written file over the catalog replaces it atomically.
+	tmp := filepath.Join(dir, "de.toml.tmp")
+	if err := os.WriteFile(tmp, []byte("[Translations]\nhello = \"Servus\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// File systems with coarse timestamps may not tell the files apart.
+	later := time.Now().Add(time.Second)
+	if err := os.Chtimes(tmp, later, later); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, filepath.Join(dir, "de.toml")); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case e := <-events:
+		if e.Tag != "de" || e.Old == "" || e.New == "" {
+			t.Errorf("want a reload of de, got: %v", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("want a reload event")
+	}
+	if got, want := b.TranslatorForTag("de").Translate("hello"), "Servus"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "fr.toml"), []byte("[Translations]\nhello = \"Bonjour\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case e := <-events:
+		if e.Tag != "fr" || e.Old != "" || e.New == "" {
+			t.Errorf("want fr to be added, got: %v", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("want a reload event")
+	}
+	if got, want := b.TranslatorForTag("fr").Translate("hello"), "Bonjour"; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+}